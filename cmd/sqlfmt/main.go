@@ -4,10 +4,10 @@ import (
 	"flag"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/jackc/sqlfmt"
 )
@@ -15,9 +15,20 @@ import (
 const Version = "0.1.0"
 
 var options struct {
-	write   bool
-	upper   bool
-	version bool
+	write       bool
+	upper       bool
+	version     bool
+	placeholder string
+	dialect     string
+	recover     bool
+}
+
+var placeholderStyles = map[string]sqlfmt.PlaceholderStyle{
+	"":         sqlfmt.NoPlaceholders,
+	"question": sqlfmt.QuestionPlaceholders,
+	"colon":    sqlfmt.ColonPlaceholders,
+	"at":       sqlfmt.AtPlaceholders,
+	"sqlc":     sqlfmt.SqlcPlaceholders,
 }
 
 type job struct {
@@ -35,20 +46,12 @@ func (j *job) run() error {
 		}
 	}
 
-	input, err := ioutil.ReadAll(j.r)
-	if err != nil {
-		return err
-	}
-
-	err = j.r.Close()
-	if err != nil {
-		return err
+	lexOptions := []sqlfmt.LexerOption{
+		sqlfmt.WithDialect(sqlfmt.LookupDialect(options.dialect)),
+		sqlfmt.WithPlaceholderStyle(placeholderStyles[options.placeholder]),
 	}
-
-	lexer := sqlfmt.NewSqlLexer(string(input))
-	stmt, err := sqlfmt.Parse(lexer)
-	if err != nil {
-		return err
+	if options.recover {
+		lexOptions = append(lexOptions, sqlfmt.WithErrorRecovery())
 	}
 
 	var inPlace bool
@@ -58,8 +61,10 @@ func (j *job) run() error {
 		dir := filepath.Dir(j.name)
 		base := filepath.Base(j.name)
 		tmpPath = path.Join(dir, "."+base+".sqlfmt")
+		var err error
 		j.w, err = os.Create(tmpPath)
 		if err != nil {
+			j.r.Close()
 			return err
 		}
 		inPlace = true
@@ -69,36 +74,68 @@ func (j *job) run() error {
 
 	r.UpperCase = options.upper
 
-	stmt.RenderTo(r)
-	if r.Error() != nil {
-		return err
+	// each statement is written as soon as it is parsed
+	lexer := sqlfmt.NewSqlLexerFromReader(j.r, lexOptions...)
+	err := sqlfmt.ParseFunc(lexer, func(stmt sqlfmt.Stmt) {
+		if v, ok := stmt.(*sqlfmt.VerbatimStmt); ok {
+			line, column, msg, _ := describeError(v.Err)
+			fmt.Fprintf(os.Stderr, "%s:%d:%d: warning: %s; bytes %d-%d left unformatted\n", displayName(j.name), line, column, msg, v.Start, v.End)
+		}
+		stmt.RenderTo(r)
+	})
+	if closeErr := j.r.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = r.Flush()
 	}
 
 	if inPlace {
-		err = j.w.Close()
-		if err != nil {
-			return err
+		// the file is left as it was if it could not be formatted
+		if closeErr := j.w.Close(); err == nil {
+			err = closeErr
 		}
-		err = os.Rename(tmpPath, j.name)
 		if err != nil {
+			os.Remove(tmpPath)
 			return err
 		}
+		return os.Rename(tmpPath, j.name)
 	}
 
-	return nil
+	return err
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "tokens" {
+		os.Exit(tokensMain(os.Args[2:]))
+	}
+
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "usage:  %s [options] [path ...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "        %s tokens [-json] [path ...]\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 
 	flag.BoolVar(&options.write, "w", false, "write result to (source) file instead of stdout")
 	flag.BoolVar(&options.upper, "u", false, "format with upper-case")
 	flag.BoolVar(&options.version, "version", false, "print version and exit")
+	flag.BoolVar(&options.recover, "recover", false, "leave statements that cannot be parsed as written instead of failing")
+	flag.StringVar(&options.placeholder, "placeholder", "", "recognize placeholders: question (?), colon (:name), at (@name) or sqlc (sqlc.arg(name))")
+	flag.StringVar(&options.dialect, "dialect", sqlfmt.PostgreSQL.Name, "SQL dialect: "+strings.Join(sqlfmt.DialectNames(), ", "))
 	flag.Parse()
 
+	if sqlfmt.LookupDialect(options.dialect) == nil {
+		fmt.Fprintf(os.Stderr, "invalid dialect: %s\n", options.dialect)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if _, ok := placeholderStyles[options.placeholder]; !ok {
+		fmt.Fprintf(os.Stderr, "invalid placeholder style: %s\n", options.placeholder)
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	if options.version {
 		fmt.Printf("sqlfmt v%v\n", Version)
 		os.Exit(0)
@@ -118,18 +155,64 @@ func main() {
 		jobs = append(jobs, job{r: os.Stdin, w: os.Stdout})
 	}
 
-	var errors []error
+	var errors []positionError
 
 	for _, j := range jobs {
 		if err := j.run(); err != nil {
-			errors = append(errors, err)
+			errors = append(errors, positionError{name: j.name, err: err})
 		}
 	}
 
 	if len(errors) > 0 {
+		// exit with 3 if all of the input is valid SQL sqlfmt cannot format
+		code := 3
 		for _, e := range errors {
 			fmt.Fprintln(os.Stderr, e)
+			if _, ok := e.err.(*sqlfmt.UnsupportedError); !ok {
+				code = 1
+			}
 		}
-		os.Exit(1)
+		os.Exit(code)
+	}
+}
+
+// positionError prints a syntax error in the file name as name:line:col:
+// message, followed by the snippet of a parse error.
+type positionError struct {
+	name string
+	err  error
+}
+
+func (e positionError) Error() string {
+	line, column, msg, snippet := describeError(e.err)
+	if line == 0 {
+		return e.err.Error()
+	}
+
+	s := fmt.Sprintf("%s:%d:%d: %s", displayName(e.name), line, column, msg)
+	if snippet != "" {
+		s += "\n" + strings.TrimSuffix(snippet, "\n")
+	}
+	return s
+}
+
+// describeError returns the position, message and snippet of an error in the
+// SQL source. line is 0 for other errors.
+func describeError(err error) (line, column int, msg, snippet string) {
+	switch err := err.(type) {
+	case *sqlfmt.ParseError:
+		return err.Line, err.Column, err.Message(), err.Snippet
+	case *sqlfmt.UnsupportedError:
+		return err.Line, err.Column, err.Message(), err.Snippet
+	case *sqlfmt.LexError:
+		return err.Line, err.Column, err.Message(), ""
+	}
+	return 0, 0, "", ""
+}
+
+func displayName(name string) string {
+	if name == "" {
+		return "<standard input>"
 	}
+	return name
 }
@@ -2,12 +2,14 @@ package main_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
 	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -204,7 +206,7 @@ func TestSqlFmtAll(t *testing.T) {
 	}
 
 	for _, fi := range fileInfos {
-		if fi.Name()[len(fi.Name())-10:] != ".input.sql" {
+		if !strings.HasSuffix(fi.Name(), ".input.sql") {
 			continue
 		}
 
@@ -241,3 +243,123 @@ func TestSqlFmtAll(t *testing.T) {
 		}
 	}
 }
+
+func TestPlaceholderStyles(t *testing.T) {
+	tests := []struct {
+		style    string
+		input    string
+		expected string
+	}{
+		{"question", "select * from t where a=? and b = ?::int", "select\n  *\nfrom\n  t\nwhere\n  a = ?\n  and b = ?::int\n"},
+		{"colon", "select * from t where a=:a and b = :b::int", "select\n  *\nfrom\n  t\nwhere\n  a = :a\n  and b = :b::int\n"},
+		{"at", "select * from t where a=@a and b = @b", "select\n  *\nfrom\n  t\nwhere\n  a = @a\n  and b = @b\n"},
+		{"sqlc", "select * from t where a=SQLC.ARG(a) and b = sqlc.narg('b')", "select\n  *\nfrom\n  t\nwhere\n  a = SQLC.ARG(a)\n  and b = sqlc.narg('b')\n"},
+	}
+
+	for _, tt := range tests {
+		output, err := sqlfmt([]byte(tt.input), "-placeholder", tt.style)
+		if err != nil {
+			t.Errorf("%s: sqlfmt failed with: %v", tt.style, err)
+			continue
+		}
+
+		if string(output) != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.style, tt.expected, string(output))
+		}
+	}
+}
+
+func TestDialect(t *testing.T) {
+	output, err := sqlfmt([]byte("select \"a\" from t"), "-dialect", "PostgreSQL")
+	if err != nil {
+		t.Fatalf("sqlfmt failed with: %v", err)
+	}
+	if expected := "select\n  \"a\"\nfrom\n  t\n"; string(output) != expected {
+		t.Errorf("expected %q, got %q", expected, string(output))
+	}
+
+	cmd := exec.Command("tmp/sqlfmt", "-dialect", "oracle")
+	cmd.Stdin = strings.NewReader("select 1")
+	err = cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 2 {
+		t.Errorf("expected exit code 2, got %v", err)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	output, err := sqlfmt([]byte("select a -- c\nfrom t"), "tokens")
+	if err != nil {
+		t.Fatalf("sqlfmt tokens failed: %v", err)
+	}
+
+	expected := "1:1\tkeyword\t\"select\"\n1:8\tidentifier\t\"a\"\n1:10\tcomment\t\"-- c\"\n2:1\tkeyword\t\"from\"\n2:6\tidentifier\t\"t\"\n"
+	if string(output) != expected {
+		t.Errorf("expected %q, got %q", expected, string(output))
+	}
+
+	output, err = sqlfmt([]byte("select 1"), "tokens", "-json")
+	if err != nil {
+		t.Fatalf("sqlfmt tokens -json failed: %v", err)
+	}
+
+	var tokens []map[string]interface{}
+	if err := json.Unmarshal(output, &tokens); err != nil {
+		t.Fatalf("invalid JSON %q: %v", output, err)
+	}
+	if len(tokens) != 2 || tokens[1]["kind"] != "integer" || tokens[1]["text"] != "1" || tokens[1]["column"] != 8.0 {
+		t.Errorf("unexpected tokens: %v", tokens)
+	}
+}
+
+func TestSyntaxErrorPosition(t *testing.T) {
+	_, err := sqlfmt([]byte("select a,\n  b c d from t"))
+	if err == nil {
+		t.Fatal("expected sqlfmt to fail")
+	}
+
+	expected := "<standard input>:2:7: syntax error at or near \"d\""
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to contain %q, got %v", expected, err)
+	}
+
+	expected = "  b c d from t\n      ^"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to contain %q, got %v", expected, err)
+	}
+}
+
+func TestUnsupportedExitCode(t *testing.T) {
+	tests := []struct {
+		sql  string
+		code int
+	}{
+		{"create trigger t after insert on x execute function f()", 3},
+		{"select a b c", 1},
+	}
+
+	for _, tt := range tests {
+		cmd := exec.Command("tmp/sqlfmt")
+		cmd.Stdin = strings.NewReader(tt.sql)
+		err := cmd.Run()
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok {
+			t.Errorf("%s: expected exit error, got %v", tt.sql, err)
+			continue
+		}
+		if code := exitErr.ExitCode(); code != tt.code {
+			t.Errorf("%s: expected exit code %d, got %d", tt.sql, tt.code, code)
+		}
+	}
+}
+
+func TestRecover(t *testing.T) {
+	output, err := sqlfmt([]byte("select 1; select a b c  from t; select 2"), "-recover")
+	if err != nil {
+		t.Fatalf("sqlfmt -recover failed: %v", err)
+	}
+
+	expected := "select\n  1\n;\nselect a b c  from t\n;\nselect\n  2\n"
+	if string(output) != expected {
+		t.Errorf("expected %q, got %q", expected, string(output))
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/jackc/sqlfmt"
+)
+
+// tokensMain implements "sqlfmt tokens", which prints the tokens of its input
+// for debugging the lexer and grammar. It returns the exit code.
+func tokensMain(args []string) int {
+	fs := flag.NewFlagSet("tokens", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage:  %s tokens [options] [path ...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	jsonOutput := fs.Bool("json", false, "print tokens as JSON")
+	placeholder := fs.String("placeholder", "", "recognize placeholders: question (?), colon (:name), at (@name) or sqlc (sqlc.arg(name))")
+	dialectName := fs.String("dialect", sqlfmt.PostgreSQL.Name, "SQL dialect: "+strings.Join(sqlfmt.DialectNames(), ", "))
+	fs.Parse(args)
+
+	dialect := sqlfmt.LookupDialect(*dialectName)
+	if dialect == nil {
+		fmt.Fprintf(os.Stderr, "invalid dialect: %s\n", *dialectName)
+		fs.Usage()
+		return 2
+	}
+
+	style, ok := placeholderStyles[*placeholder]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "invalid placeholder style: %s\n", *placeholder)
+		fs.Usage()
+		return 2
+	}
+
+	var errors []error
+
+	printTokens := func(name string, r io.Reader) {
+		input, err := ioutil.ReadAll(r)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		tokens, err := sqlfmt.Tokenize(string(input), sqlfmt.WithDialect(dialect), sqlfmt.WithPlaceholderStyle(style))
+		if err != nil {
+			errors = append(errors, positionError{name: strings.TrimSuffix(name, ":"), err: err})
+		}
+
+		if *jsonOutput {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(tokens); err != nil {
+				errors = append(errors, err)
+			}
+			return
+		}
+
+		for _, t := range tokens {
+			fmt.Printf("%s%d:%d\t%s\t%q\n", name, t.Line, t.Column, t.Kind, t.Text)
+		}
+	}
+
+	if fs.NArg() > 0 {
+		for _, fp := range fs.Args() {
+			f, err := os.Open(fp)
+			if err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			printTokens(fp+":", f)
+			f.Close()
+		}
+	} else {
+		printTokens("", os.Stdin)
+	}
+
+	if len(errors) > 0 {
+		for _, e := range errors {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,124 @@
+package sqlfmt
+
+import (
+	"sort"
+	"strings"
+)
+
+// Dialect is a variant of SQL that the lexer and parser accept. It holds the
+// keyword table, the lexical rules that differ between databases and the
+// syntax features that are enabled, so that a dialect is added by defining a
+// Dialect rather than by changing the lexer.
+type Dialect struct {
+	Name string // as given to LookupDialect, such as "postgresql"
+
+	// Keywords maps the lower-case spelling of each keyword to its token.
+	// Other words are identifiers.
+	Keywords map[string]int
+
+	// IdentifierQuotes are the characters that delimit quoted identifiers.
+	IdentifierQuotes string
+
+	// StringQuotes are the characters that delimit string constants.
+	StringQuotes string
+
+	// LineComments are the prefixes of comments that run to the end of the
+	// line. /* */ comments are recognized in every dialect.
+	LineComments []string
+
+	// NestedComments makes /* */ comments nest.
+	NestedComments bool
+
+	// BackslashEscapes makes a backslash escape the character after it in
+	// every string constant, not only in E'...' strings.
+	BackslashEscapes bool
+
+	// Features is the set of syntax features beyond standard SQL that the
+	// dialect has.
+	Features Feature
+}
+
+// Feature is a syntax feature a Dialect may have. Features combine with |.
+type Feature uint
+
+const (
+	TypecastFeature           Feature = 1 << iota // expr::type casts
+	ParamFeature                                  // $1 positional parameters
+	DollarQuoteFeature                            // $$...$$ and $tag$...$tag$ strings
+	PrefixedStringFeature                         // E'...' escape strings and U&'...' Unicode escapes
+	LimitCommaFeature                             // LIMIT offset, count
+	DuplicateKeyUpdateFeature                     // INSERT ... ON DUPLICATE KEY UPDATE
+	VersionCommentFeature                         // /*! ... */ comments holding code run by some server versions
+)
+
+// Has reports whether the dialect has all of features.
+func (d *Dialect) Has(features Feature) bool {
+	return d.Features&features == features
+}
+
+// PostgreSQL is the default dialect.
+var PostgreSQL = &Dialect{
+	Name:             "postgresql",
+	Keywords:         keywords,
+	IdentifierQuotes: `"`,
+	StringQuotes:     `'`,
+	LineComments:     []string{"--"},
+	NestedComments:   true,
+	Features:         TypecastFeature | ParamFeature | DollarQuoteFeature | PrefixedStringFeature,
+}
+
+// MySQL is the dialect of MySQL and MariaDB. DUPLICATE is a keyword only in ON
+// DUPLICATE KEY UPDATE. /*! */ version comments hold code, so they are kept
+// between the tokens they are written between.
+var MySQL = &Dialect{
+	Name:             "mysql",
+	Keywords:         keywords,
+	IdentifierQuotes: "`",
+	StringQuotes:     `'"`,
+	LineComments:     []string{"#", "-- ", "--\t", "--\n", "--\r"}, // -- must be followed by whitespace
+	BackslashEscapes: true,
+	Features:         LimitCommaFeature | DuplicateKeyUpdateFeature | VersionCommentFeature,
+}
+
+var dialects = map[string]*Dialect{
+	PostgreSQL.Name: PostgreSQL,
+	MySQL.Name:      MySQL,
+	"mariadb":       MySQL,
+}
+
+// LookupDialect returns the dialect named name, ignoring case, or nil if there
+// is none.
+func LookupDialect(name string) *Dialect {
+	return dialects[strings.ToLower(name)]
+}
+
+// DialectNames returns the names of the dialects LookupDialect knows, sorted.
+func DialectNames() []string {
+	names := make([]string, 0, len(dialects))
+	for name := range dialects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WithDialect makes the lexer lex and parse the SQL of dialect d. The default
+// is PostgreSQL.
+func WithDialect(d *Dialect) LexerOption {
+	return func(l *sqlLex) {
+		if d != nil {
+			l.dialect = d
+		}
+	}
+}
+
+// atLineComment reports whether a comment running to the end of the line
+// starts at the rune just read.
+func (l *sqlLex) atLineComment() bool {
+	for _, prefix := range l.dialect.LineComments {
+		if l.available(l.start, len(prefix)) && strings.HasPrefix(l.src[l.start:], prefix) {
+			return true
+		}
+	}
+	return false
+}
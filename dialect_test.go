@@ -0,0 +1,151 @@
+package sqlfmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupDialect(t *testing.T) {
+	for _, name := range []string{"postgresql", "PostgreSQL"} {
+		if d := LookupDialect(name); d != PostgreSQL {
+			t.Errorf("%s: expected PostgreSQL, got %v", name, d)
+		}
+	}
+
+	if d := LookupDialect("oracle"); d != nil {
+		t.Errorf("expected no dialect, got %v", d)
+	}
+
+	if names := DialectNames(); !reflect.DeepEqual(names, []string{"mariadb", "mysql", "postgresql"}) {
+		t.Errorf("unexpected dialect names: %v", names)
+	}
+}
+
+// testDialect differs from PostgreSQL in each of the rules a Dialect holds.
+var testDialect = &Dialect{
+	Name:             "test",
+	Keywords:         map[string]int{"select": SELECT, "from": FROM, "as": AS},
+	IdentifierQuotes: "`",
+	StringQuotes:     `'"`,
+	LineComments:     []string{"#", "--"},
+	BackslashEscapes: true,
+}
+
+func TestTokenizeDialect(t *testing.T) {
+	src := "select `a b`, \"it's\", 'a\\'b', x::y, $1 # c\n/* /* */ from where"
+	expected := []Token{
+		{Kind: KeywordKind, Text: "select", Offset: 0, Line: 1, Column: 1},
+		{Kind: IdentifierKind, Text: "`a b`", Offset: 7, Line: 1, Column: 8},
+		{Kind: PunctuationKind, Text: ",", Offset: 12, Line: 1, Column: 13},
+		{Kind: StringKind, Text: `"it's"`, Offset: 14, Line: 1, Column: 15},
+		{Kind: PunctuationKind, Text: ",", Offset: 20, Line: 1, Column: 21},
+		{Kind: StringKind, Text: `'a\'b'`, Offset: 22, Line: 1, Column: 23},
+		{Kind: PunctuationKind, Text: ",", Offset: 28, Line: 1, Column: 29},
+		{Kind: IdentifierKind, Text: "x", Offset: 30, Line: 1, Column: 31},
+		{Kind: PunctuationKind, Text: ":", Offset: 31, Line: 1, Column: 32},
+		{Kind: PunctuationKind, Text: ":", Offset: 32, Line: 1, Column: 33},
+		{Kind: IdentifierKind, Text: "y", Offset: 33, Line: 1, Column: 34},
+		{Kind: PunctuationKind, Text: ",", Offset: 34, Line: 1, Column: 35},
+	}
+
+	tokens, err := Tokenize(src, WithDialect(testDialect))
+	if _, ok := err.(*LexError); !ok {
+		t.Fatalf("expected *LexError for $1, got %#v", err)
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected %v, got %v", expected, tokens)
+	}
+
+	expected = []Token{
+		{Kind: CommentKind, Text: "# c", Offset: 0, Line: 1, Column: 1},
+		{Kind: CommentKind, Text: "/* /* */", Offset: 4, Line: 2, Column: 1},
+		{Kind: KeywordKind, Text: "from", Offset: 13, Line: 2, Column: 10},
+		{Kind: IdentifierKind, Text: "where", Offset: 18, Line: 2, Column: 15},
+	}
+
+	tokens, err = Tokenize("# c\n/* /* */ from where", WithDialect(testDialect))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected %v, got %v", expected, tokens)
+	}
+}
+
+func TestFormatDialect(t *testing.T) {
+	src := "select `a` as b, \"c\" # c\nfrom t"
+	expected := "select\n  `a` as b,\n  \"c\" # c\nfrom\n  t\n"
+
+	output, err := Format(src, FormatOptions{ParseOptions: ParseOptions{Dialect: testDialect}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestTokenizeMySQL(t *testing.T) {
+	tests := []struct {
+		src   string
+		kinds []TokenKind
+	}{
+		{"a--1", []TokenKind{IdentifierKind, OperatorKind, OperatorKind, IntegerKind}},
+		{"a -- c\n--\nb", []TokenKind{IdentifierKind, CommentKind, CommentKind, IdentifierKind}},
+		{"a # c", []TokenKind{IdentifierKind, CommentKind}},
+		{"on duplicate key update", []TokenKind{KeywordKind, KeywordKind, KeywordKind, KeywordKind}},
+		{"duplicate", []TokenKind{IdentifierKind}},
+		{"a /*!50000 + 1 */", []TokenKind{IdentifierKind, CommentKind}},
+	}
+
+	for _, tt := range tests {
+		tokens, err := Tokenize(tt.src, WithDialect(MySQL))
+		if err != nil {
+			t.Errorf("%q: %v", tt.src, err)
+			continue
+		}
+
+		var kinds []TokenKind
+		for _, tok := range tokens {
+			kinds = append(kinds, tok.Kind)
+		}
+		if !reflect.DeepEqual(kinds, tt.kinds) {
+			t.Errorf("%q: expected %v, got %v", tt.src, tt.kinds, kinds)
+		}
+	}
+}
+
+func TestRequireFeature(t *testing.T) {
+	_, err := ParseString("select a from t limit 1, 2", ParseOptions{})
+	e, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("expected *ParseError, got %#v", err)
+	}
+	if e.Column != 24 || e.Feature != "LIMIT #,# syntax" || e.Message() != "LIMIT #,# syntax is not supported in postgresql" {
+		t.Errorf("unexpected error: %#v", e)
+	}
+
+	stmts, err := ParseString("select a from t limit 1, 2; select b", ParseOptions{Recover: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := stmts[0].(*VerbatimStmt); !ok || v.Text != "select a from t limit 1, 2" || v.Err.(*ParseError).Feature == "" {
+		t.Errorf("expected the statement to be recovered, got %#v", stmts[0])
+	}
+	if _, ok := stmts[1].(*SelectStmt); !ok {
+		t.Errorf("expected *SelectStmt, got %#v", stmts[1])
+	}
+
+	if _, err := ParseString("select a from t limit 1, 2", ParseOptions{Dialect: MySQL}); err != nil {
+		t.Errorf("MySQL: %v", err)
+	}
+
+	_, err = ParseString("insert into t values (1) on duplicate key update a = 2", ParseOptions{})
+	e, ok = err.(*ParseError)
+	if !ok {
+		t.Fatalf("expected *ParseError, got %#v", err)
+	}
+	if e.Column != 26 || e.Message() != "ON DUPLICATE KEY UPDATE is not supported in postgresql" {
+		t.Errorf("unexpected error: %#v", e)
+	}
+}
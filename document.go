@@ -0,0 +1,231 @@
+package sqlfmt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Document is a script kept parsed as it is edited, for editors that reformat
+// on every change. An edit re-lexes and reparses only the statements around
+// it and reuses the others.
+type Document struct {
+	text     string
+	opts     ParseOptions
+	segments []segment
+	err      error
+}
+
+// segment is the part of the text a statement was parsed from: its leading
+// comments, the statement, its semicolon, the comments trailing the semicolon
+// and the whitespace after them. The first segment also holds the whitespace
+// at the start of the text.
+type segment struct {
+	start, end int
+	stmt       Stmt
+}
+
+// DocumentChange describes the statements an edit replaced.
+type DocumentChange struct {
+	First   int // index of the first replaced statement
+	Removed int // number of statements replaced
+	Added   int // number of statements that replaced them
+
+	// Start and End are the byte range of the new text the added statements
+	// were parsed from.
+	Start, End int
+}
+
+// NewDocument parses src into a Document. If parsing fails, Err returns the
+// error until an edit makes the text parse.
+func NewDocument(src string, opts ParseOptions) *Document {
+	d := &Document{text: src, opts: opts}
+	d.reparse()
+	return d
+}
+
+// Text returns the current text of the document.
+func (d *Document) Text() string {
+	return d.text
+}
+
+// Statements returns the statements of the text, or nil if it failed to
+// parse.
+func (d *Document) Statements() []Stmt {
+	if d.err != nil {
+		return nil
+	}
+
+	stmts := make([]Stmt, len(d.segments))
+	for i, s := range d.segments {
+		stmts[i] = s.stmt
+	}
+	return stmts
+}
+
+// Err returns the error parsing the text failed with.
+func (d *Document) Err() error {
+	return d.err
+}
+
+// Edit replaces the bytes from start to end of the text with text and
+// reparses the statements the edit may affect. The statements after them are
+// reused, with the positions in VerbatimStmts moved. If parsing fails, the
+// text is still edited and the error is also returned by Err; the next edit
+// then reparses the whole text.
+func (d *Document) Edit(start, end int, text string) (DocumentChange, error) {
+	if start < 0 || start > end || end > len(d.text) {
+		return DocumentChange{}, fmt.Errorf("invalid edit of bytes %d-%d of %d", start, end, len(d.text))
+	}
+
+	old := d.text
+	d.text = old[:start] + text + old[end:]
+	if d.err != nil || len(d.segments) == 0 {
+		return d.reparse()
+	}
+
+	delta := len(text) - (end - start)
+	lineDelta := strings.Count(text, "\n") - strings.Count(old[start:end], "\n")
+
+	// A comment can move between a statement and the one before it, and a
+	// semicolon can be inserted or removed, so the statements on either side
+	// of the edit are reparsed too.
+	n := len(d.segments)
+	first := d.segmentAt(start) - 1
+	if first < 0 {
+		first = 0
+	}
+	last := d.segmentAt(end) + 1
+	if last > n-1 {
+		last = n - 1
+	}
+
+	// The range holds whole lines, for the snippets of errors in it and so
+	// that the statements after it keep their columns.
+	for first > 0 && old[d.segments[first].start-1] != '\n' {
+		first--
+	}
+
+	for {
+		for last < n-1 && old[d.segments[last].end-1] != '\n' {
+			last++
+		}
+
+		rs, re := d.segments[first].start, d.segments[last].end+delta
+		segments, x, err := d.parseRange(rs, re)
+		if last < n-1 && (err != nil || !d.resyncs(segments, x, last, delta)) {
+			// the edit changed how the text after the range lexes
+			last++
+			continue
+		}
+		if err != nil {
+			d.segments, d.err = nil, err
+			return DocumentChange{}, err
+		}
+
+		if last < n-1 {
+			// Parse does not record a blank line after the last statement.
+			endStmt(segments[len(segments)-1].stmt, Comments{}, x.tokens[len(x.tokens)-2].blankLineAfter)
+		}
+
+		after := d.segments[last+1:]
+		updated := make([]segment, 0, first+len(segments)+len(after))
+		updated = append(updated, d.segments[:first]...)
+		updated = append(updated, segments...)
+		for _, s := range after {
+			updated = append(updated, segment{start: s.start + delta, end: s.end + delta, stmt: moveStmt(s.stmt, delta, lineDelta)})
+		}
+		d.segments = updated
+
+		return DocumentChange{First: first, Removed: last - first + 1, Added: len(segments), Start: rs, End: re}, nil
+	}
+}
+
+// reparse parses the whole text.
+func (d *Document) reparse() (DocumentChange, error) {
+	change := DocumentChange{Removed: len(d.segments), End: len(d.text)}
+
+	d.segments, _, d.err = d.parseRange(0, len(d.text))
+	change.Added = len(d.segments)
+
+	return change, d.err
+}
+
+// segmentAt returns the index of the segment holding the byte at offset i, or
+// of the last segment if i is the end of the text.
+func (d *Document) segmentAt(i int) int {
+	j := sort.Search(len(d.segments), func(j int) bool { return d.segments[j].end > i })
+	if j == len(d.segments) {
+		j--
+	}
+	return j
+}
+
+// resyncs reports whether the parse of a range ends where the segment last
+// began to end before the edit, in the same lexer state, so that the
+// segments after it can be reused.
+func (d *Document) resyncs(segments []segment, x *sqlLex, last, delta int) bool {
+	n := len(x.tokens)
+	if n < 2 || x.tokens[n-2].typ != ';' || x.tokens[n-1].leadingOffset != x.tokens[n-1].offset {
+		return false
+	}
+	return len(segments) > 0 && segments[len(segments)-1].start == d.segments[last].start+delta
+}
+
+// parseRange parses the statements from start to end of the text into
+// segments. Positions are those in the whole text.
+func (d *Document) parseRange(start, end int) ([]segment, *sqlLex, error) {
+	x := NewSqlLexer("", d.opts.lexerOptions()...)
+	before := d.text[:start]
+	x.src = d.text[start:end]
+	x.base = start
+	x.lines = strings.Count(before, "\n")
+	x.column = utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:])
+	x.limitBytes()
+	x.stmtStarts = []int{start}
+
+	stmts, err := Parse(x)
+	if err != nil {
+		return nil, x, err
+	}
+
+	// Parse drops the empty statement after the last semicolon.
+	starts := x.stmtStarts
+
+	segments := make([]segment, len(stmts))
+	for i, stmt := range stmts {
+		segments[i] = segment{start: starts[i], end: end, stmt: stmt}
+		if i > 0 {
+			segments[i-1].end = starts[i]
+		}
+	}
+
+	return segments, x, nil
+}
+
+// moveStmt returns stmt with its source positions moved by delta bytes and
+// lineDelta lines.
+func moveStmt(stmt Stmt, delta, lineDelta int) Stmt {
+	s, ok := stmt.(*VerbatimStmt)
+	if !ok || delta == 0 && lineDelta == 0 {
+		return stmt
+	}
+
+	moved := *s
+	moved.Start += delta
+	moved.End += delta
+	switch err := s.Err.(type) {
+	case *ParseError:
+		e := *err
+		e.Offset += delta
+		e.Line += lineDelta
+		moved.Err = &e
+	case *UnsupportedError:
+		e := *err
+		e.Offset += delta
+		e.Line += lineDelta
+		moved.Err = &e
+	}
+	return &moved
+}
@@ -0,0 +1,105 @@
+package sqlfmt
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDocumentEdit(t *testing.T) {
+	src := "select 1;\n\n-- two\nselect 2; -- after two\nselect 3;\nselect 4\n"
+	d := NewDocument(src, ParseOptions{})
+	if d.Err() != nil {
+		t.Fatalf("NewDocument failed: %v", d.Err())
+	}
+
+	// Edit the last statement.
+	i := strings.Index(d.Text(), "4")
+	change, err := d.Edit(i, i+1, "42")
+	if err != nil {
+		t.Fatalf("Edit failed: %v", err)
+	}
+	expected := DocumentChange{First: 2, Removed: 2, Added: 2, Start: strings.Index(src, "select 3"), End: len(src) + 1}
+	if change != expected {
+		t.Errorf("expected %+v, got %+v", expected, change)
+	}
+
+	// Split the second statement in two.
+	i = strings.Index(d.Text(), "2;")
+	change, err = d.Edit(i+1, i+1, "; select 2.5")
+	if err != nil {
+		t.Fatalf("Edit failed: %v", err)
+	}
+	expected = DocumentChange{First: 0, Removed: 3, Added: 4, Start: 0, End: strings.Index(d.Text(), "select 42")}
+	if change != expected {
+		t.Errorf("expected %+v, got %+v", expected, change)
+	}
+
+	stmts, err := ParseString(d.Text(), ParseOptions{})
+	if err != nil {
+		t.Fatalf("ParseString failed: %v", err)
+	}
+	if !reflect.DeepEqual(d.Statements(), stmts) {
+		t.Errorf("expected %#v, got %#v", stmts, d.Statements())
+	}
+}
+
+func TestDocumentEditError(t *testing.T) {
+	d := NewDocument("select 1; select 2", ParseOptions{})
+
+	if _, err := d.Edit(10, 16, "selec"); err == nil {
+		t.Fatal("expected Edit to fail")
+	}
+	if d.Err() == nil || d.Statements() != nil {
+		t.Errorf("expected the document to have failed, got %v", d.Statements())
+	}
+
+	if _, err := d.Edit(10, 15, "select"); err != nil {
+		t.Fatalf("Edit failed: %v", err)
+	}
+	if d.Err() != nil || len(d.Statements()) != 2 {
+		t.Errorf("expected 2 statements, got %v (%v)", d.Statements(), d.Err())
+	}
+
+	if _, err := d.Edit(5, 100, ""); err == nil {
+		t.Error("expected an invalid range to fail")
+	}
+}
+
+// TestDocumentRandomEdits checks that incremental parsing gives the same
+// statements as parsing the edited text from scratch.
+func TestDocumentRandomEdits(t *testing.T) {
+	fragments := []string{";", " ", "\n", "\n\n", "-- c\n", "/* c */", "select", " 1", " a", " from t", "'", "(", ")", "$1", "selct"}
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, recover := range []bool{false, true} {
+		opts := ParseOptions{Recover: recover}
+		d := NewDocument("select 1;\n-- c\nselect a from t; select 2;\n\nselect 3 -- c\n", opts)
+
+		for i := 0; i < 2000; i++ {
+			text := d.Text()
+			start := rnd.Intn(len(text) + 1)
+			end := start
+			if rnd.Intn(2) == 0 {
+				end += rnd.Intn(len(text) - start + 1)
+				if end-start > 10 {
+					end = start + 10
+				}
+			}
+			insert := ""
+			if rnd.Intn(3) > 0 {
+				insert = fragments[rnd.Intn(len(fragments))]
+			}
+
+			_, err := d.Edit(start, end, insert)
+			stmts, expectedErr := ParseString(d.Text(), opts)
+			if (err == nil) != (expectedErr == nil) {
+				t.Fatalf("%q: expected error %v, got %v", d.Text(), expectedErr, err)
+			}
+			if !reflect.DeepEqual(d.Statements(), stmts) {
+				t.Fatalf("%q after replacing %d-%d with %q: expected %#v, got %#v", d.Text(), start, end, insert, stmts, d.Statements())
+			}
+		}
+	}
+}
@@ -6,29 +6,37 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/jackc/sqlfmt"
 )
 
 func TestIntegration(t *testing.T) {
-	// TODO - put these notes in testdata input files when sqlfmt handles comments
-	//	{inputFile: "b_expr.sql"}, // b_expr is duplicated subset of a_expr -- test its clauses
-	//	{inputFile: "in.sql"}, // TODO - fix formatting when spacing / new line is improved
+	testGoldenFiles(t, "testdata")
+}
+
+func TestIntegrationMySQL(t *testing.T) {
+	testGoldenFiles(t, "testdata/mysql", sqlfmt.WithDialect(sqlfmt.MySQL))
+}
 
-	fileInfos, err := ioutil.ReadDir("testdata")
+// testGoldenFiles formats each name.input.sql file in dir and compares the
+// result with name.golden.sql. It also formats name.golden.sql, which must
+// come out unchanged.
+func testGoldenFiles(t *testing.T, dir string, options ...sqlfmt.LexerOption) {
+	fileInfos, err := ioutil.ReadDir(dir)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	for _, fi := range fileInfos {
-		if fi.Name()[len(fi.Name())-10:] != ".input.sql" {
+		if !strings.HasSuffix(fi.Name(), ".input.sql") {
 			continue
 		}
 
 		testName := fi.Name()[:len(fi.Name())-10]
-		inputPath := path.Join("testdata", fi.Name())
-		goldenPath := path.Join("testdata", testName+".golden.sql")
+		inputPath := path.Join(dir, fi.Name())
+		goldenPath := path.Join(dir, testName+".golden.sql")
 
 		input, err := ioutil.ReadFile(inputPath)
 		if err != nil {
@@ -42,25 +50,51 @@ func TestIntegration(t *testing.T) {
 			continue
 		}
 
-		lexer := sqlfmt.NewSqlLexer(string(input))
-		stmt, err := sqlfmt.Parse(lexer)
+		output, err := formatGolden(string(input), options...)
 		if err != nil {
 			t.Errorf("%s: Given %s, %v", testName, inputPath, err)
 			continue
 		}
 
-		var outBuf bytes.Buffer
-		r := sqlfmt.NewTextRenderer(&outBuf)
-		stmt.RenderTo(r)
-
-		if outBuf.String() != string(expected) {
+		if output != string(expected) {
 			actualFileName := path.Join("tmp", fmt.Sprintf("%s.sql", testName))
-			err = ioutil.WriteFile(actualFileName, outBuf.Bytes(), os.ModePerm)
+			err = ioutil.WriteFile(actualFileName, []byte(output), os.ModePerm)
 			if err != nil {
 				t.Fatal(err)
 			}
 
 			t.Errorf("%s: Unexpected output written to %s", testName, actualFileName)
+			continue
+		}
+
+		output, err = formatGolden(string(expected), options...)
+		if err != nil {
+			t.Errorf("%s: Given %s, %v", testName, goldenPath, err)
+			continue
+		}
+		if output != string(expected) {
+			actualFileName := path.Join("tmp", fmt.Sprintf("%s.reformatted.sql", testName))
+			err = ioutil.WriteFile(actualFileName, []byte(output), os.ModePerm)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			t.Errorf("%s: Formatting %s again changed it; output written to %s", testName, goldenPath, actualFileName)
 		}
 	}
 }
+
+func formatGolden(src string, options ...sqlfmt.LexerOption) (string, error) {
+	lexer := sqlfmt.NewSqlLexer(src, options...)
+	stmts, err := sqlfmt.Parse(lexer)
+	if err != nil {
+		return "", err
+	}
+
+	var outBuf bytes.Buffer
+	r := sqlfmt.NewTextRenderer(&outBuf)
+	for _, stmt := range stmts {
+		stmt.RenderTo(r)
+	}
+	return outBuf.String(), nil
+}
@@ -2,10 +2,9 @@ package sqlfmt
 
 // Derived from PostgreSQL -- ./src/include/parser/kwlist.h
 
-var keywords map[string]int
+var keywords = make(map[string]int)
 
 func init() {
-	keywords = make(map[string]int)
 	/* name, value, category */
 	keywords["abort"] = ABORT_P
 	keywords["absolute"] = ABSOLUTE_P
@@ -41,6 +40,7 @@ func init() {
 	keywords["bit"] = BIT
 	keywords["boolean"] = BOOLEAN_P
 	keywords["both"] = BOTH
+	keywords["breadth"] = BREADTH
 	keywords["by"] = BY
 	keywords["cache"] = CACHE
 	keywords["called"] = CALLED
@@ -106,6 +106,7 @@ func init() {
 	keywords["delete"] = DELETE_P
 	keywords["delimiter"] = DELIMITER
 	keywords["delimiters"] = DELIMITERS
+	keywords["depth"] = DEPTH
 	keywords["desc"] = DESC
 	keywords["dictionary"] = DICTIONARY
 	keywords["disable"] = DISABLE_P
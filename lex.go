@@ -3,7 +3,11 @@
 package sqlfmt
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -12,50 +16,606 @@ import (
 type stateFn func(*sqlLex) stateFn
 
 type token struct {
-	typ int
-	src string
+	typ    int
+	src    string
+	offset int // byte offset of the token in the source
+	end    int // byte offset just past the token in the source
+
+	// blankLineAfter is set when a blank line follows the token and any
+	// trailing comments on its line.
+	blankLineAfter bool
+
+	leading  []Comment
+	trailing []Comment
+
+	// leadingOffset is the byte offset of the first leading comment, or of the
+	// token if it has none.
+	leadingOffset int
 }
 
 type sqlLex struct {
-	src    string
-	start  int
-	pos    int
-	width  int
-	state  stateFn
-	tokens []token
-	stmt   *SelectStmt
+	src           string // the buffered part of the source
+	r             io.Reader
+	base          int // offset in the source of src[0]
+	lines         int // newlines in the source before src
+	column        int // characters between the last newline before src and src
+	start         int
+	pos           int
+	width         int
+	state         stateFn
+	tokens        []token // from index dropped on; see dropTokens
+	dropped       int
+	comments      []token
+	withComments  []int // indexes of the tokens holding unclaimed comments, in order
+	nextToken     int
+	lastEnd       int
+	pending       []Comment
+	pendingOffset int // byte offset of the first pending comment
+	parser        yyParser
+	err           error
+	stmts         []Stmt
+	emit          func(Stmt) // see ParseFunc
+	stmtStarts    []int      // source offsets of the statements, if not nil; see Document
+
+	dialect      *Dialect
+	placeholders PlaceholderStyle
+
+	duplicateKeyUpdate bool // in the ON DUPLICATE KEY UPDATE clause of MySQL
+
+	// error recovery state; see WithErrorRecovery
+	recover       bool
+	stmtStart     int       // index of the first token of the statement of the last token read by the parser
+	nextStmtStart int       // index of the first token after the last semicolon read by the parser
+	stmtLeading   []Comment // comments before the first token of the statement
+	parseErr      error     // error reported by the parser and not yet recovered from
+
+	// trial is set for parsing a statement again (see newTrial), in which
+	// case trialErr is the index of the token of the first syntax error, or
+	// -1, and trialExpected the tokens acceptable there.
+	trial         bool
+	trialErr      int
+	trialExpected []string
+
+	limits     Limits
+	ctx        context.Context
+	statements int // started so far
+
+	// nodes are the expressions reduced so far that no node reduced after
+	// them contains, in the order of their first tokens; see nest
+	nodes []node
+
+	verbatim *VerbatimStmt // recovered statement waiting for its end
 }
 
 func (x *sqlLex) Lex(yylval *yySymType) int {
-	token := x.tokens[0]
-	x.tokens = x.tokens[1:]
+	// Appending a token can rewrite the type of the one before it (NOT to
+	// NOT_LA, for example), so a token is returned only once the token after
+	// it has been lexed. A comment after a comma is attached to the token
+	// before the comma (see appendComment), so that token waits for the token
+	// after the comma, before which the comment is lexed.
+	var token token
+	for {
+		for (x.nextToken+1 >= x.lexed() || x.token(x.nextToken+1).typ == ',' && x.nextToken+2 >= x.lexed()) && x.advance() {
+		}
+
+		token = *x.token(x.nextToken)
+		yylval.pos = x.nextToken
+		x.nextToken++
+
+		// the rest of a statement being recovered is not parsed
+		if x.verbatim == nil || token.typ == ';' || token.typ == eof {
+			break
+		}
+	}
+
+	// a semicolon belongs to the statement it ends
+	x.stmtStart = x.nextStmtStart
+	if x.recover && yylval.pos == x.stmtStart {
+		// nodes reduced before a syntax error may claim these
+		x.stmtLeading = token.leading
+	}
+	if token.typ == ';' {
+		x.nextStmtStart = x.nextToken
+		if x.stmtStarts != nil && x.nextToken < x.lexed() {
+			// the next statement begins with the comments leading its first token
+			x.stmtStarts = append(x.stmtStarts, x.token(x.nextToken).leadingOffset)
+		}
+	}
+	if x.verbatim != nil && (token.typ == ';' || token.typ == eof) {
+		x.endVerbatim(yylval.pos)
+	}
 
 	yylval.str = token.src
 	return token.typ
 }
 
-// The parser calls this method on a parse error.
+// The parser calls this method on a parse error. s is the verbose goyacc
+// message, such as "syntax error: unexpected IDENT, expecting FROM or ','".
 func (x *sqlLex) Error(s string) {
-	log.Printf("parse error: %s at character %d", s, x.start)
+	if x.trial {
+		if x.trialErr == -1 {
+			x.trialErr = x.nextToken - 1
+			x.trialExpected = expectedTokens(s)
+		}
+		return
+	}
+	if x.err != nil {
+		return // the lexer error is reported instead
+	}
+
+	e := x.syntaxError(expectedTokens(s))
+	if x.recover {
+		x.parseErr = e
+	} else {
+		x.err = e
+	}
+}
+
+// expectedTokens returns the acceptable tokens listed in the goyacc message s.
+func expectedTokens(s string) []string {
+	var expected []string
+	if i := strings.Index(s, ", expecting "); i != -1 {
+		for _, name := range strings.Split(s[i+len(", expecting "):], " or ") {
+			expected = append(expected, displayTokenName(name))
+		}
+	}
+	return expected
+}
+
+// syntaxError returns an UnsupportedError if the syntax error at the last
+// token read by the parser is due to a feature sqlfmt does not implement, and
+// a ParseError otherwise.
+func (x *sqlLex) syntaxError(expected []string) error {
+	e := x.newParseError()
+	e.Expected = expected
+
+	// classifying may lex ahead, so the snippet is taken first
+	if feature := x.unsupportedFeature(); feature != "" {
+		return &UnsupportedError{Offset: e.Offset, Line: e.Line, Column: e.Column, Feature: feature, Snippet: e.Snippet}
+	}
+
+	if i, keyword := x.suggestKeyword(); i != -1 {
+		e = x.parseErrorAt(i)
+		e.Suggestion = keyword
+	}
+	return e
+}
+
+// newParseError returns a ParseError at the last token read by the parser.
+func (x *sqlLex) newParseError() *ParseError {
+	i := x.nextToken - 1
+	if i < 0 {
+		i = 0
+	}
+	return x.parseErrorAt(i)
+}
+
+// parseErrorAt returns a ParseError at the token at index i.
+func (x *sqlLex) parseErrorAt(i int) *ParseError {
+	t := x.token(i)
+
+	e := &ParseError{Offset: t.offset, Token: t.src}
+	if t.typ == eof {
+		e.Token = ""
+	}
+	e.Line, e.Column = x.lineColumn(t.offset - x.base)
+	e.Snippet = x.snippet(t.offset - x.base)
+
+	return e
+}
+
+// requireFeature reports an error at the token at index i, which starts the
+// syntax named name, unless the dialect has feature. The grammar accepts the
+// syntax of every dialect and checks it with requireFeature.
+func (x *sqlLex) requireFeature(feature Feature, name string, i int) {
+	if x.trial || x.dialect.Has(feature) {
+		return
+	}
+
+	e := x.parseErrorAt(i)
+	e.Feature, e.Dialect = name, x.dialect.Name
+	if x.recover {
+		if x.parseErr == nil {
+			x.parseErr = e
+		}
+	} else if x.err == nil {
+		x.err = e
+	}
+}
+
+// dialectChecked returns stmt, or the statement recovered from the error
+// requireFeature reported in it when parsing with error recovery.
+func (x *sqlLex) dialectChecked(stmt Stmt) Stmt {
+	if x.parseErr == nil {
+		return stmt
+	}
+	return x.recoverStmt()
+}
+
+// recoverStmt returns the statement the parser recovered from a syntax error
+// in. The statement extends to the next semicolon or the end of the input,
+// which the parser may not have read yet.
+func (x *sqlLex) recoverStmt() *VerbatimStmt {
+	if !x.recover {
+		return &VerbatimStmt{} // Parse fails with the error
+	}
+
+	s := &VerbatimStmt{Err: x.parseErr}
+	x.parseErr = nil
+
+	// After recovering from an error, goyacc does not report another until it
+	// has shifted three tokens, and it has no yyerrok to make it report the
+	// next one at once. An error that follows within three tokens, as in
+	// "x; select )", is recovered from without calling Error, so the tokens
+	// acceptable at it are found by parsing the statement again.
+	if s.Err == nil {
+		s.Err = x.syntaxError(x.reparseExpected())
+	}
+
+	x.verbatim = s
+	if t := x.token(x.nextToken - 1); t.typ == ';' || t.typ == eof {
+		x.endVerbatim(x.nextToken - 1)
+	}
+
+	return s
 }
 
-func NewSqlLexer(src string) *sqlLex {
+// reparseExpected returns the tokens acceptable at the last token read by the
+// parser, by parsing the statement up to it again.
+func (x *sqlLex) reparseExpected() []string {
+	last := x.nextToken - 1
+	if last < x.stmtStart {
+		return nil
+	}
+
+	trial := x.newTrial(last)
+	yyNewParser().Parse(trial)
+	if trial.trialErr != last-x.stmtStart {
+		return nil
+	}
+	return trial.trialExpected
+}
+
+// endVerbatim completes the recovered statement that the token at index end
+// terminates. The statement's text is copied from the source, so the comments
+// in it are dropped from the tokens.
+func (x *sqlLex) endVerbatim(end int) {
+	s := x.verbatim
+	x.verbatim = nil
+
+	first := x.stmtStart
+	s.Start = x.token(first).offset
+	s.Text = strings.TrimRightFunc(x.src[s.Start-x.base:x.token(end).offset-x.base], unicode.IsSpace)
+	s.End = s.Start + len(s.Text)
+
+	s.Comments.Leading = x.stmtLeading
+	x.claimCommentsRange(first, end-1)
+	x.token(end).leading = nil
+}
+
+// snippet returns the line of src containing byte i followed by a line with a
+// caret under byte i.
+func (x *sqlLex) snippet(i int) string {
+	for !strings.ContainsRune(x.src[i:], '\n') && x.fill() {
+	}
+
+	start := strings.LastIndexByte(x.src[:i], '\n') + 1
+	end := strings.IndexByte(x.src[i:], '\n')
+	if end == -1 {
+		end = len(x.src)
+	} else {
+		end += i
+	}
+	line := strings.TrimRightFunc(x.src[start:end], unicode.IsSpace)
+
+	var caret []rune
+	for _, r := range x.src[start:i] {
+		if r == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+
+	return line + "\n" + string(caret) + "^\n"
+}
+
+// displayTokenName converts a goyacc token name to the form shown in a
+// ParseError.
+func displayTokenName(name string) string {
+	switch name {
+	case "$end":
+		return "end of input"
+	case "IDENT":
+		return "identifier"
+	case "ICONST", "FCONST":
+		return "number"
+	case "SCONST":
+		return "string"
+	case "BCONST", "XCONST":
+		return "bit string"
+	case "Op":
+		return "operator"
+	case "PARAM":
+		return "parameter"
+	case "PLACEHOLDER":
+		return "placeholder"
+	case "TYPECAST":
+		return "::"
+	case "DOT_DOT":
+		return ".."
+	case "COLON_EQUALS":
+		return ":="
+	case "EQUALS_GREATER":
+		return "=>"
+	case "LESS_EQUALS":
+		return "<="
+	case "GREATER_EQUALS":
+		return ">="
+	case "NOT_EQUALS":
+		return "<>"
+	}
+
+	if len(name) == 3 && name[0] == '\'' && name[2] == '\'' {
+		return name[1:2]
+	}
+
+	name = strings.TrimSuffix(name, "_LA")
+	name = strings.TrimSuffix(name, "_P")
+	return name
+}
+
+// PlaceholderStyle is a driver-specific placeholder syntax the lexer can
+// recognize in addition to PostgreSQL's $1 positional parameters.
+type PlaceholderStyle int
+
+const (
+	NoPlaceholders       PlaceholderStyle = iota
+	QuestionPlaceholders                  // ?
+	ColonPlaceholders                     // :name
+	AtPlaceholders                        // @name
+	SqlcPlaceholders                      // sqlc.arg(name) and sqlc.narg(name)
+)
+
+// LexerOption configures a lexer created by NewSqlLexer or
+// NewSqlLexerFromReader.
+type LexerOption func(*sqlLex)
+
+// WithPlaceholderStyle makes the lexer recognize placeholders written in style.
+//
+// Enabling a style takes the syntax away from PostgreSQL: with
+// QuestionPlaceholders ? is never part of an operator, with ColonPlaceholders
+// a slice such as arr[1:n] must be written arr[1 : n], and with
+// AtPlaceholders the absolute value operator must be followed by a space.
+func WithPlaceholderStyle(style PlaceholderStyle) LexerOption {
+	return func(l *sqlLex) {
+		l.placeholders = style
+	}
+}
+
+// WithErrorRecovery makes Parse recover from syntax errors. A statement that
+// cannot be parsed is returned as a VerbatimStmt holding its source text and
+// the error, and parsing resumes after the semicolon that ends it. Lexical
+// errors still fail the parse.
+func WithErrorRecovery() LexerOption {
+	return func(l *sqlLex) {
+		l.recover = true
+	}
+}
+
+// NewSqlLexer returns a lexer for src. Tokens are lexed as the parser asks for
+// them.
+func NewSqlLexer(src string, options ...LexerOption) *sqlLex {
 	x := &sqlLex{src: src,
-		tokens: make([]token, 0),
-		state:  blankState,
+		tokens:  make([]token, 0),
+		state:   blankState,
+		dialect: PostgreSQL,
 	}
 
-	for x.state != nil {
-		x.state = x.state(x)
+	for _, o := range options {
+		o(x)
 	}
+	x.limitBytes()
 
-	x.append(token{typ: eof})
+	return x
+}
 
+// NewSqlLexerFromReader returns a lexer that reads its source from r as the
+// parser asks for tokens, so the source is never held in memory all at once.
+func NewSqlLexerFromReader(r io.Reader, options ...LexerOption) *sqlLex {
+	x := NewSqlLexer("", options...)
+	x.r = r
 	return x
 }
 
+// advance runs the state machine until it appends a token. At the end of the
+// input it appends eof. It returns false if the input was already exhausted.
+func (x *sqlLex) advance() bool {
+	if x.state == nil {
+		return false
+	}
+
+	for n := len(x.tokens); len(x.tokens) == n; {
+		x.state = x.state(x)
+		if x.state == nil {
+			x.append(token{typ: eof})
+		}
+	}
+
+	if x.state != nil {
+		if err := x.checkLimits(); err != nil {
+			// the parser sees the end of the input instead of the token
+			if x.err == nil {
+				x.err = err
+			}
+			x.state = nil
+			last := &x.tokens[len(x.tokens)-1]
+			*last = token{typ: eof, offset: last.offset, end: last.offset, leading: last.leading}
+		}
+	}
+
+	return true
+}
+
+// lexAll lexes the rest of the input.
+func (x *sqlLex) lexAll() {
+	for x.advance() {
+	}
+}
+
+const readSize = 64 * 1024
+
+// fill reads more of the source into src. It returns false at the end of the
+// input or on a read error, which it records as the lexer error.
+func (l *sqlLex) fill() bool {
+	if l.r == nil {
+		return false
+	}
+
+	buf := make([]byte, readSize)
+	for {
+		n, err := l.r.Read(buf)
+		if n > 0 {
+			l.src += string(buf[:n])
+			if l.limitBytes() {
+				return true
+			}
+		}
+		if err != nil {
+			if err != io.EOF && l.err == nil {
+				l.err = err
+			}
+			l.r = nil
+			return n > 0
+		}
+		if n > 0 {
+			return true
+		}
+	}
+}
+
+// available reports whether src holds n bytes starting at i, reading more of
+// the source if needed.
+func (l *sqlLex) available(i, n int) bool {
+	for len(l.src)-i < n && l.fill() {
+	}
+	return len(l.src)-i >= n
+}
+
+// snippetContext is how much of the line before a token is kept for the
+// snippet of a ParseError.
+const snippetContext = 200
+
+// discard drops the source that is no longer needed. It keeps the current
+// token and, for the snippet of a ParseError, the line of the last token,
+// which the parser may not have read yet, or of the one before it, which may
+// be a misspelled keyword (see suggestKeyword).
+func (l *sqlLex) discard() {
+	n := l.start
+	if l.lastEnd < n {
+		n = l.lastEnd
+	}
+	if l.recover && l.stmtStart < l.lexed() {
+		// the statement may have to be copied verbatim
+		if keep := l.token(l.stmtStart).offset - l.base; keep < n {
+			n = keep
+		}
+	}
+	if len(l.tokens) > 0 {
+		keep := l.tokens[len(l.tokens)-1].offset - l.base
+		if len(l.tokens) > 1 {
+			keep = l.tokens[len(l.tokens)-2].offset - l.base
+		}
+		lineStart := keep - snippetContext
+		if lineStart < 0 {
+			lineStart = 0
+		}
+		lineStart += strings.LastIndexByte(l.src[lineStart:keep], '\n') + 1
+		if lineStart < n {
+			n = lineStart
+		}
+	}
+	if n <= 0 {
+		return
+	}
+
+	dropped := l.src[:n]
+	if i := strings.LastIndexByte(dropped, '\n'); i >= 0 {
+		l.lines += strings.Count(dropped, "\n")
+		l.column = utf8.RuneCountInString(dropped[i+1:])
+	} else {
+		l.column += utf8.RuneCountInString(dropped)
+	}
+
+	l.src = l.src[n:]
+	l.base += n
+	l.start -= n
+	l.pos -= n
+	l.lastEnd -= n
+}
+
+// lexed returns the number of tokens lexed so far, which is the index of the
+// next token.
+func (l *sqlLex) lexed() int {
+	return l.dropped + len(l.tokens)
+}
+
+// token returns the token at index i, which must not have been dropped.
+func (l *sqlLex) token(i int) *token {
+	return &l.tokens[i-l.dropped]
+}
+
+// dropTokens drops the tokens before the token at index first, and the
+// comments before them, once the parser is done with them, so that memory
+// does not grow with the length of the source. Indexes of tokens do not
+// change.
+func (l *sqlLex) dropTokens(first int) {
+	n := first - l.dropped
+	if n <= 0 {
+		return
+	}
+
+	end := l.tokens[n-1].end
+	for len(l.comments) > 0 && l.comments[0].offset < end {
+		l.comments = l.comments[1:]
+	}
+
+	// the remaining tokens are moved down so the array does not keep growing
+	kept := copy(l.tokens, l.tokens[n:])
+	for i := kept; i < len(l.tokens); i++ {
+		l.tokens[i] = token{}
+	}
+	l.tokens = l.tokens[:kept]
+	l.dropped = first
+
+	k := sort.SearchInts(l.withComments, first)
+	l.withComments = append(l.withComments[:0], l.withComments[k:]...)
+
+	i := 0
+	for i < len(l.nodes) && l.nodes[i].first < first {
+		i++
+	}
+	l.nodes = append(l.nodes[:0], l.nodes[i:]...)
+}
+
 func (l *sqlLex) append(t token) {
+	t.offset, t.end = l.base+l.start, l.base+l.pos
+	t.leading = l.pending
+	t.leadingOffset = t.offset
+	if len(l.pending) > 0 {
+		t.leadingOffset = l.pendingOffset
+	}
+	l.pending = nil
+	l.lastEnd = l.pos
+
 	l.tokens = append(l.tokens, t)
+	if len(t.leading) > 0 {
+		l.withComments = append(l.withComments, l.lexed()-1)
+	}
+
+	if t.typ != eof && (l.lexed() == 1 || len(l.tokens) > 1 && l.tokens[len(l.tokens)-2].typ == ';') {
+		l.statements++
+	}
 
 	if len(l.tokens) == 1 {
 		return
@@ -76,10 +636,157 @@ func (l *sqlLex) append(t token) {
 		if prevToken.typ == WITH {
 			prevToken.typ = WITH_LA
 		}
+	case KEY:
+		// DUPLICATE is a keyword only in ON DUPLICATE KEY UPDATE
+		if prevToken.typ == IDENT && strings.EqualFold(prevToken.src, "duplicate") && len(l.tokens) > 2 && l.tokens[len(l.tokens)-3].typ == ON {
+			prevToken.typ = DUPLICATE
+		}
+	case UPDATE:
+		if prevToken.typ == KEY && len(l.tokens) > 2 && l.tokens[len(l.tokens)-3].typ == DUPLICATE {
+			l.duplicateKeyUpdate = true
+		}
+	case VALUES:
+		// VALUES(col) refers to the row being inserted
+		if l.duplicateKeyUpdate {
+			l.tokens[len(l.tokens)-1].typ = IDENT
+		}
+	case ';':
+		l.duplicateKeyUpdate = false
 	}
 }
 
+// appendComment attaches the comment between start and pos to the previous
+// token when it follows that token on the same line, or holds it for the next
+// token otherwise.
+func (l *sqlLex) appendComment() {
+	c := Comment{Text: strings.TrimRightFunc(l.src[l.start:l.pos], unicode.IsSpace)}
+	l.comments = append(l.comments, token{src: c.Text, offset: l.base + l.start, end: l.base + l.start + len(c.Text)})
+
+	// read through the rest of the line and any whitespace after it
+	for !strings.ContainsRune(l.src[l.pos:], '\n') && l.fill() {
+	}
+	for strings.TrimLeftFunc(l.src[l.pos:], isWhitespace) == "" && l.fill() {
+	}
+
+	rest := l.src[l.pos:]
+	lineEnd := strings.IndexByte(rest, '\n')
+	if lineEnd == -1 {
+		lineEnd = len(rest)
+	}
+	restOfLine := strings.TrimSpace(rest[:lineEnd])
+
+	sameLine := len(l.tokens) > 0 && !strings.ContainsRune(l.src[l.lastEnd:l.start], '\n')
+
+	// A MySQL /*! */ version comment holds code, so it is never moved to the
+	// end of the line the way a trailing comment can be.
+	version := l.dialect.Has(VersionCommentFeature) && strings.HasPrefix(c.Text, "/*!")
+
+	switch {
+	case sameLine && !version && (restOfLine == "" || strings.HasPrefix(restOfLine, "--")):
+		c.Trailing = true
+
+		// A trailing comment after a comma describes the list item the comma
+		// ends, not the comma itself.
+		i := len(l.tokens) - 1
+		if i > 0 && l.tokens[i].typ == ',' {
+			i--
+		}
+		l.tokens[i].trailing = append(l.tokens[i].trailing, c)
+		l.markCommented(l.dropped + i)
+	case sameLine && !strings.ContainsRune(c.Text, '\n') && (endsOperand(l.tokens[len(l.tokens)-1].typ) || restOfLine != "" && strings.ContainsAny(restOfLine[:1], ",)];")):
+		// A comment in a line after an operand, or before the punctuation
+		// that ends it, as in "a /* note */, b", describes the code before it.
+		c.Inline = true
+		l.tokens[len(l.tokens)-1].trailing = append(l.tokens[len(l.tokens)-1].trailing, c)
+		l.markCommented(l.lexed() - 1)
+	default:
+		c.Inline = sameLine && !strings.ContainsRune(c.Text, '\n')
+		following := rest[:len(rest)-len(strings.TrimLeftFunc(rest, isWhitespace))]
+		c.Standalone = strings.Count(following, "\n") >= 2
+		if len(l.pending) == 0 {
+			l.pendingOffset = l.base + l.start
+		}
+		l.pending = append(l.pending, c)
+	}
+
+	l.start = l.pos
+}
+
+// lastTokenIndex returns the index of the last token belonging to the
+// production the parser is currently reducing.
+func (x *sqlLex) lastTokenIndex() int {
+	if x.parser != nil && x.parser.Lookahead() >= 0 {
+		return x.nextToken - 2
+	}
+	return x.nextToken - 1
+}
+
+// claimComments removes and returns the comments attached to the tokens from
+// first to the end of the current production. Comments claimed by a nested
+// node are not returned again.
+func (x *sqlLex) claimComments(first int) Comments {
+	return x.claimCommentsRange(first, x.lastTokenIndex())
+}
+
+// markCommented records that the token at index i holds comments.
+func (l *sqlLex) markCommented(i int) {
+	k := sort.SearchInts(l.withComments, i)
+	if k < len(l.withComments) && l.withComments[k] == i {
+		return
+	}
+	l.withComments = append(l.withComments, 0)
+	copy(l.withComments[k+1:], l.withComments[k:])
+	l.withComments[k] = i
+}
+
+// claimCommentsRange removes and returns the comments attached to the tokens
+// from first through last. Only the tokens in withComments are looked at, so
+// the nodes without comments, most of them, are cheap.
+func (x *sqlLex) claimCommentsRange(first, last int) Comments {
+	var c Comments
+	k := sort.SearchInts(x.withComments, first)
+	n := k
+	for ; n < len(x.withComments) && x.withComments[n] <= last; n++ {
+		t := x.token(x.withComments[n])
+		c.Leading = append(c.Leading, t.leading...)
+		c.Trailing = append(c.Trailing, t.trailing...)
+		t.leading = nil
+		t.trailing = nil
+	}
+	x.withComments = append(x.withComments[:k], x.withComments[n:]...)
+	return c
+}
+
+// claimTokenComments removes and returns the comments attached to the tokens
+// at indexes, such as the keywords of a node.
+func (x *sqlLex) claimTokenComments(indexes ...int) Comments {
+	var c Comments
+	for _, i := range indexes {
+		t := x.claimCommentsRange(i, i)
+		c.Leading = append(c.Leading, t.Leading...)
+		c.Trailing = append(c.Trailing, t.Trailing...)
+	}
+	return c
+}
+
+// commented wraps e with the comments attached to the tokens from first to the
+// end of the current production.
+func (x *sqlLex) commented(first int, e Expr) Expr {
+	return x.commentedRange(first, x.lastTokenIndex(), e)
+}
+
+// commentedRange wraps e with the comments attached to the tokens from first
+// through last.
+func (x *sqlLex) commentedRange(first, last int, e Expr) Expr {
+	return withComments(e, x.claimCommentsRange(first, last))
+}
+
+// next returns the next rune of the source. It keeps at least utf8.UTFMax bytes
+// after pos buffered when the source has them, so a rune is never split and
+// a peek can be followed by a short prefix check of src.
 func (l *sqlLex) next() (r rune) {
+	l.available(l.pos, utf8.UTFMax)
+
 	if l.pos >= len(l.src) {
 		l.width = 0 // because backing up from having read eof should read eof again
 		return 0
@@ -91,6 +798,116 @@ func (l *sqlLex) next() (r rune) {
 	return r
 }
 
+// LexError is an error in the source text found by the lexer, such as an
+// unterminated string.
+type LexError struct {
+	Offset int // byte offset of the offending token
+	Line   int // line of the offending token, starting at 1
+	Column int // column of the offending token in characters, starting at 1
+
+	// Description is the message followed by the position, such as
+	// "unterminated quoted string starting at 1:8".
+	Description string
+}
+
+func (e *LexError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message())
+}
+
+// Message returns the description of the error without its position.
+func (e *LexError) Message() string {
+	msg := strings.TrimSuffix(e.Description, fmt.Sprintf(" at %d:%d", e.Line, e.Column))
+	return strings.TrimSuffix(msg, " starting")
+}
+
+// ParseError is a syntax error found by the parser.
+type ParseError struct {
+	Offset   int      // byte offset of the offending token
+	Line     int      // line of the offending token, starting at 1
+	Column   int      // column of the offending token in characters, starting at 1
+	Token    string   // text of the offending token; empty at the end of the input
+	Expected []string // tokens acceptable at the offending token when there are few enough to list
+
+	// Suggestion is the keyword the offending token, an identifier, is likely
+	// a misspelling of.
+	Suggestion string
+
+	// Feature is set when the offending token starts syntax the dialect does
+	// not have, such as "LIMIT #,# syntax". Dialect is the name of the dialect.
+	Feature string
+	Dialect string
+
+	// Snippet is the source line containing the offending token followed by a
+	// line with a caret under the token.
+	Snippet string
+}
+
+// Error returns the message prefixed with line:column.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message())
+}
+
+// Message returns the description of the error without its position.
+func (e *ParseError) Message() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unexpected identifier %q; did you mean %s?", e.Token, e.Suggestion)
+	}
+	if e.Feature != "" {
+		return fmt.Sprintf("%s is not supported in %s", e.Feature, e.Dialect)
+	}
+
+	var msg string
+	if e.Token == "" {
+		msg = "syntax error at end of input"
+	} else {
+		msg = fmt.Sprintf("syntax error at or near %q", e.Token)
+	}
+
+	switch n := len(e.Expected); {
+	case n == 1:
+		msg += ", expecting " + e.Expected[0]
+	case n > 1:
+		msg += ", expecting " + strings.Join(e.Expected[:n-1], ", ") + " or " + e.Expected[n-1]
+	}
+
+	return msg
+}
+
+// errorf records a LexError for the token being lexed and stops the lexer.
+// The position is appended to the description.
+func (l *sqlLex) errorf(format string, args ...interface{}) stateFn {
+	if l.err != nil {
+		return nil // a read error
+	}
+
+	line, column := l.lineColumn(l.start)
+	l.err = &LexError{
+		Offset:      l.base + l.start,
+		Line:        line,
+		Column:      column,
+		Description: fmt.Sprintf(format, args...) + fmt.Sprintf(" at %d:%d", line, column),
+	}
+	return nil
+}
+
+// lineColumn returns the line and column of the byte offset i in src.
+func (l *sqlLex) lineColumn(i int) (line, column int) {
+	before := l.src[:i]
+	line = l.lines + strings.Count(before, "\n") + 1
+	if nl := strings.LastIndexByte(before, '\n'); nl >= 0 {
+		column = utf8.RuneCountInString(before[nl+1:]) + 1
+	} else {
+		column = l.column + utf8.RuneCountInString(before) + 1
+	}
+	return line, column
+}
+
+func (l *sqlLex) peek() rune {
+	r := l.next()
+	l.unnext()
+	return r
+}
+
 func (l *sqlLex) unnext() {
 	l.pos -= l.width
 }
@@ -106,22 +923,39 @@ func (l *sqlLex) acceptRunFunc(f func(rune) bool) {
 }
 
 func blankState(l *sqlLex) stateFn {
-	switch r := l.next(); {
+	l.discard()
+
+	r := l.next()
+	switch {
 	case r == 0:
 		return nil
 	case r == ',' || r == '(' || r == ')' || r == '[' || r == ']' || r == ';':
 		return lexSimple
-	case r == '\'':
+	case strings.ContainsRune(l.dialect.StringQuotes, r):
 		return lexStringConst
-	case r == '"':
+	case r == '$' && isDecimalDigit(l.peek()) && l.dialect.Has(ParamFeature):
+		return lexParam
+	case r == '$' && l.dialect.Has(DollarQuoteFeature):
+		return lexDollarQuotedString
+	case strings.ContainsRune(l.dialect.IdentifierQuotes, r):
 		return lexQuotedIdentifier
+	case r == '.' && isDecimalDigit(l.peek()):
+		return lexNumber
+	case l.atPlaceholder(r):
+		return lexPlaceholder
 	case r == ':' || r == '.':
 		return lexAlmostOperator
+	case r == '/' && l.peek() == '*':
+		return lexBlockComment
+	case l.atLineComment():
+		return lexLineComment
 	case isOperator(r):
 		return lexOperator
 	case r == 'b' || r == 'B' || r == 'x' || r == 'X':
 		return lexPossibleBitString
-	case unicode.IsDigit(r):
+	case r == 'e' || r == 'E' || r == 'n' || r == 'N' || r == 'u' || r == 'U':
+		return lexPossiblePrefixedString
+	case isDecimalDigit(r):
 		return lexNumber
 	case isWhitespace(r):
 		l.skipWhitespace()
@@ -129,31 +963,102 @@ func blankState(l *sqlLex) stateFn {
 	case isAlphanumeric(r):
 		return lexAlphanumeric
 	}
-	return nil
+	return l.errorf("unexpected character %q", r)
 }
 
+// lexNumber lexes an integer or numeric constant. Integers may be written in
+// decimal, or in hexadecimal, octal or binary with a 0x, 0o or 0b prefix, and
+// single underscores may separate digits. Integers that do not fit in 32 bits
+// are FCONST, as in PostgreSQL.
 func lexNumber(l *sqlLex) stateFn {
+	l.pos = l.start
+
 	typ := ICONST
-	l.acceptRunFunc(unicode.IsDigit)
-	r := l.next()
-	if r == '.' {
-		l.acceptRunFunc(unicode.IsDigit)
-		typ = FCONST
+	base := 10
+
+	if r := l.next(); r == '0' && strings.ContainsRune("xXoObB", l.peek()) {
+		prefix := unicode.ToLower(l.next())
+		var isDigit func(rune) bool
+		var name string
+		switch prefix {
+		case 'x':
+			isDigit, name = isHexDigit, "hexadecimal"
+		case 'o':
+			isDigit, name = isOctalDigit, "octal"
+		case 'b':
+			isDigit, name = isBinaryDigit, "binary"
+		}
+
+		if l.peek() == '_' {
+			l.next()
+		}
+		if !l.acceptDigits(isDigit) {
+			return l.errorf("invalid %s integer %q", name, l.src[l.start:l.pos])
+		}
+		base = 0
 	} else {
 		l.unnext()
+		l.acceptDigits(isDecimalDigit)
+
+		// "1..10" is an integer followed by DOT_DOT
+		if l.peek() == '.' && !strings.HasPrefix(l.src[l.pos:], "..") {
+			l.next()
+			l.acceptDigits(isDecimalDigit)
+			typ = FCONST
+		}
+
+		if r := l.peek(); r == 'e' || r == 'E' {
+			l.next()
+			if r := l.peek(); r == '+' || r == '-' {
+				l.next()
+			}
+			if !l.acceptDigits(isDecimalDigit) {
+				return l.errorf("trailing junk after numeric literal %q", l.src[l.start:l.pos])
+			}
+			typ = FCONST
+		}
+	}
+
+	if isIdentifierChar(l.peek()) {
+		l.acceptRunFunc(isIdentifierChar)
+		return l.errorf("trailing junk after numeric literal %q", l.src[l.start:l.pos])
 	}
+
 	t := token{src: l.src[l.start:l.pos], typ: typ}
+	if typ == ICONST {
+		if _, err := strconv.ParseInt(strings.Replace(t.src, "_", "", -1), base, 32); err != nil {
+			t.typ = FCONST
+		}
+	}
 	l.append(t)
 	l.start = l.pos
 	return blankState
 }
 
+// acceptDigits consumes a run of digits in which single underscores may
+// separate digits. It returns false if there is no digit to consume.
+func (l *sqlLex) acceptDigits(isDigit func(rune) bool) bool {
+	if !isDigit(l.peek()) {
+		return false
+	}
+
+	for {
+		l.acceptRunFunc(isDigit)
+
+		underscore := l.pos
+		if l.next() != '_' || !isDigit(l.next()) {
+			l.pos = underscore
+			return true
+		}
+	}
+}
+
 func lexAlphanumeric(l *sqlLex) stateFn {
-	l.acceptRunFunc(isAlphanumeric)
+	l.acceptRunFunc(isIdentifierChar)
 
 	t := token{src: l.src[l.start:l.pos]}
 
-	if typ, ok := keywords[strings.ToLower(t.src)]; ok {
+	if typ, ok := l.dialect.Keywords[strings.ToLower(t.src)]; ok {
 		t.typ = typ
 	} else {
 		t.typ = IDENT
@@ -164,48 +1069,225 @@ func lexAlphanumeric(l *sqlLex) stateFn {
 	return blankState
 }
 
+// lexStringConst lexes a string constant whose opening quote has just been
+// read.
 func lexStringConst(l *sqlLex) stateFn {
-	for {
-		var r rune
-		r = l.next()
-		if r == 0 {
-			return nil // error for EOF inside of string literal
+	quote, _ := utf8.DecodeLastRuneInString(l.src[:l.pos])
+	if !l.acceptQuoted(quote, l.dialect.BackslashEscapes) {
+		return l.errorf("unterminated quoted string starting")
+	}
+
+	t := token{src: l.src[l.start:l.pos]}
+	t.typ = SCONST
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+// lexEscapeStringConst lexes the body of an E'...' string, in which a
+// backslash escapes the following character.
+func lexEscapeStringConst(l *sqlLex) stateFn {
+	if !l.acceptQuoted('\'', true) {
+		return l.errorf("unterminated quoted string starting")
+	}
+
+	t := token{src: l.src[l.start:l.pos]}
+	t.typ = SCONST
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+// lexUnicodeEscape lexes the body of a U&'...' string or U&"..." identifier
+// and its optional UESCAPE clause.
+func lexUnicodeEscape(l *sqlLex) stateFn {
+	quote := l.next()
+	if !l.acceptQuoted(quote, false) {
+		if quote == '"' {
+			return l.errorf("unterminated quoted identifier starting")
 		}
+		return l.errorf("unterminated quoted string starting")
+	}
 
-		if r == '\'' {
-			r = l.next()
-			if r != '\'' {
+	t := token{src: l.src[l.start:l.pos]}
+	if quote == '"' {
+		t.typ = IDENT
+	} else {
+		t.typ = SCONST
+	}
+
+	if uescape := l.acceptUescape(); uescape != "" {
+		t.src += " " + uescape
+	}
+
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+// acceptQuoted consumes the rest of a quoted string or identifier through the
+// closing quote. A doubled quote stands for one quote character. If
+// backslashEscapes is true a backslash escapes the character after it. It
+// returns false if the input ends before the closing quote.
+func (l *sqlLex) acceptQuoted(quote rune, backslashEscapes bool) bool {
+	for {
+		r := l.next()
+		switch {
+		case r == 0:
+			return false
+		case r == '\\' && backslashEscapes:
+			if l.next() == 0 {
+				return false
+			}
+		case r == quote:
+			if l.next() != quote {
 				l.unnext()
-				t := token{src: l.src[l.start:l.pos]}
-				t.typ = SCONST
-				l.append(t)
-				l.start = l.pos
-				return blankState
+				return true
 			}
 		}
 	}
 }
 
-func lexQuotedIdentifier(l *sqlLex) stateFn {
+// acceptUescape consumes a UESCAPE 'c' clause if one follows and returns it
+// with its whitespace normalized.
+func (l *sqlLex) acceptUescape() string {
+	const uescape = "uescape"
+
+	i := l.pos
+	skipWhitespace := func() {
+		for l.available(i, 1) && l.src[i] < utf8.RuneSelf && isWhitespace(rune(l.src[i])) {
+			i++
+		}
+	}
+
+	skipWhitespace()
+	if !l.available(i, len(uescape)) || !strings.EqualFold(l.src[i:i+len(uescape)], uescape) {
+		return ""
+	}
+	keyword := l.src[i : i+len(uescape)]
+	i += len(uescape)
+
+	skipWhitespace()
+	if !l.available(i, 1) || l.src[i] != '\'' {
+		return ""
+	}
+	l.available(i+1, utf8.UTFMax+1)
+	_, width := utf8.DecodeRuneInString(l.src[i+1:])
+	if width == 0 || i+1+width >= len(l.src) || l.src[i+1+width] != '\'' {
+		return ""
+	}
+	escape := l.src[i : i+2+width]
+
+	l.pos = i + len(escape)
+	return keyword + " " + escape
+}
+
+// lexDollarQuotedString lexes a $$ or $tag$ quoted string constant. The
+// string ends at the first repetition of its opening delimiter, so the body may
+// contain dollar quotes with different tags.
+func lexDollarQuotedString(l *sqlLex) stateFn {
+	if r := l.peek(); r != '$' && !isDollarQuoteTagStart(r) {
+		return l.errorf("unexpected character '$'")
+	}
+
+	l.acceptRunFunc(isDollarQuoteTagChar)
+	if l.next() != '$' {
+		l.unnext()
+		return l.errorf("invalid dollar quote delimiter %q", l.src[l.start:l.pos])
+	}
+
+	delimiter := l.src[l.start:l.pos]
+	from := l.pos
 	for {
-		var r rune
-		r = l.next()
-		if r == 0 {
-			return nil // error for EOF inside of string literal
+		if end := strings.Index(l.src[from:], delimiter); end != -1 {
+			l.pos = from + end + len(delimiter)
+			break
 		}
 
-		if r == '"' {
-			r = l.next()
-			if r != '"' {
-				l.unnext()
-				t := token{src: l.src[l.start:l.pos]}
-				t.typ = IDENT
-				l.append(t)
-				l.start = l.pos
-				return blankState
+		// the delimiter may start in the text not yet searched
+		if from < len(l.src)-len(delimiter)+1 {
+			from = len(l.src) - len(delimiter) + 1
+		}
+		if !l.fill() {
+			return l.errorf("unterminated dollar-quoted string starting")
+		}
+	}
+
+	t := token{src: l.src[l.start:l.pos], typ: SCONST}
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+func lexParam(l *sqlLex) stateFn {
+	l.acceptRunFunc(isDecimalDigit)
+	if isIdentifierChar(l.peek()) {
+		l.acceptRunFunc(isIdentifierChar)
+		return l.errorf("trailing junk after parameter %q", l.src[l.start:l.pos])
+	}
+
+	t := token{src: l.src[l.start:l.pos], typ: PARAM}
+	if _, err := strconv.ParseInt(t.src[1:], 10, 32); err != nil {
+		return l.errorf("parameter number too large %q", t.src)
+	}
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+// atPlaceholder reports whether r, the rune just read, starts a placeholder in
+// the lexer's placeholder style.
+func (l *sqlLex) atPlaceholder(r rune) bool {
+	switch l.placeholders {
+	case QuestionPlaceholders:
+		return r == '?'
+	case ColonPlaceholders:
+		return r == ':' && isIdentifierStart(l.peek())
+	case AtPlaceholders:
+		return r == '@' && isIdentifierStart(l.peek())
+	case SqlcPlaceholders:
+		const arg, narg = "sqlc.arg(", "sqlc.narg("
+		return l.available(l.start, len(arg)) && strings.EqualFold(l.src[l.start:l.start+len(arg)], arg) ||
+			l.available(l.start, len(narg)) && strings.EqualFold(l.src[l.start:l.start+len(narg)], narg)
+	}
+	return false
+}
+
+func lexPlaceholder(l *sqlLex) stateFn {
+	switch l.placeholders {
+	case ColonPlaceholders, AtPlaceholders:
+		l.acceptRunFunc(isIdentifierChar)
+	case SqlcPlaceholders:
+		l.pos = l.start + strings.IndexByte(l.src[l.start:], '(') + 1
+		for r := l.next(); r != ')'; r = l.next() {
+			switch r {
+			case 0:
+				return l.errorf("unterminated placeholder %q", l.src[l.start:l.pos])
+			case '\'', '"':
+				if !l.acceptQuoted(r, false) {
+					return l.errorf("unterminated placeholder %q", l.src[l.start:l.pos])
+				}
 			}
 		}
 	}
+
+	t := token{src: l.src[l.start:l.pos], typ: PLACEHOLDER}
+	l.append(t)
+	l.start = l.pos
+	return blankState
+}
+
+func lexQuotedIdentifier(l *sqlLex) stateFn {
+	quote, _ := utf8.DecodeLastRuneInString(l.src[:l.pos])
+	if !l.acceptQuoted(quote, false) {
+		return l.errorf("unterminated quoted identifier starting")
+	}
+
+	t := token{src: l.src[l.start:l.pos]}
+	t.typ = IDENT
+	l.append(t)
+	l.start = l.pos
+	return blankState
 }
 
 // lexAlmostOperator is for operator-like ':' and '.' which aren't operators
@@ -214,7 +1296,7 @@ func lexAlmostOperator(l *sqlLex) stateFn {
 
 	t := token{src: l.src[l.start:l.pos]}
 	switch {
-	case t.src == "::":
+	case t.src == "::" && l.dialect.Has(TypecastFeature):
 		t.typ = TYPECAST
 	case t.src == "..":
 		t.typ = DOT_DOT
@@ -232,13 +1314,38 @@ func lexAlmostOperator(l *sqlLex) stateFn {
 }
 
 func lexOperator(l *sqlLex) stateFn {
-	l.acceptRunFunc(isOperator)
+	// an operator ends where a placeholder starts
+	for {
+		pos := l.pos
+		if r := l.next(); !isOperator(r) || l.atPlaceholder(r) {
+			l.pos = pos
+			break
+		}
+	}
 
-	if (l.pos-l.start) >= 2 && l.src[l.start:l.start+2] == "--" {
-		return lexDashDashComment
+	src := l.src[l.start:l.pos]
+
+	// comments start even in the middle of an operator
+	if i := strings.Index(src, "/*"); i > 0 {
+		src = src[:i]
+	}
+	for _, c := range l.dialect.LineComments {
+		if i := strings.Index(src, c); i > 0 {
+			src = src[:i]
+		}
 	}
 
-	t := token{src: l.src[l.start:l.pos]}
+	// A multi-character operator cannot end in + or - unless it contains one
+	// of ~!@#%^&|`?, so that a*-1 is a * -1 rather than a *- operator.
+	if !strings.ContainsAny(src, "~!@#%^&|`?") {
+		for len(src) > 1 && (src[len(src)-1] == '+' || src[len(src)-1] == '-') {
+			src = src[:len(src)-1]
+		}
+	}
+
+	l.pos = l.start + len(src)
+
+	t := token{src: src}
 	switch {
 	case t.src == "+" || t.src == "-" || t.src == "*" || t.src == "/" || t.src == "%" || t.src == "^" || t.src == "<" || t.src == ">" || t.src == "=" || t.src == "[" || t.src == "]" || t.src == ":":
 		t.typ = int(t.src[0])
@@ -260,7 +1367,7 @@ func lexOperator(l *sqlLex) stateFn {
 }
 
 func lexSimple(l *sqlLex) stateFn {
-	l.append(token{int(l.src[l.start]), l.src[l.start:l.pos]})
+	l.append(token{typ: int(l.src[l.start]), src: l.src[l.start:l.pos]})
 	l.start = l.pos
 	return blankState
 }
@@ -298,7 +1405,10 @@ func lexPossibleBitString(l *sqlLex) stateFn {
 			l.start = l.pos
 			return blankState
 		}
-		return nil // lex error
+		if r == 0 {
+			return l.errorf("unterminated bit string literal starting")
+		}
+		return l.errorf("invalid character %q in bit string literal", r)
 	} else {
 		l.unnext()
 	}
@@ -306,14 +1416,61 @@ func lexPossibleBitString(l *sqlLex) stateFn {
 	return lexAlphanumeric
 }
 
-func lexDashDashComment(l *sqlLex) stateFn {
-	var r rune
-	for r = l.next(); r != '\n'; r = l.next() {
+// lexPossiblePrefixedString lexes E'...' escape strings, N'...' national
+// character strings and U&'...' or U&"..." Unicode escapes. Anything else
+// starting with one of these letters is a keyword or identifier.
+func lexPossiblePrefixedString(l *sqlLex) stateFn {
+	switch l.src[l.start] {
+	case 'e', 'E':
+		if l.peek() == '\'' && l.dialect.Has(PrefixedStringFeature) {
+			l.next()
+			return lexEscapeStringConst
+		}
+	case 'n', 'N':
+		if l.peek() == '\'' && strings.ContainsRune(l.dialect.StringQuotes, '\'') {
+			l.next()
+			return lexStringConst
+		}
+	case 'u', 'U':
+		if l.dialect.Has(PrefixedStringFeature) && (strings.HasPrefix(l.src[l.pos:], "&'") || strings.HasPrefix(l.src[l.pos:], "&\"")) {
+			l.next()
+			return lexUnicodeEscape
+		}
 	}
 
-	// TODO - don't ignore comments
-	l.ignore()
+	return lexAlphanumeric
+}
+
+// lexLineComment lexes a comment that runs to the end of the line, such as
+// -- in PostgreSQL.
+func lexLineComment(l *sqlLex) stateFn {
+	for r := l.next(); r != '\n' && r != 0; r = l.next() {
+	}
+	l.unnext()
+
+	l.appendComment()
+	return blankState
+}
+
+// lexBlockComment lexes a /* */ comment. In PostgreSQL, block comments nest.
+func lexBlockComment(l *sqlLex) stateFn {
+	l.next() // '*'
 
+	depth := 1
+	for depth > 0 {
+		switch r := l.next(); {
+		case r == 0:
+			return l.errorf("unterminated /* comment starting")
+		case r == '/' && l.peek() == '*' && l.dialect.NestedComments:
+			l.next()
+			depth++
+		case r == '*' && l.peek() == '/':
+			l.next()
+			depth--
+		}
+	}
+
+	l.appendComment()
 	return blankState
 }
 
@@ -326,9 +1483,26 @@ func (l *sqlLex) skipWhitespace() {
 		l.unnext()
 	}
 
+	// only a blank line directly after a token and its trailing comments
+	// separates the token from what follows; one after a comment on a line of
+	// its own makes that comment Standalone instead
+	if len(l.tokens) > 0 && len(l.pending) == 0 && strings.Count(l.src[l.start:l.pos], "\n") >= 2 {
+		l.tokens[len(l.tokens)-1].blankLineAfter = true
+	}
+
 	l.ignore()
 }
 
+// endsOperand reports whether a token of type typ can end an operand, so that
+// an operator may follow it.
+func endsOperand(typ int) bool {
+	switch typ {
+	case IDENT, ICONST, FCONST, SCONST, BCONST, XCONST, PARAM, PLACEHOLDER, ')', ']':
+		return true
+	}
+	return false
+}
+
 func isWhitespace(r rune) bool {
 	return unicode.IsSpace(r)
 }
@@ -337,6 +1511,41 @@ func isAlphanumeric(r rune) bool {
 	return r == '_' || unicode.In(r, unicode.Letter, unicode.Digit)
 }
 
+func isDecimalDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isHexDigit(r rune) bool {
+	return isDecimalDigit(r) || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F'
+}
+
+func isOctalDigit(r rune) bool {
+	return '0' <= r && r <= '7'
+}
+
+func isBinaryDigit(r rune) bool {
+	return r == '0' || r == '1'
+}
+
+// isIdentifierStart reports whether r may start an unquoted identifier.
+func isIdentifierStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// isIdentifierChar reports whether r may appear after the first character of
+// an unquoted identifier.
+func isIdentifierChar(r rune) bool {
+	return r == '$' || isAlphanumeric(r)
+}
+
+func isDollarQuoteTagStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isDollarQuoteTagChar(r rune) bool {
+	return isAlphanumeric(r)
+}
+
 func isOperator(r rune) bool {
 	// list of operator characters from
 	// http://www.postgresql.org/docs/9.4/static/sql-createoperator.html
@@ -0,0 +1,466 @@
+package sqlfmt
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func lexAll(src string, options ...LexerOption) *sqlLex {
+	l := NewSqlLexer(src, options...)
+	l.lexAll()
+	return l
+}
+
+func TestLexNumber(t *testing.T) {
+	tests := []struct {
+		src    string
+		tokens []token
+	}{
+		{"42", []token{{typ: ICONST, src: "42"}}},
+		{"1_000_000", []token{{typ: ICONST, src: "1_000_000"}}},
+		{"0x1F", []token{{typ: ICONST, src: "0x1F"}}},
+		{"0O17", []token{{typ: ICONST, src: "0O17"}}},
+		{"0b_101", []token{{typ: ICONST, src: "0b_101"}}},
+		{"2147483648", []token{{typ: FCONST, src: "2147483648"}}},
+		{"0x7fffffff", []token{{typ: ICONST, src: "0x7fffffff"}}},
+		{"0x80000000", []token{{typ: FCONST, src: "0x80000000"}}},
+		{"3.14", []token{{typ: FCONST, src: "3.14"}}},
+		{".5", []token{{typ: FCONST, src: ".5"}}},
+		{"5.", []token{{typ: FCONST, src: "5."}}},
+		{"1e10", []token{{typ: FCONST, src: "1e10"}}},
+		{"1.5E-3", []token{{typ: FCONST, src: "1.5E-3"}}},
+		{".5e+2", []token{{typ: FCONST, src: ".5e+2"}}},
+		{"1..10", []token{{typ: ICONST, src: "1"}, {typ: DOT_DOT, src: ".."}, {typ: ICONST, src: "10"}}},
+		{"1+2", []token{{typ: ICONST, src: "1"}, {typ: '+', src: "+"}, {typ: ICONST, src: "2"}}},
+	}
+
+	for _, tt := range tests {
+		l := lexAll(tt.src)
+		if l.err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.src, l.err)
+			continue
+		}
+
+		// drop EOF
+		actual := l.tokens[:len(l.tokens)-1]
+		if len(actual) != len(tt.tokens) {
+			t.Errorf("%s: expected %d tokens, got %d", tt.src, len(tt.tokens), len(actual))
+			continue
+		}
+		for i := range actual {
+			if actual[i].typ != tt.tokens[i].typ || actual[i].src != tt.tokens[i].src {
+				t.Errorf("%s: expected token %d to be %v, got %v", tt.src, i, tt.tokens[i], actual[i])
+			}
+		}
+	}
+}
+
+func TestLexNumberError(t *testing.T) {
+	tests := []string{
+		"123abc",
+		"1.5x",
+		"1e",
+		"1e+",
+		"1_",
+		"1__0",
+		"0x",
+		"0xG",
+		"0b102",
+	}
+
+	for _, src := range tests {
+		l := lexAll(src)
+		if l.err == nil {
+			t.Errorf("%s: expected error but did not get one", src)
+		}
+	}
+}
+
+func TestLexParam(t *testing.T) {
+	l := lexAll("$1::int[]")
+	if l.err != nil {
+		t.Fatalf("unexpected error: %v", l.err)
+	}
+	if l.tokens[0].typ != PARAM || l.tokens[0].src != "$1" {
+		t.Errorf("expected PARAM $1, got %v", l.tokens[0])
+	}
+
+	for _, src := range []string{"$1abc", "$99999999999"} {
+		l := lexAll(src)
+		if l.err == nil {
+			t.Errorf("%s: expected error but did not get one", src)
+		}
+	}
+
+	l = lexAll("$$1$$")
+	if l.err != nil || l.tokens[0].typ != SCONST {
+		t.Errorf("expected $$1$$ to be a dollar-quoted string, got %v (%v)", l.tokens[0], l.err)
+	}
+}
+
+func TestLexPlaceholder(t *testing.T) {
+	tests := []struct {
+		style  PlaceholderStyle
+		src    string
+		tokens []token
+	}{
+		{NoPlaceholders, "a=?", []token{{typ: IDENT, src: "a"}, {typ: Op, src: "=?"}}},
+		{QuestionPlaceholders, "a=?", []token{{typ: IDENT, src: "a"}, {typ: '=', src: "="}, {typ: PLACEHOLDER, src: "?"}}},
+		{ColonPlaceholders, ":name::foo", []token{{typ: PLACEHOLDER, src: ":name"}, {typ: TYPECAST, src: "::"}, {typ: IDENT, src: "foo"}}},
+		{ColonPlaceholders, "a[1:2]", []token{{typ: IDENT, src: "a"}, {typ: '[', src: "["}, {typ: ICONST, src: "1"}, {typ: ':', src: ":"}, {typ: ICONST, src: "2"}, {typ: ']', src: "]"}}},
+		{AtPlaceholders, "@name", []token{{typ: PLACEHOLDER, src: "@name"}}},
+		{AtPlaceholders, "a@>b", []token{{typ: IDENT, src: "a"}, {typ: Op, src: "@>"}, {typ: IDENT, src: "b"}}},
+		{SqlcPlaceholders, "sqlc.arg(name)", []token{{typ: PLACEHOLDER, src: "sqlc.arg(name)"}}},
+		{SqlcPlaceholders, "sqlc.narg('a)b')", []token{{typ: PLACEHOLDER, src: "sqlc.narg('a)b')"}}},
+	}
+
+	for _, tt := range tests {
+		l := lexAll(tt.src, WithPlaceholderStyle(tt.style))
+		if l.err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.src, l.err)
+			continue
+		}
+
+		actual := l.tokens[:len(l.tokens)-1]
+		if len(actual) != len(tt.tokens) {
+			t.Errorf("%s: expected %d tokens, got %d", tt.src, len(tt.tokens), len(actual))
+			continue
+		}
+		for i := range actual {
+			if actual[i].typ != tt.tokens[i].typ || actual[i].src != tt.tokens[i].src {
+				t.Errorf("%s: expected token %d to be %v, got %v", tt.src, i, tt.tokens[i], actual[i])
+			}
+		}
+	}
+}
+
+func TestLexOperator(t *testing.T) {
+	tests := []struct {
+		src    string
+		tokens []string
+	}{
+		{"a*-1", []string{"a", "*", "-", "1"}},
+		{"x<-1", []string{"x", "<", "-", "1"}},
+		{"a+-b", []string{"a", "+", "-", "b"}},
+		{"a<=-1", []string{"a", "<=", "-", "1"}},
+		{"a<>+-1", []string{"a", "<>", "+", "-", "1"}},
+		{"a@-b", []string{"a", "@-", "b"}},
+		{"a!=-1", []string{"a", "!=-", "1"}},
+		{"a?-b", []string{"a", "?-", "b"}},
+		{"a+/* c */b", []string{"a", "+", "b"}},
+		{"a*--c\nb", []string{"a", "*", "b"}},
+		{"a@>b", []string{"a", "@>", "b"}},
+		{"a||b", []string{"a", "||", "b"}},
+	}
+
+	for _, tt := range tests {
+		l := lexAll(tt.src)
+		if l.err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.src, l.err)
+			continue
+		}
+
+		actual := l.tokens[:len(l.tokens)-1]
+		if len(actual) != len(tt.tokens) {
+			t.Errorf("%s: expected %v, got %v", tt.src, tt.tokens, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i].src != tt.tokens[i] {
+				t.Errorf("%s: expected token %d to be %q, got %q", tt.src, i, tt.tokens[i], actual[i].src)
+			}
+		}
+	}
+}
+
+func TestLexError(t *testing.T) {
+	tests := []struct {
+		src         string
+		offset      int
+		line        int
+		column      int
+		description string
+	}{
+		{"select 'foo", 7, 1, 8, "unterminated quoted string starting at 1:8"},
+		{"select\n  a,\n  \"föö", 14, 3, 3, "unterminated quoted identifier starting at 3:3"},
+		{"select ü, \"b", 11, 1, 11, "unterminated quoted identifier starting at 1:11"},
+		{"select E'\\'", 7, 1, 8, "unterminated quoted string starting at 1:8"},
+		{"select $a$foo", 7, 1, 8, "unterminated dollar-quoted string starting at 1:8"},
+		{"select b'102'", 7, 1, 8, "invalid character '2' in bit string literal at 1:8"},
+		{"select x'ab", 7, 1, 8, "unterminated bit string literal starting at 1:8"},
+		{"select 1 /* foo", 9, 1, 10, "unterminated /* comment starting at 1:10"},
+		{"select 123abc", 7, 1, 8, "trailing junk after numeric literal \"123abc\" at 1:8"},
+		{"select {", 7, 1, 8, "unexpected character '{' at 1:8"},
+	}
+
+	for _, tt := range tests {
+		l := lexAll(tt.src)
+		err, ok := l.err.(*LexError)
+		if !ok {
+			t.Errorf("%s: expected *LexError, got %#v", tt.src, l.err)
+			continue
+		}
+
+		if err.Offset != tt.offset || err.Line != tt.line || err.Column != tt.column {
+			t.Errorf("%s: expected error at %d (%d:%d), got %d (%d:%d)", tt.src, tt.offset, tt.line, tt.column, err.Offset, err.Line, err.Column)
+		}
+		if err.Description != tt.description {
+			t.Errorf("%s: expected %q, got %q", tt.src, tt.description, err.Description)
+		}
+		msg := strings.TrimSuffix(tt.description, fmt.Sprintf(" at %d:%d", tt.line, tt.column))
+		msg = strings.TrimSuffix(msg, " starting")
+		if err.Message() != msg {
+			t.Errorf("%s: expected message %q, got %q", tt.src, msg, err.Message())
+		}
+		if s := fmt.Sprintf("%d:%d: %s", tt.line, tt.column, msg); err.Error() != s {
+			t.Errorf("%s: expected error %q, got %q", tt.src, s, err.Error())
+		}
+
+		if _, parseErr := Parse(l); parseErr != err {
+			t.Errorf("%s: expected Parse to return the lex error, got %v", tt.src, parseErr)
+		}
+	}
+}
+
+func TestLexFromReader(t *testing.T) {
+	fileInfos, err := ioutil.ReadDir("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fi := range fileInfos {
+		if !strings.HasSuffix(fi.Name(), ".input.sql") {
+			continue
+		}
+
+		src, err := ioutil.ReadFile(path.Join("testdata", fi.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := lexAll(string(src))
+
+		// one byte at a time splits every rune and every lookahead
+		l := NewSqlLexerFromReader(iotest.OneByteReader(strings.NewReader(string(src))))
+		l.lexAll()
+
+		if l.err != expected.err {
+			t.Errorf("%s: expected error %v, got %v", fi.Name(), expected.err, l.err)
+		}
+		if !reflect.DeepEqual(l.tokens, expected.tokens) {
+			t.Errorf("%s: expected tokens %v, got %v", fi.Name(), expected.tokens, l.tokens)
+		}
+		if !reflect.DeepEqual(l.comments, expected.comments) {
+			t.Errorf("%s: expected comments %v, got %v", fi.Name(), expected.comments, l.comments)
+		}
+	}
+}
+
+func TestLexFromReaderError(t *testing.T) {
+	src := "select\n  a,\n  b -- comment\nfrom foo\nwhere $a$ unterminated"
+	l := NewSqlLexerFromReader(iotest.OneByteReader(strings.NewReader(src)))
+	l.lexAll()
+
+	err, ok := l.err.(*LexError)
+	if !ok {
+		t.Fatalf("expected *LexError, got %#v", l.err)
+	}
+	if err.Offset != 42 || err.Line != 5 || err.Column != 7 {
+		t.Errorf("expected error at 42 (5:7), got %d (%d:%d)", err.Offset, err.Line, err.Column)
+	}
+
+	l = NewSqlLexerFromReader(iotest.TimeoutReader(strings.NewReader(strings.Repeat("select 1 ", 10000))))
+	l.lexAll()
+	if l.err != iotest.ErrTimeout {
+		t.Errorf("expected read error, got %v", l.err)
+	}
+}
+
+func TestParseFunc(t *testing.T) {
+	const n = 1000
+	src := strings.Repeat("select a, f(b, c) -- note\nfrom t where d in (1, 2, 3);\n", n)
+	expected, err := Format(src, FormatOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := NewSqlLexerFromReader(strings.NewReader(src))
+	var buf bytes.Buffer
+	r := NewTextRenderer(&buf)
+	var stmts, maxTokens, maxSrc int
+	err = ParseFunc(l, func(stmt Stmt) {
+		stmt.RenderTo(r)
+		stmts++
+		if len(l.tokens) > maxTokens {
+			maxTokens = len(l.tokens)
+		}
+		if len(l.src) > maxSrc {
+			maxSrc = len(l.src)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stmts != n {
+		t.Errorf("expected %d statements, got %d", n, stmts)
+	}
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+
+	// only the statements not yet passed on are held
+	if maxTokens > 100 {
+		t.Errorf("expected at most 100 tokens to be held, got %d", maxTokens)
+	}
+	if maxSrc > 2*readSize {
+		t.Errorf("expected at most %d bytes of source to be held, got %d", 2*readSize, maxSrc)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		src     string
+		err     ParseError
+		message string
+	}{
+		{
+			src:     "select a,\n\tb c d from t",
+			err:     ParseError{Offset: 15, Line: 2, Column: 6, Token: "d", Expected: []string{"FETCH", "FOR", "LIMIT", "OFFSET"}, Snippet: "\tb c d from t\n\t    ^\n"},
+			message: `2:6: syntax error at or near "d", expecting FETCH, FOR, LIMIT or OFFSET`,
+		},
+		{
+			src:     "select a from",
+			err:     ParseError{Offset: 13, Line: 1, Column: 14, Snippet: "select a from\n             ^\n"},
+			message: "1:14: syntax error at end of input",
+		},
+		{
+			src:     "select 1\nfrom\n",
+			err:     ParseError{Offset: 14, Line: 3, Column: 1, Snippet: "\n^\n"},
+			message: "3:1: syntax error at end of input",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(NewSqlLexerFromReader(iotest.OneByteReader(strings.NewReader(tt.src))))
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%s: expected *ParseError, got %#v", tt.src, err)
+			continue
+		}
+
+		if pe.Offset != tt.err.Offset || pe.Line != tt.err.Line || pe.Column != tt.err.Column || pe.Token != tt.err.Token || pe.Snippet != tt.err.Snippet {
+			t.Errorf("%s: expected %#v, got %#v", tt.src, tt.err, *pe)
+		}
+		if len(tt.err.Expected) > 0 && !reflect.DeepEqual(pe.Expected, tt.err.Expected) {
+			t.Errorf("%s: expected %v, got %v", tt.src, tt.err.Expected, pe.Expected)
+		}
+		if pe.Error() != tt.message {
+			t.Errorf("%s: expected %q, got %q", tt.src, tt.message, pe.Error())
+		}
+	}
+}
+
+func TestParseErrorFromItem(t *testing.T) {
+	for _, src := range []string{
+		"select * from lateral t",
+		"select * from t as x(a int)",
+		"select * from (select 1) x(a int)",
+		"select * from t[1]",
+		"select * from (select 1) x tablesample system (1)",
+		"select * from f() tablesample system (1)",
+	} {
+		_, err := Parse(NewSqlLexer(src))
+		if _, ok := err.(*ParseError); !ok {
+			t.Errorf("%s: expected *ParseError, got %#v", src, err)
+		}
+	}
+}
+
+func TestParseErrorRecovery(t *testing.T) {
+	src := "select 1;\n-- broken\nselect a b c  from t; select 2;\nselect from\n"
+	stmts, err := Parse(NewSqlLexerFromReader(iotest.OneByteReader(strings.NewReader(src)), WithErrorRecovery()))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(stmts) != 4 {
+		t.Fatalf("expected 4 statements, got %d", len(stmts))
+	}
+
+	for i, text := range []string{1: "select a b c  from t", 3: "select from"} {
+		if text == "" {
+			if _, ok := stmts[i].(*SelectStmt); !ok {
+				t.Errorf("%d: expected *SelectStmt, got %#v", i, stmts[i])
+			}
+			continue
+		}
+
+		v, ok := stmts[i].(*VerbatimStmt)
+		if !ok {
+			t.Errorf("%d: expected *VerbatimStmt, got %#v", i, stmts[i])
+			continue
+		}
+		if v.Text != text || src[v.Start:v.End] != text {
+			t.Errorf("%d: expected %q, got %q at %d-%d", i, text, v.Text, v.Start, v.End)
+		}
+		if v.Err == nil {
+			t.Errorf("%d: expected an error", i)
+		}
+	}
+
+	v := stmts[1].(*VerbatimStmt)
+	if pe, ok := v.Err.(*ParseError); !ok || pe.Line != 3 || pe.Column != 12 {
+		t.Errorf("unexpected error %#v", v.Err)
+	}
+	if !v.Semicolon || len(v.Comments.Leading) != 1 {
+		t.Errorf("unexpected %#v", *v)
+	}
+}
+
+func TestParseErrorRecoveryAfterStatement(t *testing.T) {
+	// the error is found only after "select a b" is reduced
+	stmts, err := Parse(NewSqlLexer("x;\nselect a b) c; select 1", WithErrorRecovery()))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var texts []string
+	for _, stmt := range stmts {
+		if v, ok := stmt.(*VerbatimStmt); ok {
+			texts = append(texts, v.Text)
+		} else {
+			texts = append(texts, fmt.Sprintf("%T", stmt))
+		}
+	}
+	expected := []string{"x", "select a b) c", "*sqlfmt.SelectStmt"}
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("expected %q, got %q", expected, texts)
+	}
+}
+
+func TestParseErrorRecoveryReportsEachError(t *testing.T) {
+	// goyacc does not report the second error, which is within three tokens
+	// of the first
+	stmts, err := Parse(NewSqlLexer("x; select ) ; select 1", WithErrorRecovery()))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(stmts) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(stmts))
+	}
+
+	v, ok := stmts[1].(*VerbatimStmt)
+	if !ok {
+		t.Fatalf("expected *VerbatimStmt, got %#v", stmts[1])
+	}
+	pe, ok := v.Err.(*ParseError)
+	if !ok || pe.Token != ")" || len(pe.Expected) == 0 {
+		t.Errorf("expected a ParseError at \")\" listing the expected tokens, got %#v", v.Err)
+	}
+}
@@ -0,0 +1,164 @@
+package sqlfmt
+
+import (
+	"context"
+	"fmt"
+)
+
+// Limits bounds the resources parsing untrusted input may use. A zero field
+// means no limit. The memory the parser uses grows with the tokens of the
+// statement being parsed, so MaxBytes or MaxTokens bounds it.
+type Limits struct {
+	MaxBytes      int // length of the source
+	MaxTokens     int // number of tokens, not counting comments
+	MaxDepth      int // nesting of expressions, joins and set operations
+	MaxStatements int // number of statements, including empty ones
+}
+
+// LimitKind identifies the limit a LimitError exceeded.
+type LimitKind int
+
+const (
+	ByteLimit LimitKind = iota
+	TokenLimit
+	DepthLimit
+	StatementLimit
+)
+
+// LimitError reports input that exceeds one of the Limits.
+type LimitError struct {
+	Limit  LimitKind
+	Max    int // value of the limit
+	Offset int // byte offset at which the limit was exceeded
+	Line   int // starting at 1
+	Column int // in characters, starting at 1
+}
+
+// Error returns the message prefixed with line:column.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message())
+}
+
+// Message returns the description of the error without its position.
+func (e *LimitError) Message() string {
+	switch e.Limit {
+	case ByteLimit:
+		return fmt.Sprintf("input exceeds the limit of %d bytes", e.Max)
+	case TokenLimit:
+		return fmt.Sprintf("input exceeds the limit of %d tokens", e.Max)
+	case DepthLimit:
+		return fmt.Sprintf("nesting exceeds the limit of %d levels", e.Max)
+	case StatementLimit:
+		return fmt.Sprintf("input exceeds the limit of %d statements", e.Max)
+	}
+	return fmt.Sprintf("input exceeds limit %d of %d", e.Limit, e.Max)
+}
+
+// WithLimits makes parsing fail with a *LimitError once the input exceeds
+// limits.
+func WithLimits(limits Limits) LexerOption {
+	return func(l *sqlLex) {
+		l.limits = limits
+	}
+}
+
+// WithContext makes the lexer fail with ctx.Err() once ctx is done. As the
+// parser reads tokens as it goes, this also stops Parse.
+func WithContext(ctx context.Context) LexerOption {
+	return func(l *sqlLex) {
+		l.ctx = ctx
+	}
+}
+
+// contextCheckInterval is how many tokens are lexed between checks of the
+// context. The context is checked at the first token.
+const contextCheckInterval = 256
+
+// checkLimits returns the error for the last token appended exceeding a limit
+// or for the context being done.
+func (x *sqlLex) checkLimits() error {
+	if x.ctx != nil && (x.lexed()-1)%contextCheckInterval == 0 {
+		if err := x.ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	var kind LimitKind
+	var max int
+	switch {
+	case x.limits.MaxTokens > 0 && x.lexed() > x.limits.MaxTokens:
+		kind, max = TokenLimit, x.limits.MaxTokens
+	case x.limits.MaxStatements > 0 && x.statements > x.limits.MaxStatements:
+		kind, max = StatementLimit, x.limits.MaxStatements
+	default:
+		return nil
+	}
+
+	t := x.tokens[len(x.tokens)-1]
+	e := &LimitError{Limit: kind, Max: max, Offset: t.offset}
+	e.Line, e.Column = x.lineColumn(t.offset - x.base)
+	return e
+}
+
+// node is an expression, join or set operation the parser has reduced.
+type node struct {
+	first int // index of the first token
+	depth int // of the nodes nested in it; 0 if there are none
+}
+
+// nest records the expression, join, set operation or subquery the parser is
+// reducing, whose first token is at index first, and fails with a *LimitError
+// at the last token read if its nesting exceeds MaxDepth. The nodes reduced
+// since the token at index first are the ones nested in it.
+//
+// Nesting is measured as the parser reduces the tree rather than by counting
+// parentheses, as operators nest without them ("- - 1", "1 + 1 + 1"), and it
+// is the depth of the tree that rendering recurses through.
+func (x *sqlLex) nest(first int) {
+	n := node{first: first}
+	for len(x.nodes) > 0 && x.nodes[len(x.nodes)-1].first >= first {
+		if d := x.nodes[len(x.nodes)-1].depth + 1; d > n.depth {
+			n.depth = d
+		}
+		x.nodes = x.nodes[:len(x.nodes)-1]
+	}
+	x.nodes = append(x.nodes, n)
+
+	if max := x.limits.MaxDepth; max > 0 && n.depth > max && x.err == nil {
+		// the source of the first token may have been discarded
+		t := x.token(x.nextToken - 1)
+		e := &LimitError{Limit: DepthLimit, Max: max, Offset: t.offset}
+		e.Line, e.Column = x.lineColumn(t.offset - x.base)
+		x.stop(e)
+	}
+}
+
+// stop fails the parse with err. The parser sees the end of the input after
+// the tokens it has read.
+func (x *sqlLex) stop(err error) {
+	x.err = err
+	x.state = nil
+
+	end := x.token(x.nextToken - 1).end
+	x.tokens = append(x.tokens[:x.nextToken-x.dropped], token{typ: eof, offset: end, end: end, leadingOffset: end})
+}
+
+// limitBytes cuts the source off at the byte limit, so that the lexer sees
+// the end of the input there, and records the LimitError. It returns false if
+// the source is within the limit.
+func (l *sqlLex) limitBytes() bool {
+	max := l.limits.MaxBytes
+	if max <= 0 || l.base+len(l.src) <= max {
+		return false
+	}
+
+	l.src = l.src[:max-l.base]
+	l.r = nil
+
+	if l.err == nil {
+		e := &LimitError{Limit: ByteLimit, Max: max, Offset: max}
+		e.Line, e.Column = l.lineColumn(max - l.base)
+		l.err = e
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package sqlfmt
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		src    string
+		limits Limits
+		err    LimitError
+		msg    string
+	}{
+		{
+			src:    "select 1;\nselect 2",
+			limits: Limits{MaxBytes: 12},
+			err:    LimitError{Limit: ByteLimit, Max: 12, Offset: 12, Line: 2, Column: 3},
+			msg:    "2:3: input exceeds the limit of 12 bytes",
+		},
+		{
+			src:    "select a, b, c from t",
+			limits: Limits{MaxTokens: 5},
+			err:    LimitError{Limit: TokenLimit, Max: 5, Offset: 13, Line: 1, Column: 14},
+			msg:    "1:14: input exceeds the limit of 5 tokens",
+		},
+		{
+			src:    "select ((((1))))",
+			limits: Limits{MaxDepth: 3},
+			err:    LimitError{Limit: DepthLimit, Max: 3, Offset: 16, Line: 1, Column: 17},
+			msg:    "1:17: nesting exceeds the limit of 3 levels",
+		},
+		{
+			src:    "select - - - - 1",
+			limits: Limits{MaxDepth: 3},
+			err:    LimitError{Limit: DepthLimit, Max: 3, Offset: 16, Line: 1, Column: 17},
+			msg:    "1:17: nesting exceeds the limit of 3 levels",
+		},
+		{
+			src:    "select a, 1 + 2 * 3 - 4 + 5 from t",
+			limits: Limits{MaxDepth: 3},
+			err:    LimitError{Limit: DepthLimit, Max: 3, Offset: 28, Line: 1, Column: 29},
+			msg:    "1:29: nesting exceeds the limit of 3 levels",
+		},
+		{
+			src:    "select f(1 + -(2 * - 3))",
+			limits: Limits{MaxDepth: 4},
+			err:    LimitError{Limit: DepthLimit, Max: 4, Offset: 23, Line: 1, Column: 24},
+			msg:    "1:24: nesting exceeds the limit of 4 levels",
+		},
+		{
+			src:    "select * from (select * from (select * from (select * from t) x) x) x",
+			limits: Limits{MaxDepth: 1},
+			err:    LimitError{Limit: DepthLimit, Max: 1, Offset: 69, Line: 1, Column: 70},
+			msg:    "1:70: nesting exceeds the limit of 1 levels",
+		},
+		{
+			src:    "select * from a, lateral (select * from lateral (select * from lateral (select 1) x) x) x",
+			limits: Limits{MaxDepth: 2},
+			err:    LimitError{Limit: DepthLimit, Max: 2, Offset: 89, Line: 1, Column: 90},
+			msg:    "1:90: nesting exceeds the limit of 2 levels",
+		},
+		{
+			src:    "with a as (with b as (with c as (select 1) select 1) select 1) select 1",
+			limits: Limits{MaxDepth: 2},
+			err:    LimitError{Limit: DepthLimit, Max: 2, Offset: 63, Line: 1, Column: 64},
+			msg:    "1:64: nesting exceeds the limit of 2 levels",
+		},
+		{
+			src:    "select 1; select 2;; select 3",
+			limits: Limits{MaxStatements: 2},
+			err:    LimitError{Limit: StatementLimit, Max: 2, Offset: 19, Line: 1, Column: 20},
+			msg:    "1:20: input exceeds the limit of 2 statements",
+		},
+	}
+
+	for _, tt := range tests {
+		for _, lexer := range []*sqlLex{
+			NewSqlLexer(tt.src, WithLimits(tt.limits)),
+			NewSqlLexerFromReader(iotest.OneByteReader(strings.NewReader(tt.src)), WithLimits(tt.limits)),
+		} {
+			_, err := Parse(lexer)
+			le, ok := err.(*LimitError)
+			if !ok {
+				t.Errorf("%s: expected *LimitError, got %#v", tt.src, err)
+				continue
+			}
+			if *le != tt.err || le.Error() != tt.msg {
+				t.Errorf("%s: expected %#v (%s), got %#v (%v)", tt.src, tt.err, tt.msg, *le, le)
+			}
+		}
+	}
+
+	// within the limits
+	limits := Limits{MaxBytes: 20, MaxTokens: 7, MaxDepth: 2, MaxStatements: 2}
+	if _, err := Parse(NewSqlLexer("select ((1)); ", WithLimits(limits))); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the depth of each statement is measured on its own
+	src := strings.Repeat("select - - 1 + 2;\n", 1000)
+	if _, err := Parse(NewSqlLexer(src, WithLimits(Limits{MaxDepth: 3}))); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDepthLimitDeepNesting(t *testing.T) {
+	for _, src := range []string{
+		"select " + strings.Repeat("- ", 10000) + "1",
+		"select " + strings.Repeat("1 + ", 10000) + "1",
+		"select " + strings.Repeat("-(1 + ", 10000) + "1" + strings.Repeat(")", 10000),
+		strings.Repeat("select * from (", 5000) + "select 1" + strings.Repeat(") x", 5000),
+		"select * from t" + strings.Repeat(", lateral (select * from t", 5000) + strings.Repeat(") x", 5000),
+		strings.Repeat("with a as (", 5000) + "select 1" + strings.Repeat(") select 1", 5000),
+	} {
+		_, err := Parse(NewSqlLexer(src, WithLimits(Limits{MaxDepth: 100})))
+		if le, ok := err.(*LimitError); !ok || le.Limit != DepthLimit {
+			t.Errorf("%.20s...: expected a depth *LimitError, got %v", src, err)
+		}
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParseStringContext(ctx, "select 1", ParseOptions{})
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+
+	src := strings.Repeat("select 1, 2, 3;\n", 1000)
+	_, err = Parse(NewSqlLexer(src, WithContext(&cancelAfter{Context: context.Background(), n: 3})))
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+// cancelAfter is a context that is done from the nth call of Err on.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n--; c.n <= 0 {
+		return context.Canceled
+	}
+	return nil
+}
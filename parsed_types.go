@@ -2,20 +2,207 @@ package sqlfmt
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 )
 
-func Parse(lexer *sqlLex) (stmt *SelectStmt, err error) {
-	if rc := yyParse(lexer); rc != 0 {
+// Parse parses the statements of a script in order. Each statement is a
+// *SelectStmt, an *EmptyStmt for nothing but a semicolon or, with error
+// recovery, a *VerbatimStmt.
+func Parse(lexer *sqlLex) (stmts []Stmt, err error) {
+	lexer.parser = yyNewParser()
+	rc := lexer.parser.Parse(lexer)
+	if lexer.err != nil {
+		return nil, lexer.err
+	}
+	if rc != 0 {
 		return nil, errors.New("Parse failed")
 	}
 
-	return lexer.stmt, nil
+	stmts = lexer.stmts
+
+	// Comments after the last statement have no token to lead. They trail the
+	// last statement unless it is empty.
+	eof := lexer.claimCommentsRange(lexer.lexed()-1, lexer.lexed()-1)
+	switch last := stmts[len(stmts)-1].(type) {
+	case *SelectStmt:
+		last.Comments.Trailing = append(last.Comments.Trailing, eof.Leading...)
+	case *InsertStmt:
+		last.Comments.Trailing = append(last.Comments.Trailing, eof.Leading...)
+	case *VerbatimStmt:
+		// the comments are part of the statement's text
+	case *EmptyStmt:
+		last.Comments.Leading = append(last.Comments.Leading, eof.Leading...)
+
+		// the empty statement after the last semicolon
+		if !last.Semicolon && last.Comments.Empty() {
+			stmts = stmts[:len(stmts)-1]
+			if len(stmts) > 0 {
+				endStmt(stmts[len(stmts)-1], Comments{}, false)
+			}
+		}
+	}
+
+	return stmts, nil
+}
+
+// ParseFunc is like Parse but calls f with each statement in order instead of
+// returning them, and drops each statement and the source it was parsed from
+// once f has been called, so that a script of any length is parsed in bounded
+// memory. A statement is passed to f once the statement after it has been
+// parsed, as its end depends on what follows. If parsing fails, f has been
+// called with some of the statements before the error.
+func ParseFunc(lexer *sqlLex, f func(Stmt)) error {
+	lexer.emit = f
+	stmts, err := Parse(lexer)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range stmts {
+		f(stmt)
+	}
+	return nil
+}
+
+// flushStmts passes the statements parsed so far but the last two to the
+// function given to ParseFunc and returns the last two. The last statement may
+// still be replaced by error recovery and the one before it ended by the
+// empty statement after the last semicolon (see Parse). Without ParseFunc, it
+// returns stmts.
+func (x *sqlLex) flushStmts(stmts []Stmt) []Stmt {
+	if x.emit == nil || len(stmts) <= 2 {
+		return stmts
+	}
+
+	n := len(stmts) - 2
+	if x.err == nil {
+		for _, stmt := range stmts[:n] {
+			x.emit(stmt)
+		}
+	}
+	return []Stmt{stmts[n], stmts[n+1]}
+}
+
+// endStmt records on stmt the semicolon that ends it, the comments on the
+// semicolon and whether a blank line follows.
+func endStmt(stmt Stmt, c Comments, blankLineAfter bool) {
+	switch s := stmt.(type) {
+	case *SelectStmt:
+		s.Semicolon = true
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Leading...)
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Trailing...)
+		s.BlankLineAfter = blankLineAfter
+	case *InsertStmt:
+		s.Semicolon = true
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Leading...)
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Trailing...)
+		s.BlankLineAfter = blankLineAfter
+	case *EmptyStmt:
+		s.Semicolon = true
+		s.Comments.Leading = append(s.Comments.Leading, c.Leading...)
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Trailing...)
+		s.BlankLineAfter = blankLineAfter
+	case *VerbatimStmt:
+		s.Semicolon = true
+		s.Comments.Trailing = append(s.Comments.Trailing, c.Trailing...)
+		s.BlankLineAfter = blankLineAfter
+	}
 }
 
 type Expr interface {
 	RenderTo(Renderer)
 }
 
+// Stmt is a top level statement of a script.
+type Stmt interface {
+	RenderTo(Renderer)
+}
+
+type Comment struct {
+	Text       string
+	Trailing   bool // followed code on the same line
+	Inline     bool // between code on the same line
+	Standalone bool // separated from the following code by a blank line
+}
+
+func (c Comment) RenderTo(r Renderer) {
+	if c.Trailing {
+		r.Text(c.Text, TrailingCommentToken)
+		return
+	}
+	if c.Inline {
+		r.Text(c.Text, InlineCommentToken)
+		return
+	}
+
+	r.Text(c.Text, CommentToken)
+	if c.Standalone {
+		r.Control(BlankLineToken)
+	}
+}
+
+// Comments are the comments attached to a node. Leading comments are rendered
+// on their own lines before the node. Trailing comments are rendered at the
+// end of the line the node ends on. Inline comments are rendered where they
+// are, next to the node.
+type Comments struct {
+	Leading  []Comment
+	Trailing []Comment
+}
+
+func (c Comments) Empty() bool {
+	return len(c.Leading) == 0 && len(c.Trailing) == 0
+}
+
+func (c Comments) renderLeading(r Renderer) {
+	for _, l := range c.Leading {
+		l.RenderTo(r)
+	}
+}
+
+func (c Comments) renderTrailing(r Renderer) {
+	for _, t := range c.Trailing {
+		t.RenderTo(r)
+	}
+}
+
+// withComments returns e wrapped with c, or e if c is empty.
+func withComments(e Expr, c Comments) Expr {
+	if c.Empty() {
+		return e
+	}
+	return CommentedExpr{Expr: e, Comments: c}
+}
+
+// withParenComments returns e, a parenthesized query or list, with c added to
+// the comments on its parentheses, so that comments before it are rendered
+// before the line break that may follow it.
+func withParenComments(e Expr, c Comments) Expr {
+	switch e := e.(type) {
+	case *SelectStmt:
+		e.ParenComments.Leading = append(c.Leading, e.ParenComments.Leading...)
+		e.ParenComments.Trailing = append(c.Trailing, e.ParenComments.Trailing...)
+		return e
+	case ValuesRow:
+		e.Comments.Leading = append(c.Leading, e.Comments.Leading...)
+		e.Comments.Trailing = append(c.Trailing, e.Comments.Trailing...)
+		return e
+	}
+	return withComments(e, c)
+}
+
+type CommentedExpr struct {
+	Expr Expr
+	Comments
+}
+
+func (e CommentedExpr) RenderTo(r Renderer) {
+	e.renderLeading(r)
+	e.Expr.RenderTo(r)
+	e.renderTrailing(r)
+}
+
 type PgType struct {
 	Name         AnyName
 	OptInterval  *OptInterval
@@ -92,6 +279,35 @@ func (cr ColumnRef) RenderTo(r Renderer) {
 	}
 }
 
+// ParamRef is a positional parameter such as $1.
+type ParamRef struct {
+	Number      int
+	Indirection Indirection
+}
+
+func NewParamRef(src string, indirection Indirection) ParamRef {
+	n, _ := strconv.Atoi(src[1:])
+	return ParamRef{Number: n, Indirection: indirection}
+}
+
+func (p ParamRef) RenderTo(r Renderer) {
+	r.Text("$"+strconv.Itoa(p.Number), ConstantToken)
+	if p.Indirection != nil {
+		p.Indirection.RenderTo(r)
+	}
+}
+
+// Placeholder is a driver-specific parameter such as ? or :name. It is
+// rendered exactly as written.
+type Placeholder struct {
+	Style PlaceholderStyle
+	Text  string
+}
+
+func (p Placeholder) RenderTo(r Renderer) {
+	r.Text(p.Text, ConstantToken)
+}
+
 type Indirection []IndirectionEl
 
 func (i Indirection) RenderTo(r Renderer) {
@@ -121,10 +337,40 @@ func (ie IndirectionEl) RenderTo(r Renderer) {
 	}
 }
 
-type StringConst string
+type StringStyle int
+
+const (
+	QuotedString        StringStyle = iota // 'text'
+	DollarQuotedString  StringStyle = iota // $$text$$ or $tag$text$tag$
+	EscapeString        StringStyle = iota // E'text\n'
+	UnicodeEscapeString StringStyle = iota // U&'t\0065xt' [UESCAPE 'c']
+	NationalString      StringStyle = iota // N'text'
+)
+
+type StringConst struct {
+	Text  string // as written in the source, including the quotes
+	Style StringStyle
+	Tag   string // tag of a dollar-quoted string
+}
+
+func NewStringConst(src string) StringConst {
+	switch src[0] {
+	case '$':
+		end := strings.IndexByte(src[1:], '$')
+		return StringConst{Text: src, Style: DollarQuotedString, Tag: src[1 : end+1]}
+	case 'e', 'E':
+		return StringConst{Text: src, Style: EscapeString}
+	case 'u', 'U':
+		return StringConst{Text: src, Style: UnicodeEscapeString}
+	case 'n', 'N':
+		return StringConst{Text: src, Style: NationalString}
+	}
+
+	return StringConst{Text: src, Style: QuotedString}
+}
 
 func (s StringConst) RenderTo(r Renderer) {
-	r.Text(string(s), ConstantToken)
+	r.Text(s.Text, ConstantToken)
 }
 
 type IntegerConst string
@@ -233,10 +479,26 @@ type UnaryExpr struct {
 
 func (e UnaryExpr) RenderTo(r Renderer) {
 	e.Operator.RenderTo(r)
-	r.Control(RefuseSpaceToken)
+	// "- -1" written without the space would be a comment, and "@-1" the
+	// operator @-
+	if !startsWithPrefixOperator(e.Expr) {
+		r.Control(RefuseSpaceToken)
+	}
 	e.Expr.RenderTo(r)
 }
 
+// startsWithPrefixOperator reports whether e is rendered starting with a
+// prefix operator.
+func startsWithPrefixOperator(e Expr) bool {
+	switch e := e.(type) {
+	case UnaryExpr:
+		return true
+	case CommentedExpr:
+		return len(e.Leading) == 0 && startsWithPrefixOperator(e.Expr)
+	}
+	return false
+}
+
 type PostfixExpr struct {
 	Expr     Expr
 	Operator AnyName
@@ -278,17 +540,22 @@ func (s SubqueryOp) RenderTo(r Renderer) {
 	}
 }
 
+// WhenClause is a WHEN of a CASE. Its comments are those on its WHEN and THEN
+// keywords.
 type WhenClause struct {
-	When Expr
-	Then Expr
+	When     Expr
+	Then     Expr
+	Comments Comments
 }
 
 func (w WhenClause) RenderTo(r Renderer) {
+	w.Comments.renderLeading(r)
 	r.Text("when", KeywordToken)
 	w.When.RenderTo(r)
 	r.Text("then", KeywordToken)
-	r.Control(NewLineToken)
+	w.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 	w.Then.RenderTo(r)
 	r.Control(NewLineToken)
 	r.Control(UnindentToken)
@@ -339,19 +606,27 @@ func (b BetweenExpr) RenderTo(r Renderer) {
 	b.Right.RenderTo(r)
 }
 
+// CaseExpr is a CASE expression. Comments, DefaultComments and EndComments
+// are those on its CASE, ELSE and END keywords, which are rendered there
+// rather than around the whole expression.
 type CaseExpr struct {
-	CaseArg     Expr
-	WhenClauses []WhenClause
-	Default     Expr
+	CaseArg         Expr
+	WhenClauses     []WhenClause
+	Default         Expr
+	Comments        Comments
+	DefaultComments Comments
+	EndComments     Comments
 }
 
 func (c CaseExpr) RenderTo(r Renderer) {
+	c.Comments.renderLeading(r)
 	r.Text("case", KeywordToken)
 
 	if c.CaseArg != nil {
 		c.CaseArg.RenderTo(r)
 	}
 
+	c.Comments.renderTrailing(r)
 	r.Control(NewLineToken)
 
 	for _, w := range c.WhenClauses {
@@ -359,15 +634,19 @@ func (c CaseExpr) RenderTo(r Renderer) {
 	}
 
 	if c.Default != nil {
+		c.DefaultComments.renderLeading(r)
 		r.Text("else", KeywordToken)
-		r.Control(NewLineToken)
+		c.DefaultComments.renderTrailing(r)
 		r.Control(IndentToken)
+		r.Control(NewLineToken)
 		c.Default.RenderTo(r)
 		r.Control(NewLineToken)
 		r.Control(UnindentToken)
 	}
 
+	c.EndComments.renderLeading(r)
 	r.Text("end", KeywordToken)
+	c.EndComments.renderTrailing(r)
 	r.Control(NewLineToken)
 }
 
@@ -817,14 +1096,199 @@ func (e IsExpr) RenderTo(r Renderer) {
 }
 
 type AliasedExpr struct {
-	Expr  Expr
-	Alias string
+	Expr    Expr
+	Alias   string
+	Columns []string // column aliases of a table in a FROM clause
+
+	// ColumnDefs is the column definition list of a function in a FROM
+	// clause. Alias may then be empty.
+	ColumnDefs []ColumnDef
 }
 
 func (e AliasedExpr) RenderTo(r Renderer) {
 	e.Expr.RenderTo(r)
 	r.Text("as", KeywordToken)
-	r.Text(e.Alias, IdentifierToken)
+	if e.Alias != "" {
+		r.Text(e.Alias, IdentifierToken)
+	}
+	if e.Columns != nil {
+		r.Text("(", SymbolToken)
+		renderIdentifierList(r, e.Columns)
+		r.Text(")", SymbolToken)
+	}
+	if e.ColumnDefs != nil {
+		renderColumnDefs(r, e.Alias == "", e.ColumnDefs)
+	}
+}
+
+// ColumnDef is a column of a column definition list.
+type ColumnDef struct {
+	Name      string
+	Type      PgType
+	Collation AnyName
+}
+
+func (cd ColumnDef) RenderTo(r Renderer) {
+	r.Text(cd.Name, IdentifierToken)
+	cd.Type.RenderTo(r)
+	if cd.Collation != nil {
+		r.Text("collate", KeywordToken)
+		cd.Collation.RenderTo(r)
+	}
+}
+
+// renderColumnDefs renders a parenthesized column definition list, with a
+// space before it if it follows a keyword.
+func renderColumnDefs(r Renderer, afterKeyword bool, defs []ColumnDef) {
+	if afterKeyword {
+		r.Control(SpaceToken)
+	}
+	r.Text("(", SymbolToken)
+	for i, cd := range defs {
+		cd.RenderTo(r)
+		if i < len(defs)-1 {
+			r.Text(",", SymbolToken)
+		}
+	}
+	r.Text(")", SymbolToken)
+}
+
+// FuncTable is a function call in a FROM clause WITH ORDINALITY, or a ROWS
+// FROM of several calls.
+type FuncTable struct {
+	Func       Expr // nil for ROWS FROM
+	RowsFrom   []RowsFromItem
+	Ordinality bool
+}
+
+func (t FuncTable) RenderTo(r Renderer) {
+	if t.Func != nil {
+		t.Func.RenderTo(r)
+	} else {
+		r.Text("rows from", KeywordToken)
+		r.Control(SpaceToken)
+		r.Text("(", SymbolToken)
+		for i, item := range t.RowsFrom {
+			item.RenderTo(r)
+			if i < len(t.RowsFrom)-1 {
+				r.Text(",", SymbolToken)
+			}
+		}
+		r.Text(")", SymbolToken)
+	}
+
+	if t.Ordinality {
+		r.Text("with ordinality", KeywordToken)
+	}
+}
+
+// RowsFromItem is a function call in ROWS FROM.
+type RowsFromItem struct {
+	Func       Expr
+	ColumnDefs []ColumnDef
+}
+
+func (item RowsFromItem) RenderTo(r Renderer) {
+	item.Func.RenderTo(r)
+	if item.ColumnDefs != nil {
+		r.Text("as", KeywordToken)
+		renderColumnDefs(r, true, item.ColumnDefs)
+	}
+}
+
+// TableSample is a relation in a FROM clause read with a TABLESAMPLE clause,
+// which reads a sample of its rows.
+type TableSample struct {
+	Relation   Expr // with its alias, if any
+	Method     AnyName
+	Args       []Expr
+	Repeatable Expr // seed of REPEATABLE, nil if none
+}
+
+func (ts TableSample) RenderTo(r Renderer) {
+	ts.Relation.RenderTo(r)
+	r.Text("tablesample", KeywordToken)
+	ts.Method.RenderTo(r)
+	r.Control(SpaceToken)
+	r.Text("(", SymbolToken)
+	for i, a := range ts.Args {
+		a.RenderTo(r)
+		if i < len(ts.Args)-1 {
+			r.Text(",", SymbolToken)
+		}
+	}
+	r.Text(")", SymbolToken)
+
+	if ts.Repeatable != nil {
+		r.Text("repeatable", KeywordToken)
+		r.Control(SpaceToken)
+		r.Text("(", SymbolToken)
+		ts.Repeatable.RenderTo(r)
+		r.Text(")", SymbolToken)
+	}
+}
+
+// LateralExpr is a subquery or function call in a FROM clause that can refer
+// to the items of the FROM clause before it.
+type LateralExpr struct {
+	Expr Expr
+}
+
+func (e LateralExpr) RenderTo(r Renderer) {
+	r.Text("lateral", KeywordToken)
+	e.Expr.RenderTo(r)
+}
+
+// DerivedTable is a subquery in a FROM clause. Its query is indented inside
+// the parentheses.
+type DerivedTable struct {
+	Select  *SelectStmt
+	Alias   string
+	Columns []string
+}
+
+func (t DerivedTable) RenderTo(r Renderer) {
+	t.Select.ParenComments.renderLeading(r)
+	r.Control(SpaceToken)
+	r.Text("(", SymbolToken)
+	r.Control(NewLineToken)
+	r.Control(IndentToken)
+	s := *t.Select
+	s.ParenWrapped = false
+	s.RenderTo(r)
+	r.Control(UnindentToken)
+	r.Text(")", SymbolToken)
+	t.Select.ParenComments.renderTrailing(r)
+
+	if t.Alias != "" {
+		r.Text("as", KeywordToken)
+		r.Text(t.Alias, IdentifierToken)
+	}
+	if t.Columns != nil {
+		r.Text("(", SymbolToken)
+		renderIdentifierList(r, t.Columns)
+		r.Text(")", SymbolToken)
+	}
+}
+
+// tableAlias is the alias of an item of a FROM clause, with its column
+// aliases or column definition list.
+type tableAlias struct {
+	name       string
+	columns    []string
+	columnDefs []ColumnDef
+}
+
+// tableRef returns the item of a FROM clause that is expr with alias. A
+// parenthesized query is a DerivedTable.
+func tableRef(expr Expr, alias tableAlias) Expr {
+	if s, ok := expr.(*SelectStmt); ok && s.ParenWrapped {
+		return DerivedTable{Select: s, Alias: alias.name, Columns: alias.columns}
+	}
+	if alias.name == "" && alias.columnDefs == nil {
+		return expr
+	}
+	return AliasedExpr{Expr: expr, Alias: alias.name, Columns: alias.columns, ColumnDefs: alias.columnDefs}
 }
 
 type IntoClause struct {
@@ -849,13 +1313,16 @@ func (i IntoClause) RenderTo(r Renderer) {
 }
 
 type FromClause struct {
-	Expr Expr
+	Expr     Expr
+	Comments Comments
 }
 
 func (e FromClause) RenderTo(r Renderer) {
+	e.Comments.renderLeading(r)
 	r.Text("from", KeywordToken)
-	r.Control(NewLineToken)
+	e.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 	e.Expr.RenderTo(r)
 	r.Control(NewLineToken)
 	r.Control(UnindentToken)
@@ -902,27 +1369,55 @@ func (s JoinExpr) RenderTo(r Renderer) {
 	}
 }
 
+// ParenJoinExpr is a join in parentheses, which may be aliased. The join is
+// indented inside the parentheses.
+type ParenJoinExpr struct {
+	Join  Expr
+	Alias string
+}
+
+func (e ParenJoinExpr) RenderTo(r Renderer) {
+	r.Control(SpaceToken)
+	r.Text("(", SymbolToken)
+	r.Control(NewLineToken)
+	r.Control(IndentToken)
+	e.Join.RenderTo(r)
+	r.Control(NewLineToken)
+	r.Control(UnindentToken)
+	r.Text(")", SymbolToken)
+
+	if e.Alias != "" {
+		r.Text("as", KeywordToken)
+		r.Text(e.Alias, IdentifierToken)
+	}
+}
+
 type WhereClause struct {
-	Expr Expr
+	Expr     Expr
+	Comments Comments
 }
 
 func (e WhereClause) RenderTo(r Renderer) {
+	e.Comments.renderLeading(r)
 	r.Text("where", KeywordToken)
-	r.Control(NewLineToken)
+	e.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 	e.Expr.RenderTo(r)
 	r.Control(NewLineToken)
 	r.Control(UnindentToken)
 }
 
 type OrderExpr struct {
-	Expr  Expr
-	Order string
-	Using AnyName
-	Nulls string
+	Expr     Expr
+	Order    string
+	Using    AnyName
+	Nulls    string
+	Comments Comments
 }
 
 func (e OrderExpr) RenderTo(r Renderer) {
+	e.Comments.renderLeading(r)
 	e.Expr.RenderTo(r)
 	if e.Order != "" {
 		r.Text(e.Order, KeywordToken)
@@ -935,16 +1430,20 @@ func (e OrderExpr) RenderTo(r Renderer) {
 		r.Text("nulls", KeywordToken)
 		r.Text(e.Nulls, KeywordToken)
 	}
+	e.Comments.renderTrailing(r)
 }
 
 type OrderClause struct {
-	Exprs []OrderExpr
+	Exprs    []OrderExpr
+	Comments Comments
 }
 
 func (e OrderClause) RenderTo(r Renderer) {
+	e.Comments.renderLeading(r)
 	r.Text("order by", KeywordToken)
-	r.Control(NewLineToken)
+	e.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 
 	for i, f := range e.Exprs {
 		f.RenderTo(r)
@@ -957,13 +1456,16 @@ func (e OrderClause) RenderTo(r Renderer) {
 }
 
 type GroupByClause struct {
-	Exprs []Expr
+	Exprs    []Expr
+	Comments Comments
 }
 
 func (e GroupByClause) RenderTo(r Renderer) {
+	e.Comments.renderLeading(r)
 	r.Text("group by", KeywordToken)
-	r.Control(NewLineToken)
+	e.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 
 	for i, f := range e.Exprs {
 		f.RenderTo(r)
@@ -978,9 +1480,19 @@ func (e GroupByClause) RenderTo(r Renderer) {
 type LimitClause struct {
 	Limit  Expr
 	Offset Expr
+	Comma  bool // written LIMIT offset, count
 }
 
 func (e LimitClause) RenderTo(r Renderer) {
+	if e.Comma {
+		r.Text("limit", KeywordToken)
+		e.Offset.RenderTo(r)
+		r.Text(",", SymbolToken)
+		e.Limit.RenderTo(r)
+		r.Control(NewLineToken)
+		return
+	}
+
 	if e.Limit != nil {
 		r.Text("limit", KeywordToken)
 		e.Limit.RenderTo(r)
@@ -1128,14 +1640,17 @@ type FuncArg struct {
 	Name   string
 	NameOp string
 	Expr   Expr
+	Comments
 }
 
 func (fa FuncArg) RenderTo(r Renderer) {
+	fa.renderLeading(r)
 	if fa.Name != "" {
 		r.Text(fa.Name, IdentifierToken)
 		r.Text(fa.NameOp, SymbolToken)
 	}
 	fa.Expr.RenderTo(r)
+	fa.renderTrailing(r)
 }
 
 type CastFunc struct {
@@ -1233,31 +1748,43 @@ func (row Row) RenderTo(r Renderer) {
 	r.Text(")", SymbolToken)
 }
 
-type ValuesRow []Expr
+// ValuesRow is a row of a VALUES list. Its comments are those on its
+// parentheses and commas.
+type ValuesRow struct {
+	Exprs    []Expr
+	Comments Comments
+}
 
 func (vr ValuesRow) RenderTo(r Renderer) {
+	vr.Comments.renderLeading(r)
 	r.Text("(", SymbolToken)
 
-	for i, e := range vr {
+	for i, e := range vr.Exprs {
 		e.RenderTo(r)
-		if i < len(vr)-1 {
+		if i < len(vr.Exprs)-1 {
 			r.Text(",", SymbolToken)
 		}
 	}
 
 	r.Text(")", SymbolToken)
+	vr.Comments.renderTrailing(r)
 }
 
-type ValuesClause []ValuesRow
+type ValuesClause struct {
+	Rows     []ValuesRow
+	Comments Comments
+}
 
 func (vc ValuesClause) RenderTo(r Renderer) {
+	vc.Comments.renderLeading(r)
 	r.Text("values", KeywordToken)
-	r.Control(NewLineToken)
+	vc.Comments.renderTrailing(r)
 	r.Control(IndentToken)
+	r.Control(NewLineToken)
 
-	for i, row := range vc {
+	for i, row := range vc.Rows {
 		row.RenderTo(r)
-		if i < len(vc)-1 {
+		if i < len(vc.Rows)-1 {
 			r.Text(",", SymbolToken)
 		}
 		r.Control(NewLineToken)
@@ -1302,28 +1829,36 @@ func (wc WindowClause) RenderTo(r Renderer) {
 type WindowDefinition struct {
 	Name          string
 	Specification WindowSpecification
+	Comments      Comments
 }
 
 func (wd WindowDefinition) RenderTo(r Renderer) {
+	wd.Comments.renderLeading(r)
 	r.Text(wd.Name, IdentifierToken)
 	r.Text("as", KeywordToken)
 	r.Control(SpaceToken)
 	wd.Specification.RenderTo(r)
+	wd.Comments.renderTrailing(r)
 }
 
+// WindowSpecification is a window in parentheses. Its comments are those on
+// the opening parenthesis and the existing window name.
 type WindowSpecification struct {
 	ExistingName    string
 	PartitionClause PartitionClause
 	OrderClause     *OrderClause
 	FrameClause     *FrameClause
+	Comments        Comments
 }
 
 func (ws WindowSpecification) RenderTo(r Renderer) {
+	ws.Comments.renderLeading(r)
 	r.Text("(", SymbolToken)
 
 	if ws.ExistingName != "" {
 		r.Text(ws.ExistingName, IdentifierToken)
 	}
+	ws.Comments.renderTrailing(r)
 
 	if ws.PartitionClause != nil {
 		ws.PartitionClause.RenderTo(r)
@@ -1413,8 +1948,6 @@ func (re RelationExpr) RenderTo(r Renderer) {
 	if re.Star {
 		r.Text("*", SymbolToken)
 	}
-
-	r.Control(NewLineToken)
 }
 
 type SimpleSelect struct {
@@ -1427,7 +1960,7 @@ type SimpleSelect struct {
 	HavingClause  Expr
 	WindowClause  WindowClause
 
-	ValuesClause ValuesClause
+	ValuesClause *ValuesClause
 
 	LeftSelect  *SelectStmt
 	SetOp       string
@@ -1435,12 +1968,16 @@ type SimpleSelect struct {
 	RightSelect *SelectStmt
 
 	Table *RelationExpr
+
+	// Comments are the comments on the select keyword line.
+	Comments Comments
 }
 
 func (s SimpleSelect) RenderTo(r Renderer) {
 	if s.Table != nil {
 		r.Text("table", KeywordToken)
 		s.Table.RenderTo(r)
+		r.Control(NewLineToken)
 		return
 	}
 
@@ -1465,6 +2002,7 @@ func (s SimpleSelect) RenderTo(r Renderer) {
 		return
 	}
 
+	s.Comments.renderLeading(r)
 	r.Text("select", KeywordToken)
 
 	if s.DistinctList != nil {
@@ -1484,6 +2022,7 @@ func (s SimpleSelect) RenderTo(r Renderer) {
 		}
 
 	}
+	s.Comments.renderTrailing(r)
 
 	r.Control(NewLineToken)
 	r.Control(IndentToken)
@@ -1525,21 +2064,164 @@ func (s SimpleSelect) RenderTo(r Renderer) {
 	}
 }
 
+// WithClause is the WITH clause of a query.
+type WithClause struct {
+	Recursive bool
+	CTEs      []CommonTableExpr
+}
+
+func (wc WithClause) RenderTo(r Renderer) {
+	r.Text("with", KeywordToken)
+	if wc.Recursive {
+		r.Text("recursive", KeywordToken)
+	}
+
+	for i, cte := range wc.CTEs {
+		if i > 0 {
+			r.Text(",", SymbolToken)
+			r.Control(NewLineToken)
+		}
+		cte.RenderTo(r)
+	}
+	r.Control(NewLineToken)
+}
+
+// CommonTableExpr is a query named in a WITH clause. Its query is indented
+// inside the parentheses.
+type CommonTableExpr struct {
+	Name         string
+	Columns      []string
+	Materialized string // "materialized", "not materialized" or ""
+	Query        *SelectStmt
+	Search       *SearchClause
+	Cycle        *CycleClause
+	Comments     Comments // of the tokens from the name through the opening parenthesis
+	EndComments  Comments // of the tokens from the closing parenthesis on
+}
+
+func (cte CommonTableExpr) RenderTo(r Renderer) {
+	cte.Comments.renderLeading(r)
+	r.Text(cte.Name, IdentifierToken)
+	if cte.Columns != nil {
+		r.Text("(", SymbolToken)
+		renderIdentifierList(r, cte.Columns)
+		r.Text(")", SymbolToken)
+	}
+	cte.Comments.renderTrailing(r)
+
+	r.Text("as", KeywordToken)
+	if cte.Materialized != "" {
+		r.Text(cte.Materialized, KeywordToken)
+	}
+
+	r.Control(SpaceToken)
+	r.Text("(", SymbolToken)
+	r.Control(IndentToken)
+	r.Control(NewLineToken)
+	cte.Query.RenderTo(r)
+	r.Control(UnindentToken)
+	cte.EndComments.renderLeading(r)
+	r.Text(")", SymbolToken)
+
+	if cte.Search != nil {
+		cte.Search.RenderTo(r)
+	}
+	if cte.Cycle != nil {
+		cte.Cycle.RenderTo(r)
+	}
+	cte.EndComments.renderTrailing(r)
+}
+
+// SearchClause is the SEARCH clause of a recursive query, which adds a column
+// ordering its rows depth or breadth first.
+type SearchClause struct {
+	BreadthFirst bool
+	Columns      []string
+	SeqColumn    string
+}
+
+func (sc SearchClause) RenderTo(r Renderer) {
+	r.Text("search", KeywordToken)
+	if sc.BreadthFirst {
+		r.Text("breadth first by", KeywordToken)
+	} else {
+		r.Text("depth first by", KeywordToken)
+	}
+	renderIdentifierList(r, sc.Columns)
+	r.Text("set", KeywordToken)
+	r.Text(sc.SeqColumn, IdentifierToken)
+}
+
+// CycleClause is the CYCLE clause of a recursive query, which marks the rows
+// that close a cycle.
+type CycleClause struct {
+	Columns     []string
+	MarkColumn  string
+	MarkValue   Expr // nil for the default of true
+	MarkDefault Expr // nil for the default of false
+	PathColumn  string
+}
+
+func (cc CycleClause) RenderTo(r Renderer) {
+	r.Text("cycle", KeywordToken)
+	renderIdentifierList(r, cc.Columns)
+	r.Text("set", KeywordToken)
+	r.Text(cc.MarkColumn, IdentifierToken)
+	if cc.MarkValue != nil {
+		r.Text("to", KeywordToken)
+		cc.MarkValue.RenderTo(r)
+		r.Text("default", KeywordToken)
+		cc.MarkDefault.RenderTo(r)
+	}
+	r.Text("using", KeywordToken)
+	r.Text(cc.PathColumn, IdentifierToken)
+}
+
+// renderIdentifierList renders names separated by commas.
+func renderIdentifierList(r Renderer, names []string) {
+	for i, n := range names {
+		r.Text(n, IdentifierToken)
+		if i < len(names)-1 {
+			r.Text(",", SymbolToken)
+		}
+	}
+}
+
 type SelectStmt struct {
+	WithClause *WithClause
 	SimpleSelect
 	OrderClause   *OrderClause
 	LimitClause   *LimitClause
 	LockingClause *LockingClause
 
 	ParenWrapped bool
-	Semicolon    bool
+
+	// ParenComments are the comments on the parentheses of a ParenWrapped
+	// query and those in it that none of its parts has. They are rendered
+	// around the parentheses.
+	ParenComments Comments
+
+	// Semicolon and BlankLineAfter are set on a top level statement that is
+	// ended by a semicolon and separated from the next statement by a blank
+	// line.
+	Semicolon      bool
+	BlankLineAfter bool
+
+	Comments Comments
 }
 
 func (s SelectStmt) RenderTo(r Renderer) {
+	s.Comments.renderLeading(r)
+
 	if s.ParenWrapped {
+		s.ParenComments.renderLeading(r)
 		r.Text("(", SymbolToken)
 	}
 
+	if s.WithClause != nil {
+		s.WithClause.RenderTo(r)
+	}
+
 	s.SimpleSelect.RenderTo(r)
 
 	if s.OrderClause != nil {
@@ -1556,12 +2238,183 @@ func (s SelectStmt) RenderTo(r Renderer) {
 
 	if s.ParenWrapped {
 		r.Text(")", SymbolToken)
+		s.ParenComments.renderTrailing(r)
+		r.Control(NewLineToken)
+	}
+
+	if s.Semicolon {
+		r.Text(";", SymbolToken)
+	}
+
+	if len(s.Comments.Trailing) > 0 {
+		s.Comments.renderTrailing(r)
+		r.Control(NewLineToken)
+	} else if s.Semicolon {
+		r.Control(NewLineToken)
+	}
+
+	if s.BlankLineAfter {
+		r.Control(BlankLineToken)
+	}
+}
+
+// InsertStmt is an INSERT statement.
+type InsertStmt struct {
+	Table   AnyName
+	Alias   string
+	Columns []ColumnRef
+	Select  *SelectStmt
+
+	// OnDuplicateKeyUpdate is the MySQL ON DUPLICATE KEY UPDATE clause.
+	OnDuplicateKeyUpdate []SetClause
+
+	Semicolon      bool
+	BlankLineAfter bool
+
+	Comments       Comments
+	InsertComments Comments // of INSERT
+	IntoComments   Comments // of INTO
+	TableComments  Comments // of the table and its alias
+	ColumnComments Comments // of the column list
+}
+
+func (s InsertStmt) RenderTo(r Renderer) {
+	s.Comments.renderLeading(r)
+
+	s.InsertComments.renderLeading(r)
+	r.Text("insert", KeywordToken)
+	s.InsertComments.renderTrailing(r)
+	s.IntoComments.renderLeading(r)
+	r.Text("into", KeywordToken)
+	s.IntoComments.renderTrailing(r)
+	s.TableComments.renderLeading(r)
+	s.Table.RenderTo(r)
+	if s.Alias != "" {
+		r.Text("as", KeywordToken)
+		r.Text(s.Alias, IdentifierToken)
+	}
+	s.TableComments.renderTrailing(r)
+
+	if s.Columns != nil {
+		s.ColumnComments.renderLeading(r)
+		r.Control(SpaceToken)
+		r.Text("(", SymbolToken)
+		for i, c := range s.Columns {
+			c.RenderTo(r)
+			if i < len(s.Columns)-1 {
+				r.Text(",", SymbolToken)
+			}
+		}
+		r.Text(")", SymbolToken)
+		s.ColumnComments.renderTrailing(r)
+	}
+	r.Control(NewLineToken)
+
+	s.Select.RenderTo(r)
+
+	if s.OnDuplicateKeyUpdate != nil {
+		r.Text("on duplicate key update", KeywordToken)
+		r.Control(NewLineToken)
+		r.Control(IndentToken)
+		for i, sc := range s.OnDuplicateKeyUpdate {
+			sc.RenderTo(r)
+			if i < len(s.OnDuplicateKeyUpdate)-1 {
+				r.Text(",", SymbolToken)
+			}
+			r.Control(NewLineToken)
+		}
+		r.Control(UnindentToken)
+	}
+
+	if s.Semicolon {
+		r.Text(";", SymbolToken)
+	}
+
+	if len(s.Comments.Trailing) > 0 {
+		s.Comments.renderTrailing(r)
+		r.Control(NewLineToken)
+	} else if s.Semicolon {
 		r.Control(NewLineToken)
 	}
 
+	if s.BlankLineAfter {
+		r.Control(BlankLineToken)
+	}
+}
+
+// SetClause assigns a column, as in ON DUPLICATE KEY UPDATE.
+type SetClause struct {
+	Target ColumnRef
+	Value  Expr
+}
+
+func (sc SetClause) RenderTo(r Renderer) {
+	sc.Target.RenderTo(r)
+	r.Text("=", SymbolToken)
+	sc.Value.RenderTo(r)
+}
+
+// VerbatimStmt is a statement that could not be parsed when parsing with
+// error recovery. It is rendered exactly as written.
+type VerbatimStmt struct {
+	Text  string
+	Start int   // byte offset of Text in the source
+	End   int   // byte offset just past Text in the source
+	Err   error // why the statement could not be parsed: a *ParseError or *UnsupportedError
+
+	Semicolon      bool
+	BlankLineAfter bool
+
+	Comments Comments
+}
+
+func (s VerbatimStmt) RenderTo(r Renderer) {
+	s.Comments.renderLeading(r)
+
+	r.Text(s.Text, ConstantToken)
+	r.Control(NewLineToken)
+
 	if s.Semicolon {
 		r.Text(";", SymbolToken)
+	}
+
+	if len(s.Comments.Trailing) > 0 {
+		s.Comments.renderTrailing(r)
 		r.Control(NewLineToken)
+	} else if s.Semicolon {
+		r.Control(NewLineToken)
+	}
+
+	if s.BlankLineAfter {
+		r.Control(BlankLineToken)
+	}
+}
+
+// EmptyStmt is a statement with nothing but its semicolon, such as the second
+// statement of "select 1;;".
+type EmptyStmt struct {
+	Semicolon      bool
+	BlankLineAfter bool
+
+	Comments Comments
+}
+
+func (s EmptyStmt) RenderTo(r Renderer) {
+	s.Comments.renderLeading(r)
+
+	if s.Semicolon {
+		r.Text(";", SymbolToken)
+	}
+
+	if len(s.Comments.Trailing) > 0 {
+		s.Comments.renderTrailing(r)
+		r.Control(NewLineToken)
+	} else if s.Semicolon {
+		r.Control(NewLineToken)
+	}
+
+	if s.BlankLineAfter {
+		r.Control(BlankLineToken)
 	}
 }
 
@@ -2,6 +2,7 @@ package sqlfmt
 
 import (
 	"io"
+	"sort"
 	"strings"
 )
 
@@ -16,6 +17,16 @@ const (
 	NewLineToken     = iota
 	IndentToken      = iota
 	UnindentToken    = iota
+
+	// CommentToken is a comment rendered on a line of its own.
+	CommentToken = iota
+	// TrailingCommentToken is a comment rendered at the end of the current line.
+	TrailingCommentToken = iota
+	// BlankLineToken ends the current line and leaves one empty line after it.
+	BlankLineToken = iota
+	// InlineCommentToken is a /* */ comment rendered between the tokens around
+	// it, or on a line of its own at the start of a line.
+	InlineCommentToken = iota
 )
 
 type RenderToken struct {
@@ -35,7 +46,10 @@ type TextRenderer struct {
 	indent          string
 	lineIndented    bool
 	newLine         bool
+	blankLine       bool
 	lastRenderToken RenderToken
+	trailing        []string
+	pending         []string // trailing comments written on lines of their own before the next line
 
 	UpperCase bool
 }
@@ -46,9 +60,9 @@ func (left RenderToken) SpaceBetween(right RenderToken) bool {
 	}
 
 	switch left.Type {
-	case KeywordToken, IdentifierToken, ConstantToken:
+	case KeywordToken, IdentifierToken, ConstantToken, InlineCommentToken:
 		switch right.Type {
-		case KeywordToken, IdentifierToken, ConstantToken:
+		case KeywordToken, IdentifierToken, ConstantToken, InlineCommentToken:
 			return true
 		case SymbolToken:
 			switch right.Value {
@@ -89,17 +103,27 @@ func NewTextRenderer(w io.Writer) *TextRenderer {
 }
 
 func (tr *TextRenderer) Text(val string, tokenType int) {
-	if !tr.lineIndented {
-		for i := 0; i < tr.indentLvl; i++ {
-			_, tr.err = io.WriteString(tr.w, tr.indent)
-			if tr.err != nil {
-				return
-			}
+	switch tokenType {
+	case CommentToken:
+		tr.renderComment(val)
+		return
+	case TrailingCommentToken:
+		if tr.lineIndented {
+			tr.trailing = append(tr.trailing, val)
+		} else {
+			tr.renderComment(val)
+		}
+		return
+	case InlineCommentToken:
+		if !tr.lineIndented {
+			tr.renderComment(val)
+			return
 		}
-
-		tr.lineIndented = true
 	}
 
+	tr.writeIndent()
+	tr.blankLine = false
+
 	token := RenderToken{Type: tokenType, Value: val}
 
 	if tr.newLine {
@@ -129,9 +153,14 @@ func (tr *TextRenderer) Control(typ int) {
 
 	switch typ {
 	case SpaceToken:
-		_, tr.err = io.WriteString(tr.w, " ")
+		// a space at the start of a line would come before the indentation
+		if tr.lineIndented {
+			_, tr.err = io.WriteString(tr.w, " ")
+		}
 	case NewLineToken:
 		tr.renderNewLine()
+	case BlankLineToken:
+		tr.renderBlankLine()
 	case IndentToken:
 		tr.indentLvl = tr.indentLvl + 1
 	case UnindentToken:
@@ -141,11 +170,46 @@ func (tr *TextRenderer) Control(typ int) {
 	tr.lastRenderToken = RenderToken{Type: typ}
 }
 
+func (tr *TextRenderer) writeIndent() {
+	if tr.lineIndented {
+		return
+	}
+
+	tr.writePending()
+	for i := 0; i < tr.indentLvl; i++ {
+		_, tr.err = io.WriteString(tr.w, tr.indent)
+		if tr.err != nil {
+			return
+		}
+	}
+
+	tr.lineIndented = true
+}
+
 func (tr *TextRenderer) renderNewLine() {
 	if tr.newLine {
 		return
 	}
 
+	// Trailing comments after the first go on lines of their own before the
+	// next line, indented like it, as that is where they are attached when
+	// the output is parsed again. Only a one line /* */ comment can have
+	// another comment after it on its line, so those go first.
+	sort.SliceStable(tr.trailing, func(i, j int) bool {
+		return isLineBlockComment(tr.trailing[i]) && !isLineBlockComment(tr.trailing[j])
+	})
+	for i, c := range tr.trailing {
+		if i == 0 || isLineBlockComment(tr.trailing[i-1]) && !strings.ContainsRune(c, '\n') {
+			_, tr.err = io.WriteString(tr.w, " "+c)
+			if tr.err != nil {
+				return
+			}
+		} else {
+			tr.pending = append(tr.pending, c)
+		}
+	}
+	tr.trailing = nil
+
 	tr.newLine = true
 	tr.lastRenderToken = RenderToken{Type: NewLineToken}
 
@@ -157,6 +221,77 @@ func (tr *TextRenderer) renderNewLine() {
 	tr.lineIndented = false
 }
 
+// writePending writes the pending trailing comments on lines of their own at
+// the current indentation.
+func (tr *TextRenderer) writePending() {
+	pending := tr.pending
+	tr.pending = nil
+	for _, c := range pending {
+		for i := 0; i < tr.indentLvl && tr.err == nil; i++ {
+			_, tr.err = io.WriteString(tr.w, tr.indent)
+		}
+		if tr.err == nil {
+			_, tr.err = io.WriteString(tr.w, c+"\n")
+		}
+		tr.blankLine = false
+	}
+}
+
+// isLineBlockComment reports whether c is a /* */ comment on one line.
+func isLineBlockComment(c string) bool {
+	return strings.HasPrefix(c, "/*") && !strings.ContainsRune(c, '\n')
+}
+
+// renderBlankLine ends the current line, if any, and writes an empty line
+// unless one was just written or nothing has been written yet.
+func (tr *TextRenderer) renderBlankLine() {
+	if tr.lastRenderToken.Type == NullToken {
+		return
+	}
+
+	if tr.lineIndented {
+		tr.renderNewLine()
+	}
+	tr.writePending()
+
+	if tr.blankLine || tr.err != nil {
+		return
+	}
+
+	tr.blankLine = true
+	_, tr.err = io.WriteString(tr.w, "\n")
+}
+
+// renderComment writes a comment on a line of its own.
+func (tr *TextRenderer) renderComment(val string) {
+	if tr.lineIndented {
+		tr.renderNewLine()
+	}
+
+	tr.writeIndent()
+	if tr.err != nil {
+		return
+	}
+
+	_, tr.err = io.WriteString(tr.w, val)
+	if tr.err != nil {
+		return
+	}
+
+	tr.newLine = false
+	tr.blankLine = false
+	tr.renderNewLine()
+}
+
 func (tr *TextRenderer) Error() error {
 	return tr.err
 }
+
+// Flush writes the comments still waiting for the next line and returns the
+// first error. It is called once the last statement is rendered.
+func (tr *TextRenderer) Flush() error {
+	if tr.err == nil {
+		tr.writePending()
+	}
+	return tr.err
+}
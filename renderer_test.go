@@ -71,3 +71,129 @@ WHERE
 		t.Errorf("Expected `%s`, got `%s`", expected, buf.String())
 	}
 }
+
+func TestTextRendererComments(t *testing.T) {
+	var buf bytes.Buffer
+
+	tr := NewTextRenderer(&buf)
+
+	tr.Text("-- header", CommentToken)
+	tr.Control(BlankLineToken)
+	tr.Text("select", KeywordToken)
+	tr.Control(NewLineToken)
+	tr.Control(IndentToken)
+	tr.Text("foo", IdentifierToken)
+	tr.Text("-- first", TrailingCommentToken)
+	tr.Text(",", SymbolToken)
+	tr.Control(NewLineToken)
+	tr.Text("/* second */", CommentToken)
+	tr.Text("bar", IdentifierToken)
+	tr.Text("-- one", TrailingCommentToken)
+	tr.Text("-- two", TrailingCommentToken)
+	tr.Control(NewLineToken)
+	if err := tr.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `-- header
+
+select
+  foo, -- first
+  /* second */
+  bar -- one
+  -- two
+`
+
+	if buf.String() != expected {
+		t.Errorf("Expected `%s`, got `%s`", expected, buf.String())
+	}
+}
+
+func TestTextRendererTrailingComments(t *testing.T) {
+	var buf bytes.Buffer
+
+	tr := NewTextRenderer(&buf)
+
+	tr.Text("where", KeywordToken)
+	tr.Text("-- one", TrailingCommentToken)
+	tr.Text("/* two */", TrailingCommentToken)
+	tr.Text("-- three", TrailingCommentToken)
+	tr.Control(IndentToken)
+	tr.Control(NewLineToken)
+	tr.Text("a", IdentifierToken)
+	tr.Text("-- four", TrailingCommentToken)
+	tr.Text("-- five", TrailingCommentToken)
+	tr.Control(NewLineToken)
+	tr.Control(UnindentToken)
+	tr.Text("from", KeywordToken)
+	tr.Control(NewLineToken)
+
+	expected := `where /* two */ -- one
+  -- three
+  a -- four
+-- five
+from
+`
+
+	if buf.String() != expected {
+		t.Errorf("Expected `%s`, got `%s`", expected, buf.String())
+	}
+}
+
+func TestTextRendererInlineComments(t *testing.T) {
+	var buf bytes.Buffer
+
+	tr := NewTextRenderer(&buf)
+
+	tr.Text("select", KeywordToken)
+	tr.Control(NewLineToken)
+	tr.Control(IndentToken)
+	tr.Text("/* first */", InlineCommentToken)
+	tr.Text("f", IdentifierToken)
+	tr.Text("(", SymbolToken)
+	tr.Text("/* a */", InlineCommentToken)
+	tr.Text("a", IdentifierToken)
+	tr.Text(",", SymbolToken)
+	tr.Text("/* b */", InlineCommentToken)
+	tr.Text("b", IdentifierToken)
+	tr.Text("/* c */", InlineCommentToken)
+	tr.Text(")", SymbolToken)
+	tr.Control(NewLineToken)
+
+	expected := `select
+  /* first */
+  f(/* a */ a, /* b */ b /* c */)
+`
+
+	if buf.String() != expected {
+		t.Errorf("Expected `%s`, got `%s`", expected, buf.String())
+	}
+}
+
+func TestTextRendererSpace(t *testing.T) {
+	var buf bytes.Buffer
+
+	tr := NewTextRenderer(&buf)
+
+	tr.Text("from", KeywordToken)
+	tr.Control(NewLineToken)
+	tr.Control(IndentToken)
+	tr.Control(SpaceToken)
+	tr.Text("(", SymbolToken)
+	tr.Text("a", IdentifierToken)
+	tr.Text(")", SymbolToken)
+	tr.Control(NewLineToken)
+	tr.Text("join", KeywordToken)
+	tr.Control(SpaceToken)
+	tr.Text("(", SymbolToken)
+	tr.Text("b", IdentifierToken)
+	tr.Text(")", SymbolToken)
+
+	expected := `from
+  (a)
+  join (b)`
+
+	if buf.String() != expected {
+		t.Errorf("Expected `%s`, got `%s`", expected, buf.String())
+	}
+}
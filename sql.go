@@ -1,16 +1,44 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
 //line sql.y:2
+
 package sqlfmt
 
 import __yyfmt__ "fmt"
 
 //line sql.y:3
-//line sql.y:7
+
+func init() {
+	// report the offending and expected tokens to sqlLex.Error
+	yyErrorVerbose = true
+}
+
+//line sql.y:12
 type yySymType struct {
 	yys                 int
+	pos                 int // index of the first token of the symbol; inherited from $1 by default
 	sqlSelect           *SelectStmt
+	insertStmt          *InsertStmt
+	setClauses          []SetClause
+	setClause           SetClause
+	columnRefs          []ColumnRef
+	joinQual            JoinExpr
+	tableAlias          tableAlias
+	columnDefs          []ColumnDef
+	columnDef           ColumnDef
+	rowsFromItems       []RowsFromItem
+	rowsFromItem        RowsFromItem
+	tableSample         TableSample
+	withClause          *WithClause
+	ctes                []CommonTableExpr
+	cte                 CommonTableExpr
+	searchClause        *SearchClause
+	cycleClause         *CycleClause
 	simpleSelect        *SimpleSelect
 	fields              []Expr
 	expr                Expr
+	stmts               []Stmt
+	stmt                Stmt
 	str                 string
 	identifiers         []string
 	anyNames            []AnyName
@@ -32,7 +60,7 @@ type yySymType struct {
 	pgTypes             []PgType
 	row                 Row
 	valuesRow           ValuesRow
-	valuesClause        ValuesClause
+	valuesClause        *ValuesClause
 	funcApplication     FuncApplication
 	funcArgs            []FuncArg
 	funcArg             FuncArg
@@ -75,435 +103,440 @@ const XCONST = 57350
 const Op = 57351
 const ICONST = 57352
 const PARAM = 57353
-const TYPECAST = 57354
-const DOT_DOT = 57355
-const COLON_EQUALS = 57356
-const EQUALS_GREATER = 57357
-const LESS_EQUALS = 57358
-const GREATER_EQUALS = 57359
-const NOT_EQUALS = 57360
-const ABORT_P = 57361
-const ABSOLUTE_P = 57362
-const ACCESS = 57363
-const ACTION = 57364
-const ADD_P = 57365
-const ADMIN = 57366
-const AFTER = 57367
-const AGGREGATE = 57368
-const ALL = 57369
-const ALSO = 57370
-const ALTER = 57371
-const ALWAYS = 57372
-const ANALYSE = 57373
-const ANALYZE = 57374
-const AND = 57375
-const ANY = 57376
-const ARRAY = 57377
-const AS = 57378
-const ASC = 57379
-const ASSERTION = 57380
-const ASSIGNMENT = 57381
-const ASYMMETRIC = 57382
-const AT = 57383
-const ATTRIBUTE = 57384
-const AUTHORIZATION = 57385
-const BACKWARD = 57386
-const BEFORE = 57387
-const BEGIN_P = 57388
-const BETWEEN = 57389
-const BIGINT = 57390
-const BINARY = 57391
-const BIT = 57392
-const BOOLEAN_P = 57393
-const BOTH = 57394
-const BY = 57395
-const CACHE = 57396
-const CALLED = 57397
-const CASCADE = 57398
-const CASCADED = 57399
-const CASE = 57400
-const CAST = 57401
-const CATALOG_P = 57402
-const CHAIN = 57403
-const CHAR_P = 57404
-const CHARACTER = 57405
-const CHARACTERISTICS = 57406
-const CHECK = 57407
-const CHECKPOINT = 57408
-const CLASS = 57409
-const CLOSE = 57410
-const CLUSTER = 57411
-const COALESCE = 57412
-const COLLATE = 57413
-const COLLATION = 57414
-const COLUMN = 57415
-const COMMENT = 57416
-const COMMENTS = 57417
-const COMMIT = 57418
-const COMMITTED = 57419
-const CONCURRENTLY = 57420
-const CONFIGURATION = 57421
-const CONFLICT = 57422
-const CONNECTION = 57423
-const CONSTRAINT = 57424
-const CONSTRAINTS = 57425
-const CONTENT_P = 57426
-const CONTINUE_P = 57427
-const CONVERSION_P = 57428
-const COPY = 57429
-const COST = 57430
-const CREATE = 57431
-const CROSS = 57432
-const CSV = 57433
-const CUBE = 57434
-const CURRENT_P = 57435
-const CURRENT_CATALOG = 57436
-const CURRENT_DATE = 57437
-const CURRENT_ROLE = 57438
-const CURRENT_SCHEMA = 57439
-const CURRENT_TIME = 57440
-const CURRENT_TIMESTAMP = 57441
-const CURRENT_USER = 57442
-const CURSOR = 57443
-const CYCLE = 57444
-const DATA_P = 57445
-const DATABASE = 57446
-const DAY_P = 57447
-const DEALLOCATE = 57448
-const DEC = 57449
-const DECIMAL_P = 57450
-const DECLARE = 57451
-const DEFAULT = 57452
-const DEFAULTS = 57453
-const DEFERRABLE = 57454
-const DEFERRED = 57455
-const DEFINER = 57456
-const DELETE_P = 57457
-const DELIMITER = 57458
-const DELIMITERS = 57459
-const DESC = 57460
-const DICTIONARY = 57461
-const DISABLE_P = 57462
-const DISCARD = 57463
-const DISTINCT = 57464
-const DO = 57465
-const DOCUMENT_P = 57466
-const DOMAIN_P = 57467
-const DOUBLE_P = 57468
-const DROP = 57469
-const EACH = 57470
-const ELSE = 57471
-const ENABLE_P = 57472
-const ENCODING = 57473
-const ENCRYPTED = 57474
-const END_P = 57475
-const ENUM_P = 57476
-const ESCAPE = 57477
-const EVENT = 57478
-const EXCEPT = 57479
-const EXCLUDE = 57480
-const EXCLUDING = 57481
-const EXCLUSIVE = 57482
-const EXECUTE = 57483
-const EXISTS = 57484
-const EXPLAIN = 57485
-const EXTENSION = 57486
-const EXTERNAL = 57487
-const EXTRACT = 57488
-const FALSE_P = 57489
-const FAMILY = 57490
-const FETCH = 57491
-const FILTER = 57492
-const FIRST_P = 57493
-const FLOAT_P = 57494
-const FOLLOWING = 57495
-const FOR = 57496
-const FORCE = 57497
-const FOREIGN = 57498
-const FORWARD = 57499
-const FREEZE = 57500
-const FROM = 57501
-const FULL = 57502
-const FUNCTION = 57503
-const FUNCTIONS = 57504
-const GLOBAL = 57505
-const GRANT = 57506
-const GRANTED = 57507
-const GREATEST = 57508
-const GROUP_P = 57509
-const GROUPING = 57510
-const HANDLER = 57511
-const HAVING = 57512
-const HEADER_P = 57513
-const HOLD = 57514
-const HOUR_P = 57515
-const IDENTITY_P = 57516
-const IF_P = 57517
-const ILIKE = 57518
-const IMMEDIATE = 57519
-const IMMUTABLE = 57520
-const IMPLICIT_P = 57521
-const IMPORT_P = 57522
-const IN_P = 57523
-const INCLUDING = 57524
-const INCREMENT = 57525
-const INDEX = 57526
-const INDEXES = 57527
-const INHERIT = 57528
-const INHERITS = 57529
-const INITIALLY = 57530
-const INLINE_P = 57531
-const INNER_P = 57532
-const INOUT = 57533
-const INPUT_P = 57534
-const INSENSITIVE = 57535
-const INSERT = 57536
-const INSTEAD = 57537
-const INT_P = 57538
-const INTEGER = 57539
-const INTERSECT = 57540
-const INTERVAL = 57541
-const INTO = 57542
-const INVOKER = 57543
-const IS = 57544
-const ISNULL = 57545
-const ISOLATION = 57546
-const JOIN = 57547
-const KEY = 57548
-const LABEL = 57549
-const LANGUAGE = 57550
-const LARGE_P = 57551
-const LAST_P = 57552
-const LATERAL_P = 57553
-const LEADING = 57554
-const LEAKPROOF = 57555
-const LEAST = 57556
-const LEFT = 57557
-const LEVEL = 57558
-const LIKE = 57559
-const LIMIT = 57560
-const LISTEN = 57561
-const LOAD = 57562
-const LOCAL = 57563
-const LOCALTIME = 57564
-const LOCALTIMESTAMP = 57565
-const LOCATION = 57566
-const LOCK_P = 57567
-const LOCKED = 57568
-const LOGGED = 57569
-const MAPPING = 57570
-const MATCH = 57571
-const MATERIALIZED = 57572
-const MAXVALUE = 57573
-const MINUTE_P = 57574
-const MINVALUE = 57575
-const MODE = 57576
-const MONTH_P = 57577
-const MOVE = 57578
-const NAME_P = 57579
-const NAMES = 57580
-const NATIONAL = 57581
-const NATURAL = 57582
-const NCHAR = 57583
-const NEXT = 57584
-const NO = 57585
-const NONE = 57586
-const NOT = 57587
-const NOTHING = 57588
-const NOTIFY = 57589
-const NOTNULL = 57590
-const NOWAIT = 57591
-const NULL_P = 57592
-const NULLIF = 57593
-const NULLS_P = 57594
-const NUMERIC = 57595
-const OBJECT_P = 57596
-const OF = 57597
-const OFF = 57598
-const OFFSET = 57599
-const OIDS = 57600
-const ON = 57601
-const ONLY = 57602
-const OPERATOR = 57603
-const OPTION = 57604
-const OPTIONS = 57605
-const OR = 57606
-const ORDER = 57607
-const ORDINALITY = 57608
-const OUT_P = 57609
-const OUTER_P = 57610
-const OVER = 57611
-const OVERLAPS = 57612
-const OVERLAY = 57613
-const OWNED = 57614
-const OWNER = 57615
-const PARSER = 57616
-const PARTIAL = 57617
-const PARTITION = 57618
-const PASSING = 57619
-const PASSWORD = 57620
-const PLACING = 57621
-const PLANS = 57622
-const POLICY = 57623
-const POSITION = 57624
-const PRECEDING = 57625
-const PRECISION = 57626
-const PRESERVE = 57627
-const PREPARE = 57628
-const PREPARED = 57629
-const PRIMARY = 57630
-const PRIOR = 57631
-const PRIVILEGES = 57632
-const PROCEDURAL = 57633
-const PROCEDURE = 57634
-const PROGRAM = 57635
-const QUOTE = 57636
-const RANGE = 57637
-const READ = 57638
-const REAL = 57639
-const REASSIGN = 57640
-const RECHECK = 57641
-const RECURSIVE = 57642
-const REF = 57643
-const REFERENCES = 57644
-const REFRESH = 57645
-const REINDEX = 57646
-const RELATIVE_P = 57647
-const RELEASE = 57648
-const RENAME = 57649
-const REPEATABLE = 57650
-const REPLACE = 57651
-const REPLICA = 57652
-const RESET = 57653
-const RESTART = 57654
-const RESTRICT = 57655
-const RETURNING = 57656
-const RETURNS = 57657
-const REVOKE = 57658
-const RIGHT = 57659
-const ROLE = 57660
-const ROLLBACK = 57661
-const ROLLUP = 57662
-const ROW = 57663
-const ROWS = 57664
-const RULE = 57665
-const SAVEPOINT = 57666
-const SCHEMA = 57667
-const SCROLL = 57668
-const SEARCH = 57669
-const SECOND_P = 57670
-const SECURITY = 57671
-const SELECT = 57672
-const SEQUENCE = 57673
-const SEQUENCES = 57674
-const SERIALIZABLE = 57675
-const SERVER = 57676
-const SESSION = 57677
-const SESSION_USER = 57678
-const SET = 57679
-const SETS = 57680
-const SETOF = 57681
-const SHARE = 57682
-const SHOW = 57683
-const SIMILAR = 57684
-const SIMPLE = 57685
-const SKIP = 57686
-const SMALLINT = 57687
-const SNAPSHOT = 57688
-const SOME = 57689
-const SQL_P = 57690
-const STABLE = 57691
-const STANDALONE_P = 57692
-const START = 57693
-const STATEMENT = 57694
-const STATISTICS = 57695
-const STDIN = 57696
-const STDOUT = 57697
-const STORAGE = 57698
-const STRICT_P = 57699
-const STRIP_P = 57700
-const SUBSTRING = 57701
-const SYMMETRIC = 57702
-const SYSID = 57703
-const SYSTEM_P = 57704
-const TABLE = 57705
-const TABLES = 57706
-const TABLESAMPLE = 57707
-const TABLESPACE = 57708
-const TEMP = 57709
-const TEMPLATE = 57710
-const TEMPORARY = 57711
-const TEXT_P = 57712
-const THEN = 57713
-const TIME = 57714
-const TIMESTAMP = 57715
-const TO = 57716
-const TRAILING = 57717
-const TRANSACTION = 57718
-const TRANSFORM = 57719
-const TREAT = 57720
-const TRIGGER = 57721
-const TRIM = 57722
-const TRUE_P = 57723
-const TRUNCATE = 57724
-const TRUSTED = 57725
-const TYPE_P = 57726
-const TYPES_P = 57727
-const UNBOUNDED = 57728
-const UNCOMMITTED = 57729
-const UNENCRYPTED = 57730
-const UNION = 57731
-const UNIQUE = 57732
-const UNKNOWN = 57733
-const UNLISTEN = 57734
-const UNLOGGED = 57735
-const UNTIL = 57736
-const UPDATE = 57737
-const USER = 57738
-const USING = 57739
-const VACUUM = 57740
-const VALID = 57741
-const VALIDATE = 57742
-const VALIDATOR = 57743
-const VALUE_P = 57744
-const VALUES = 57745
-const VARCHAR = 57746
-const VARIADIC = 57747
-const VARYING = 57748
-const VERBOSE = 57749
-const VERSION_P = 57750
-const VIEW = 57751
-const VIEWS = 57752
-const VOLATILE = 57753
-const WHEN = 57754
-const WHERE = 57755
-const WHITESPACE_P = 57756
-const WINDOW = 57757
-const WITH = 57758
-const WITHIN = 57759
-const WITHOUT = 57760
-const WORK = 57761
-const WRAPPER = 57762
-const WRITE = 57763
-const XML_P = 57764
-const XMLATTRIBUTES = 57765
-const XMLCONCAT = 57766
-const XMLELEMENT = 57767
-const XMLEXISTS = 57768
-const XMLFOREST = 57769
-const XMLPARSE = 57770
-const XMLPI = 57771
-const XMLROOT = 57772
-const XMLSERIALIZE = 57773
-const YEAR_P = 57774
-const YES_P = 57775
-const ZONE = 57776
-const NOT_LA = 57777
-const NULLS_LA = 57778
-const WITH_LA = 57779
-const OP = 57780
-const POSTFIXOP = 57781
-const UMINUS = 57782
+const PLACEHOLDER = 57354
+const TYPECAST = 57355
+const DOT_DOT = 57356
+const COLON_EQUALS = 57357
+const EQUALS_GREATER = 57358
+const LESS_EQUALS = 57359
+const GREATER_EQUALS = 57360
+const NOT_EQUALS = 57361
+const ABORT_P = 57362
+const ABSOLUTE_P = 57363
+const ACCESS = 57364
+const ACTION = 57365
+const ADD_P = 57366
+const ADMIN = 57367
+const AFTER = 57368
+const AGGREGATE = 57369
+const ALL = 57370
+const ALSO = 57371
+const ALTER = 57372
+const ALWAYS = 57373
+const ANALYSE = 57374
+const ANALYZE = 57375
+const AND = 57376
+const ANY = 57377
+const ARRAY = 57378
+const AS = 57379
+const ASC = 57380
+const ASSERTION = 57381
+const ASSIGNMENT = 57382
+const ASYMMETRIC = 57383
+const AT = 57384
+const ATTRIBUTE = 57385
+const AUTHORIZATION = 57386
+const BACKWARD = 57387
+const BEFORE = 57388
+const BEGIN_P = 57389
+const BETWEEN = 57390
+const BIGINT = 57391
+const BINARY = 57392
+const BIT = 57393
+const BOOLEAN_P = 57394
+const BOTH = 57395
+const BREADTH = 57396
+const BY = 57397
+const CACHE = 57398
+const CALLED = 57399
+const CASCADE = 57400
+const CASCADED = 57401
+const CASE = 57402
+const CAST = 57403
+const CATALOG_P = 57404
+const CHAIN = 57405
+const CHAR_P = 57406
+const CHARACTER = 57407
+const CHARACTERISTICS = 57408
+const CHECK = 57409
+const CHECKPOINT = 57410
+const CLASS = 57411
+const CLOSE = 57412
+const CLUSTER = 57413
+const COALESCE = 57414
+const COLLATE = 57415
+const COLLATION = 57416
+const COLUMN = 57417
+const COMMENT = 57418
+const COMMENTS = 57419
+const COMMIT = 57420
+const COMMITTED = 57421
+const CONCURRENTLY = 57422
+const CONFIGURATION = 57423
+const CONFLICT = 57424
+const CONNECTION = 57425
+const CONSTRAINT = 57426
+const CONSTRAINTS = 57427
+const CONTENT_P = 57428
+const CONTINUE_P = 57429
+const CONVERSION_P = 57430
+const COPY = 57431
+const COST = 57432
+const CREATE = 57433
+const CROSS = 57434
+const CSV = 57435
+const CUBE = 57436
+const CURRENT_P = 57437
+const CURRENT_CATALOG = 57438
+const CURRENT_DATE = 57439
+const CURRENT_ROLE = 57440
+const CURRENT_SCHEMA = 57441
+const CURRENT_TIME = 57442
+const CURRENT_TIMESTAMP = 57443
+const CURRENT_USER = 57444
+const CURSOR = 57445
+const CYCLE = 57446
+const DATA_P = 57447
+const DATABASE = 57448
+const DAY_P = 57449
+const DEALLOCATE = 57450
+const DEC = 57451
+const DECIMAL_P = 57452
+const DECLARE = 57453
+const DEFAULT = 57454
+const DEFAULTS = 57455
+const DEFERRABLE = 57456
+const DEFERRED = 57457
+const DEFINER = 57458
+const DELETE_P = 57459
+const DELIMITER = 57460
+const DELIMITERS = 57461
+const DEPTH = 57462
+const DESC = 57463
+const DICTIONARY = 57464
+const DISABLE_P = 57465
+const DISCARD = 57466
+const DISTINCT = 57467
+const DO = 57468
+const DOCUMENT_P = 57469
+const DOMAIN_P = 57470
+const DOUBLE_P = 57471
+const DROP = 57472
+const DUPLICATE = 57473
+const EACH = 57474
+const ELSE = 57475
+const ENABLE_P = 57476
+const ENCODING = 57477
+const ENCRYPTED = 57478
+const END_P = 57479
+const ENUM_P = 57480
+const ESCAPE = 57481
+const EVENT = 57482
+const EXCEPT = 57483
+const EXCLUDE = 57484
+const EXCLUDING = 57485
+const EXCLUSIVE = 57486
+const EXECUTE = 57487
+const EXISTS = 57488
+const EXPLAIN = 57489
+const EXTENSION = 57490
+const EXTERNAL = 57491
+const EXTRACT = 57492
+const FALSE_P = 57493
+const FAMILY = 57494
+const FETCH = 57495
+const FILTER = 57496
+const FIRST_P = 57497
+const FLOAT_P = 57498
+const FOLLOWING = 57499
+const FOR = 57500
+const FORCE = 57501
+const FOREIGN = 57502
+const FORWARD = 57503
+const FREEZE = 57504
+const FROM = 57505
+const FULL = 57506
+const FUNCTION = 57507
+const FUNCTIONS = 57508
+const GLOBAL = 57509
+const GRANT = 57510
+const GRANTED = 57511
+const GREATEST = 57512
+const GROUP_P = 57513
+const GROUPING = 57514
+const HANDLER = 57515
+const HAVING = 57516
+const HEADER_P = 57517
+const HOLD = 57518
+const HOUR_P = 57519
+const IDENTITY_P = 57520
+const IF_P = 57521
+const ILIKE = 57522
+const IMMEDIATE = 57523
+const IMMUTABLE = 57524
+const IMPLICIT_P = 57525
+const IMPORT_P = 57526
+const IN_P = 57527
+const INCLUDING = 57528
+const INCREMENT = 57529
+const INDEX = 57530
+const INDEXES = 57531
+const INHERIT = 57532
+const INHERITS = 57533
+const INITIALLY = 57534
+const INLINE_P = 57535
+const INNER_P = 57536
+const INOUT = 57537
+const INPUT_P = 57538
+const INSENSITIVE = 57539
+const INSERT = 57540
+const INSTEAD = 57541
+const INT_P = 57542
+const INTEGER = 57543
+const INTERSECT = 57544
+const INTERVAL = 57545
+const INTO = 57546
+const INVOKER = 57547
+const IS = 57548
+const ISNULL = 57549
+const ISOLATION = 57550
+const JOIN = 57551
+const KEY = 57552
+const LABEL = 57553
+const LANGUAGE = 57554
+const LARGE_P = 57555
+const LAST_P = 57556
+const LATERAL_P = 57557
+const LEADING = 57558
+const LEAKPROOF = 57559
+const LEAST = 57560
+const LEFT = 57561
+const LEVEL = 57562
+const LIKE = 57563
+const LIMIT = 57564
+const LISTEN = 57565
+const LOAD = 57566
+const LOCAL = 57567
+const LOCALTIME = 57568
+const LOCALTIMESTAMP = 57569
+const LOCATION = 57570
+const LOCK_P = 57571
+const LOCKED = 57572
+const LOGGED = 57573
+const MAPPING = 57574
+const MATCH = 57575
+const MATERIALIZED = 57576
+const MAXVALUE = 57577
+const MINUTE_P = 57578
+const MINVALUE = 57579
+const MODE = 57580
+const MONTH_P = 57581
+const MOVE = 57582
+const NAME_P = 57583
+const NAMES = 57584
+const NATIONAL = 57585
+const NATURAL = 57586
+const NCHAR = 57587
+const NEXT = 57588
+const NO = 57589
+const NONE = 57590
+const NOT = 57591
+const NOTHING = 57592
+const NOTIFY = 57593
+const NOTNULL = 57594
+const NOWAIT = 57595
+const NULL_P = 57596
+const NULLIF = 57597
+const NULLS_P = 57598
+const NUMERIC = 57599
+const OBJECT_P = 57600
+const OF = 57601
+const OFF = 57602
+const OFFSET = 57603
+const OIDS = 57604
+const ON = 57605
+const ONLY = 57606
+const OPERATOR = 57607
+const OPTION = 57608
+const OPTIONS = 57609
+const OR = 57610
+const ORDER = 57611
+const ORDINALITY = 57612
+const OUT_P = 57613
+const OUTER_P = 57614
+const OVER = 57615
+const OVERLAPS = 57616
+const OVERLAY = 57617
+const OWNED = 57618
+const OWNER = 57619
+const PARSER = 57620
+const PARTIAL = 57621
+const PARTITION = 57622
+const PASSING = 57623
+const PASSWORD = 57624
+const PLACING = 57625
+const PLANS = 57626
+const POLICY = 57627
+const POSITION = 57628
+const PRECEDING = 57629
+const PRECISION = 57630
+const PRESERVE = 57631
+const PREPARE = 57632
+const PREPARED = 57633
+const PRIMARY = 57634
+const PRIOR = 57635
+const PRIVILEGES = 57636
+const PROCEDURAL = 57637
+const PROCEDURE = 57638
+const PROGRAM = 57639
+const QUOTE = 57640
+const RANGE = 57641
+const READ = 57642
+const REAL = 57643
+const REASSIGN = 57644
+const RECHECK = 57645
+const RECURSIVE = 57646
+const REF = 57647
+const REFERENCES = 57648
+const REFRESH = 57649
+const REINDEX = 57650
+const RELATIVE_P = 57651
+const RELEASE = 57652
+const RENAME = 57653
+const REPEATABLE = 57654
+const REPLACE = 57655
+const REPLICA = 57656
+const RESET = 57657
+const RESTART = 57658
+const RESTRICT = 57659
+const RETURNING = 57660
+const RETURNS = 57661
+const REVOKE = 57662
+const RIGHT = 57663
+const ROLE = 57664
+const ROLLBACK = 57665
+const ROLLUP = 57666
+const ROW = 57667
+const ROWS = 57668
+const RULE = 57669
+const SAVEPOINT = 57670
+const SCHEMA = 57671
+const SCROLL = 57672
+const SEARCH = 57673
+const SECOND_P = 57674
+const SECURITY = 57675
+const SELECT = 57676
+const SEQUENCE = 57677
+const SEQUENCES = 57678
+const SERIALIZABLE = 57679
+const SERVER = 57680
+const SESSION = 57681
+const SESSION_USER = 57682
+const SET = 57683
+const SETS = 57684
+const SETOF = 57685
+const SHARE = 57686
+const SHOW = 57687
+const SIMILAR = 57688
+const SIMPLE = 57689
+const SKIP = 57690
+const SMALLINT = 57691
+const SNAPSHOT = 57692
+const SOME = 57693
+const SQL_P = 57694
+const STABLE = 57695
+const STANDALONE_P = 57696
+const START = 57697
+const STATEMENT = 57698
+const STATISTICS = 57699
+const STDIN = 57700
+const STDOUT = 57701
+const STORAGE = 57702
+const STRICT_P = 57703
+const STRIP_P = 57704
+const SUBSTRING = 57705
+const SYMMETRIC = 57706
+const SYSID = 57707
+const SYSTEM_P = 57708
+const TABLE = 57709
+const TABLES = 57710
+const TABLESAMPLE = 57711
+const TABLESPACE = 57712
+const TEMP = 57713
+const TEMPLATE = 57714
+const TEMPORARY = 57715
+const TEXT_P = 57716
+const THEN = 57717
+const TIME = 57718
+const TIMESTAMP = 57719
+const TO = 57720
+const TRAILING = 57721
+const TRANSACTION = 57722
+const TRANSFORM = 57723
+const TREAT = 57724
+const TRIGGER = 57725
+const TRIM = 57726
+const TRUE_P = 57727
+const TRUNCATE = 57728
+const TRUSTED = 57729
+const TYPE_P = 57730
+const TYPES_P = 57731
+const UNBOUNDED = 57732
+const UNCOMMITTED = 57733
+const UNENCRYPTED = 57734
+const UNION = 57735
+const UNIQUE = 57736
+const UNKNOWN = 57737
+const UNLISTEN = 57738
+const UNLOGGED = 57739
+const UNTIL = 57740
+const UPDATE = 57741
+const USER = 57742
+const USING = 57743
+const VACUUM = 57744
+const VALID = 57745
+const VALIDATE = 57746
+const VALIDATOR = 57747
+const VALUE_P = 57748
+const VALUES = 57749
+const VARCHAR = 57750
+const VARIADIC = 57751
+const VARYING = 57752
+const VERBOSE = 57753
+const VERSION_P = 57754
+const VIEW = 57755
+const VIEWS = 57756
+const VOLATILE = 57757
+const WHEN = 57758
+const WHERE = 57759
+const WHITESPACE_P = 57760
+const WINDOW = 57761
+const WITH = 57762
+const WITHIN = 57763
+const WITHOUT = 57764
+const WORK = 57765
+const WRAPPER = 57766
+const WRITE = 57767
+const XML_P = 57768
+const XMLATTRIBUTES = 57769
+const XMLCONCAT = 57770
+const XMLELEMENT = 57771
+const XMLEXISTS = 57772
+const XMLFOREST = 57773
+const XMLPARSE = 57774
+const XMLPI = 57775
+const XMLROOT = 57776
+const XMLSERIALIZE = 57777
+const YEAR_P = 57778
+const YES_P = 57779
+const ZONE = 57780
+const NOT_LA = 57781
+const NULLS_LA = 57782
+const WITH_LA = 57783
+const OP = 57784
+const EMPTY_STMT = 57785
+const POSTFIXOP = 57786
+const UMINUS = 57787
 
 var yyToknames = [...]string{
 	"$end",
@@ -517,6 +550,7 @@ var yyToknames = [...]string{
 	"Op",
 	"ICONST",
 	"PARAM",
+	"PLACEHOLDER",
 	"TYPECAST",
 	"DOT_DOT",
 	"COLON_EQUALS",
@@ -558,6 +592,7 @@ var yyToknames = [...]string{
 	"BIT",
 	"BOOLEAN_P",
 	"BOTH",
+	"BREADTH",
 	"BY",
 	"CACHE",
 	"CALLED",
@@ -623,6 +658,7 @@ var yyToknames = [...]string{
 	"DELETE_P",
 	"DELIMITER",
 	"DELIMITERS",
+	"DEPTH",
 	"DESC",
 	"DICTIONARY",
 	"DISABLE_P",
@@ -633,6 +669,7 @@ var yyToknames = [...]string{
 	"DOMAIN_P",
 	"DOUBLE_P",
 	"DROP",
+	"DUPLICATE",
 	"EACH",
 	"ELSE",
 	"ENABLE_P",
@@ -944,6 +981,7 @@ var yyToknames = [...]string{
 	"NULLS_LA",
 	"WITH_LA",
 	"OP",
+	"EMPTY_STMT",
 	"'<'",
 	"'>'",
 	"'='",
@@ -964,2448 +1002,2974 @@ var yyToknames = [...]string{
 	"','",
 	"':'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
-const yyMaxDepth = 200
+const yyInitialStackSize = 16
 
-//line sql.y:3467
+//line sql.y:4193
 
 // The parser expects the lexer to return 0 on EOF.  Give it a name
 // for clarity.
 const eof = 0
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
+	-1, 0,
+	1, 7,
+	460, 7,
+	-2, 0,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 4,
-	1, 332,
-	455, 332,
-	-2, 340,
-	-1, 5,
-	1, 335,
-	453, 335,
-	455, 335,
-	-2, 339,
-	-1, 13,
-	1, 336,
-	453, 336,
-	455, 336,
-	-2, 368,
-	-1, 410,
-	6, 541,
-	14, 541,
-	15, 541,
-	452, 541,
-	-2, 538,
-	-1, 411,
-	6, 542,
-	14, 542,
-	15, 542,
-	452, 542,
-	-2, 539,
-	-1, 419,
-	6, 82,
-	452, 82,
-	-2, 834,
-	-1, 431,
-	6, 870,
-	14, 870,
-	15, 870,
-	452, 870,
-	-2, 225,
-	-1, 452,
-	6, 46,
-	-2, 818,
-	-1, 453,
-	6, 75,
-	452, 75,
-	-2, 819,
-	-1, 454,
-	6, 53,
-	-2, 820,
-	-1, 455,
-	6, 75,
-	63, 75,
-	452, 75,
-	-2, 821,
-	-1, 456,
-	6, 75,
-	63, 75,
-	452, 75,
-	-2, 822,
-	-1, 457,
-	6, 42,
-	-2, 824,
+	-1, 2,
+	1, 1,
+	-2, 0,
+	-1, 8,
+	1, 398,
+	2, 398,
+	263, 398,
+	458, 398,
+	460, 398,
+	-2, 410,
+	-1, 10,
+	1, 401,
+	2, 401,
+	263, 401,
+	458, 401,
+	460, 401,
+	-2, 409,
+	-1, 20,
+	1, 7,
+	460, 7,
+	-2, 0,
+	-1, 23,
+	153, 457,
+	158, 457,
+	222, 457,
+	261, 457,
+	-2, 402,
+	-1, 29,
+	153, 458,
+	158, 458,
+	222, 458,
+	261, 458,
+	-2, 405,
+	-1, 391,
+	153, 457,
+	158, 457,
+	222, 457,
+	261, 457,
+	-2, 406,
+	-1, 437,
+	6, 630,
+	15, 630,
+	16, 630,
+	457, 630,
+	-2, 627,
+	-1, 438,
+	6, 631,
+	15, 631,
+	16, 631,
+	457, 631,
+	-2, 628,
+	-1, 446,
+	6, 113,
+	457, 113,
+	-2, 925,
 	-1, 458,
-	6, 42,
-	-2, 825,
-	-1, 459,
-	6, 55,
-	-2, 828,
-	-1, 460,
-	6, 43,
-	-2, 832,
-	-1, 461,
-	6, 44,
-	-2, 833,
-	-1, 463,
+	6, 961,
+	15, 961,
+	16, 961,
+	457, 961,
+	-2, 258,
+	-1, 479,
+	6, 77,
+	-2, 909,
+	-1, 480,
+	6, 106,
+	457, 106,
+	-2, 910,
+	-1, 481,
+	6, 84,
+	-2, 911,
+	-1, 482,
+	6, 106,
+	65, 106,
+	457, 106,
+	-2, 912,
+	-1, 483,
+	6, 106,
+	65, 106,
+	457, 106,
+	-2, 913,
+	-1, 484,
+	6, 73,
+	-2, 915,
+	-1, 485,
+	6, 73,
+	-2, 916,
+	-1, 486,
+	6, 86,
+	-2, 919,
+	-1, 487,
+	6, 74,
+	-2, 923,
+	-1, 488,
 	6, 75,
-	63, 75,
-	452, 75,
-	-2, 837,
-	-1, 464,
-	6, 42,
-	-2, 840,
-	-1, 465,
-	6, 47,
-	-2, 845,
-	-1, 466,
-	6, 45,
-	-2, 848,
-	-1, 467,
-	6, 85,
-	-2, 850,
-	-1, 468,
-	6, 85,
-	-2, 851,
-	-1, 469,
-	6, 70,
-	63, 70,
-	452, 70,
-	-2, 855,
-	-1, 533,
-	321, 438,
-	322, 438,
-	-2, 102,
-	-1, 577,
-	27, 460,
-	34, 460,
-	347, 460,
-	-2, 474,
-	-1, 588,
-	137, 340,
-	149, 340,
-	154, 340,
-	198, 340,
-	218, 340,
-	257, 340,
-	265, 340,
-	389, 340,
-	-2, 194,
-	-1, 598,
-	6, 519,
-	452, 519,
-	-2, 489,
-	-1, 774,
-	1, 780,
-	137, 780,
-	149, 780,
-	154, 780,
-	159, 780,
-	167, 780,
-	170, 780,
-	198, 780,
-	218, 780,
-	257, 780,
-	265, 780,
-	389, 780,
-	413, 780,
-	415, 780,
-	450, 780,
-	453, 780,
-	454, 780,
-	455, 780,
-	-2, 360,
-	-1, 775,
-	1, 778,
-	137, 778,
-	149, 778,
-	154, 778,
-	159, 778,
-	167, 778,
-	170, 778,
-	198, 778,
-	218, 778,
-	257, 778,
-	265, 778,
-	389, 778,
-	413, 778,
-	415, 778,
-	450, 778,
-	453, 778,
-	454, 778,
-	455, 778,
-	-2, 360,
-	-1, 778,
-	1, 794,
-	137, 794,
-	149, 794,
-	154, 794,
-	159, 794,
-	167, 794,
-	170, 794,
-	198, 794,
-	218, 794,
-	257, 794,
-	265, 794,
-	389, 794,
-	413, 794,
-	415, 794,
-	450, 794,
-	453, 794,
-	454, 794,
-	455, 794,
-	-2, 360,
+	-2, 924,
+	-1, 490,
+	6, 106,
+	65, 106,
+	457, 106,
+	-2, 928,
+	-1, 491,
+	6, 73,
+	-2, 931,
+	-1, 492,
+	6, 78,
+	-2, 936,
+	-1, 493,
+	6, 76,
+	-2, 939,
+	-1, 494,
+	6, 116,
+	-2, 941,
+	-1, 495,
+	6, 116,
+	-2, 942,
+	-1, 496,
+	6, 101,
+	65, 101,
+	457, 101,
+	-2, 946,
+	-1, 562,
+	461, 524,
+	-2, 522,
+	-1, 570,
+	325, 528,
+	326, 528,
+	-2, 133,
+	-1, 614,
+	28, 550,
+	35, 550,
+	351, 550,
+	-2, 564,
+	-1, 626,
+	141, 410,
+	153, 410,
+	158, 410,
+	202, 410,
+	222, 410,
+	261, 410,
+	269, 410,
+	393, 410,
+	-2, 227,
+	-1, 636,
+	6, 608,
+	457, 608,
+	-2, 578,
 	-1, 826,
-	16, 0,
+	1, 871,
+	2, 871,
+	141, 871,
+	153, 871,
+	158, 871,
+	163, 871,
+	171, 871,
+	174, 871,
+	202, 871,
+	222, 871,
+	261, 871,
+	263, 871,
+	269, 871,
+	393, 871,
+	417, 871,
+	419, 871,
+	455, 871,
+	458, 871,
+	459, 871,
+	460, 871,
+	-2, 449,
+	-1, 827,
+	1, 869,
+	2, 869,
+	141, 869,
+	153, 869,
+	158, 869,
+	163, 869,
+	171, 869,
+	174, 869,
+	202, 869,
+	222, 869,
+	261, 869,
+	263, 869,
+	269, 869,
+	393, 869,
+	417, 869,
+	419, 869,
+	455, 869,
+	458, 869,
+	459, 869,
+	460, 869,
+	-2, 449,
+	-1, 830,
+	1, 885,
+	2, 885,
+	141, 885,
+	153, 885,
+	158, 885,
+	163, 885,
+	171, 885,
+	174, 885,
+	202, 885,
+	222, 885,
+	261, 885,
+	263, 885,
+	269, 885,
+	393, 885,
+	417, 885,
+	419, 885,
+	455, 885,
+	458, 885,
+	459, 885,
+	460, 885,
+	-2, 449,
+	-1, 878,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 114,
-	-1, 827,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 145,
+	-1, 879,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 115,
-	-1, 828,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 146,
+	-1, 880,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 116,
-	-1, 829,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 147,
+	-1, 881,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 117,
-	-1, 830,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 148,
+	-1, 882,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 118,
-	-1, 831,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 149,
+	-1, 883,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 119,
-	-1, 835,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 127,
-	-1, 841,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 131,
-	-1, 890,
-	270, 452,
-	-2, 455,
-	-1, 900,
-	14, 9,
-	15, 9,
-	-2, 518,
-	-1, 1024,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 129,
-	-1, 1025,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 133,
-	-1, 1031,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 135,
-	-1, 1057,
-	270, 451,
-	-2, 454,
-	-1, 1186,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 128,
-	-1, 1189,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 137,
-	-1, 1192,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 132,
-	-1, 1196,
-	202, 0,
-	203, 0,
-	248, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
 	-2, 150,
-	-1, 1203,
-	27, 286,
-	34, 286,
-	347, 286,
-	-2, 475,
-	-1, 1208,
-	270, 453,
-	-2, 456,
-	-1, 1250,
-	16, 0,
+	-1, 887,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 158,
+	-1, 893,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 162,
+	-1, 943,
+	274, 542,
+	-2, 545,
+	-1, 953,
+	15, 15,
+	16, 15,
+	-2, 607,
+	-1, 1096,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 160,
+	-1, 1097,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 164,
+	-1, 1103,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 166,
+	-1, 1129,
+	274, 541,
+	-2, 544,
+	-1, 1264,
+	92, 21,
+	164, 21,
+	194, 21,
+	209, 21,
+	219, 21,
+	244, 21,
+	321, 21,
+	-2, 410,
+	-1, 1268,
+	457, 608,
+	-2, 605,
+	-1, 1286,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 159,
+	-1, 1289,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 168,
+	-1, 1292,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 163,
+	-1, 1296,
+	206, 0,
+	207, 0,
+	252, 0,
+	-2, 181,
+	-1, 1303,
+	28, 338,
+	35, 338,
+	351, 338,
+	-2, 565,
+	-1, 1307,
+	274, 543,
+	-2, 546,
+	-1, 1348,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 174,
-	-1, 1251,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 205,
+	-1, 1349,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 175,
-	-1, 1252,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 206,
+	-1, 1350,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 176,
-	-1, 1253,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 207,
+	-1, 1351,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 177,
-	-1, 1254,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 208,
+	-1, 1352,
 	17, 0,
 	18, 0,
-	439, 0,
-	440, 0,
-	441, 0,
-	-2, 178,
-	-1, 1255,
-	16, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 209,
+	-1, 1353,
 	17, 0,
 	18, 0,
+	19, 0,
+	444, 0,
+	445, 0,
+	446, 0,
+	-2, 210,
+	-1, 1434,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 161,
+	-1, 1435,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 165,
+	-1, 1439,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 167,
+	-1, 1440,
+	206, 0,
+	207, 0,
+	252, 0,
+	-2, 182,
+	-1, 1444,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
 	439, 0,
-	440, 0,
-	441, 0,
-	-2, 179,
-	-1, 1315,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 130,
-	-1, 1316,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 134,
-	-1, 1320,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 136,
-	-1, 1321,
-	202, 0,
-	203, 0,
-	248, 0,
-	-2, 151,
-	-1, 1325,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 154,
-	-1, 1326,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 156,
-	-1, 1381,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 138,
-	-1, 1382,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 155,
-	-1, 1383,
-	47, 0,
-	176, 0,
-	181, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 157,
-	-1, 1391,
-	202, 0,
-	-2, 183,
-	-1, 1418,
-	202, 0,
-	-2, 184,
-	-1, 1447,
-	47, 0,
-	176, 0,
-	217, 0,
-	342, 0,
-	435, 0,
-	-2, 817,
+	-2, 185,
+	-1, 1445,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 187,
+	-1, 1515,
+	458, 305,
+	461, 305,
+	-2, 627,
+	-1, 1525,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 169,
+	-1, 1526,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 186,
+	-1, 1527,
+	48, 0,
+	180, 0,
+	185, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 188,
+	-1, 1535,
+	206, 0,
+	-2, 214,
+	-1, 1584,
+	206, 0,
+	-2, 215,
+	-1, 1634,
+	48, 0,
+	180, 0,
+	221, 0,
+	346, 0,
+	439, 0,
+	-2, 908,
 }
 
-const yyNprod = 965
 const yyPrivate = 57344
 
-var yyTokenNames []string
-var yyStates []string
-
-const yyLast = 19778
+const yyLast = 24542
 
-var yyAct = [...]int{
-
-	378, 1446, 1445, 1233, 1372, 1001, 395, 1409, 960, 14,
-	1197, 1368, 783, 470, 952, 1297, 1117, 29, 398, 897,
-	1161, 653, 997, 1198, 659, 594, 27, 1060, 32, 1116,
-	961, 661, 1013, 999, 13, 29, 911, 854, 1019, 647,
-	542, 851, 504, 758, 949, 508, 637, 396, 901, 771,
-	963, 372, 544, 907, 892, 501, 381, 1165, 873, 904,
-	633, 577, 1443, 1437, 547, 1442, 1136, 1436, 1435, 579,
-	1051, 1324, 1420, 1398, 1396, 1324, 1051, 1397, 1384, 1349,
-	1328, 1324, 1051, 1051, 1323, 1290, 1285, 1324, 1051, 1286,
-	18, 1275, 1201, 549, 1276, 1051, 545, 1152, 1143, 572,
-	1051, 1051, 1135, 1131, 1130, 1136, 1051, 1051, 1129, 1128,
-	18, 1051, 1051, 1057, 1053, 1052, 1051, 387, 4, 1054,
-	1051, 748, 1051, 548, 747, 20, 1426, 905, 1411, 1362,
-	1262, 1206, 992, 867, 506, 766, 546, 369, 505, 25,
-	506, 638, 8, 1182, 505, 547, 638, 1004, 1020, 649,
-	12, 1182, 1056, 1020, 1444, 1415, 1406, 1403, 577, 649,
-	1367, 547, 1357, 1350, 1341, 1340, 412, 1335, 648, 1334,
-	1333, 1332, 1167, 1313, 549, 10, 1277, 1272, 648, 1271,
-	1270, 1212, 1203, 646, 864, 1149, 906, 1148, 392, 903,
-	549, 1093, 1145, 650, 413, 1103, 1104, 1105, 1144, 1124,
-	1115, 1092, 1089, 1087, 548, 1085, 1084, 1166, 1083, 1082,
-	392, 1072, 1319, 1093, 1064, 11, 1055, 982, 413, 595,
-	548, 7, 1312, 369, 368, 1235, 654, 1412, 567, 1399,
-	1393, 1347, 596, 573, 547, 1195, 1158, 1114, 1080, 1079,
-	1071, 1047, 392, 1045, 1040, 1093, 856, 638, 641, 1103,
-	1104, 1105, 1093, 969, 412, 916, 350, 862, 656, 631,
-	630, 629, 628, 549, 7, 627, 1318, 626, 403, 565,
-	625, 624, 623, 622, 621, 620, 619, 1093, 618, 617,
-	577, 1217, 908, 547, 1317, 616, 615, 559, 560, 561,
-	614, 613, 612, 548, 611, 610, 577, 1177, 609, 547,
-	597, 1059, 7, 559, 560, 561, 1093, 1414, 1379, 1378,
-	547, 595, 549, 578, 1178, 865, 577, 500, 572, 547,
-	563, 649, 577, 998, 1425, 547, 1147, 1146, 549, 1022,
-	607, 418, 1369, 1359, 572, 1358, 472, 1236, 392, 549,
-	648, 1093, 548, 1000, 912, 1103, 1104, 1105, 549, 1288,
-	634, 1439, 546, 1405, 549, 1377, 986, 17, 548, 1075,
-	1070, 1069, 1200, 1068, 577, 1067, 1026, 547, 591, 548,
-	842, 976, 975, 819, 562, 1010, 853, 1009, 548, 1005,
-	1008, 1107, 1007, 357, 548, 1404, 902, 853, 355, 751,
-	351, 762, 356, 979, 568, 908, 549, 352, 503, 589,
-	5, 860, 521, 1432, 759, 760, 1017, 1225, 858, 1456,
-	578, 1222, 1438, 521, 1400, 1356, 632, 6, 16, 1389,
-	600, 601, 602, 1164, 1134, 586, 548, 1455, 471, 1078,
-	1433, 18, 1154, 749, 528, 1107, 498, 360, 535, 519,
-	1109, 966, 1193, 958, 1221, 991, 359, 567, 1188, 359,
-	519, 354, 573, 359, 1344, 750, 1346, 392, 1302, 1301,
-	1093, 1159, 1109, 567, 1103, 1104, 1105, 935, 573, 545,
-	1223, 16, 1298, 1162, 1392, 915, 1343, 1118, 1194, 1088,
-	538, 1199, 1039, 538, 538, 18, 763, 566, 565, 569,
-	570, 1119, 517, 1376, 1109, 550, 551, 552, 553, 554,
-	555, 375, 588, 908, 565, 639, 592, 593, 651, 29,
-	562, 645, 772, 608, 1424, 358, 635, 636, 358, 665,
-	1160, 516, 358, 354, 644, 529, 562, 914, 1230, 657,
-	965, 1107, 578, 562, 664, 571, 520, 654, 411, 1423,
-	363, 1417, 29, 1355, 562, 1299, 658, 520, 578, 31,
-	655, 564, 29, 367, 360, 818, 1427, 1454, 1180, 23,
-	1014, 360, 765, 643, 642, 1093, 908, 31, 578, 547,
-	964, 782, 780, 1459, 578, 755, 550, 551, 552, 553,
-	554, 555, 562, 562, 562, 562, 562, 1345, 562, 665,
-	1109, 518, 550, 551, 552, 553, 554, 555, 781, 756,
-	757, 474, 518, 875, 664, 414, 562, 861, 754, 15,
-	868, 473, 889, 568, 415, 652, 578, 939, 1100, 1101,
-	1102, 872, 1094, 1095, 1096, 1097, 1098, 1099, 548, 568,
-	349, 547, 944, 931, 658, 364, 954, 955, 956, 957,
-	603, 658, 912, 863, 1094, 1095, 1096, 1097, 1098, 1099,
-	1107, 909, 904, 970, 24, 412, 499, 917, 918, 919,
-	920, 1065, 1066, 413, 599, 1452, 874, 552, 553, 554,
-	555, 981, 1100, 1101, 1102, 362, 1094, 1095, 1096, 1097,
-	1098, 1099, 968, 1094, 1095, 1096, 1097, 1098, 1099, 971,
-	1430, 855, 365, 366, 974, 1309, 1028, 1304, 977, 417,
-	978, 371, 416, 972, 973, 980, 566, 852, 402, 1109,
-	556, 557, 558, 1099, 550, 551, 552, 553, 554, 555,
-	905, 859, 566, 787, 497, 496, 556, 557, 558, 788,
-	550, 551, 552, 553, 554, 555, 785, 515, 983, 1096,
-	1097, 1098, 1099, 514, 984, 523, 555, 562, 522, 512,
-	550, 551, 552, 553, 554, 555, 550, 551, 552, 553,
-	554, 555, 401, 537, 598, 666, 537, 537, 1100, 1101,
-	1102, 1361, 1094, 1095, 1096, 1097, 1098, 1099, 1287, 906,
-	536, 1282, 903, 539, 540, 1257, 29, 1260, 1133, 547,
-	19, 3, 1352, 985, 574, 839, 987, 1015, 550, 551,
-	552, 553, 554, 555, 989, 990, 1012, 993, 924, 817,
-	413, 382, 869, 1431, 1388, 1337, 790, 1077, 549, 1408,
-	409, 562, 562, 562, 562, 562, 562, 562, 562, 562,
-	562, 562, 562, 562, 562, 562, 562, 995, 1018, 1016,
-	386, 408, 562, 391, 390, 639, 651, 645, 548, 507,
-	910, 1073, 939, 939, 604, 385, 640, 1021, 383, 753,
-	1371, 994, 533, 857, 580, 899, 1049, 534, 764, 875,
-	761, 1043, 562, 636, 635, 908, 361, 644, 1029, 789,
-	1048, 1027, 353, 513, 803, 531, 752, 1100, 1101, 1102,
-	530, 1094, 1095, 1096, 1097, 1098, 1099, 524, 511, 562,
-	942, 934, 1058, 1090, 932, 923, 922, 26, 1258, 927,
-	913, 606, 527, 1061, 768, 541, 773, 1002, 1259, 1429,
-	871, 1046, 562, 1006, 837, 21, 1011, 22, 370, 840,
-	9, 2, 874, 1, 562, 0, 1106, 0, 939, 939,
-	939, 0, 373, 373, 562, 0, 562, 1074, 0, 1062,
-	1063, 562, 0, 0, 562, 1113, 0, 0, 855, 0,
-	848, 0, 850, 562, 0, 836, 1126, 0, 562, 0,
-	0, 0, 665, 0, 588, 1044, 802, 928, 0, 902,
-	665, 0, 0, 0, 1142, 846, 1150, 664, 0, 562,
-	1132, 1121, 1122, 1123, 0, 664, 0, 0, 1139, 29,
-	0, 0, 0, 0, 562, 0, 0, 0, 0, 0,
-	0, 29, 0, 29, 1151, 0, 0, 1157, 29, 1156,
-	0, 0, 0, 0, 0, 562, 562, 0, 939, 939,
-	0, 1170, 562, 1171, 665, 0, 929, 0, 1176, 926,
-	0, 31, 1106, 1106, 663, 1184, 0, 0, 1179, 664,
-	0, 562, 0, 790, 0, 0, 0, 0, 588, 0,
-	0, 0, 1204, 875, 0, 1181, 1183, 0, 0, 0,
-	0, 1215, 1216, 1218, 31, 0, 0, 562, 0, 1214,
-	1210, 0, 562, 844, 31, 1234, 810, 31, 843, 0,
-	838, 1229, 1207, 849, 0, 939, 939, 939, 939, 939,
-	939, 939, 939, 939, 939, 939, 939, 939, 1237, 939,
-	1239, 1106, 1106, 1106, 663, 1211, 789, 1243, 936, 1228,
-	1241, 803, 1224, 1226, 1227, 0, 874, 0, 959, 1263,
-	0, 0, 930, 1265, 0, 31, 0, 0, 0, 562,
-	1273, 0, 562, 0, 1269, 0, 0, 1266, 0, 805,
-	0, 665, 0, 791, 562, 1172, 1173, 1174, 1175, 804,
-	0, 0, 786, 588, 562, 875, 664, 1280, 0, 1281,
-	1300, 1293, 1294, 1303, 0, 0, 0, 0, 0, 29,
-	29, 29, 29, 0, 0, 1291, 562, 562, 1292, 0,
-	562, 1106, 1106, 562, 0, 0, 665, 562, 0, 1305,
-	1306, 1307, 1308, 562, 0, 900, 1322, 1314, 0, 0,
-	562, 664, 0, 802, 0, 0, 0, 1296, 0, 845,
-	562, 562, 0, 0, 0, 1310, 1311, 0, 874, 847,
-	0, 1330, 562, 1331, 0, 0, 925, 1214, 0, 0,
-	0, 562, 1338, 562, 0, 1106, 1106, 1106, 1106, 1106,
-	1106, 1106, 1106, 1106, 1106, 1106, 1106, 1106, 1342, 0,
-	0, 0, 1106, 0, 0, 0, 0, 0, 562, 562,
-	0, 509, 0, 0, 0, 562, 0, 0, 0, 525,
-	0, 532, 0, 0, 0, 0, 0, 1036, 543, 1038,
-	0, 1353, 0, 0, 0, 0, 0, 581, 582, 583,
-	584, 585, 1365, 0, 0, 587, 0, 1374, 1375, 790,
-	1366, 0, 1034, 0, 0, 0, 562, 562, 31, 1041,
-	1042, 562, 562, 810, 0, 0, 562, 562, 605, 0,
-	562, 0, 0, 0, 0, 0, 0, 562, 0, 0,
-	562, 1387, 0, 0, 939, 0, 0, 0, 1385, 562,
-	0, 0, 0, 936, 936, 790, 0, 0, 1394, 0,
-	1380, 562, 790, 0, 562, 0, 0, 0, 0, 0,
-	0, 562, 789, 0, 562, 0, 1410, 803, 1407, 0,
-	0, 0, 562, 562, 562, 0, 805, 0, 0, 790,
-	791, 0, 1106, 939, 0, 0, 804, 0, 1416, 786,
-	0, 31, 0, 0, 1419, 1110, 1111, 1112, 1422, 0,
-	0, 0, 1421, 0, 562, 1032, 1428, 0, 789, 1106,
-	1037, 0, 0, 803, 0, 789, 0, 746, 0, 0,
-	803, 1441, 1434, 1410, 1440, 0, 1451, 0, 0, 936,
-	936, 936, 0, 0, 0, 0, 0, 0, 0, 1453,
-	0, 562, 789, 0, 0, 0, 0, 803, 0, 0,
-	0, 1460, 0, 0, 790, 0, 0, 373, 0, 802,
-	0, 820, 821, 822, 823, 824, 825, 826, 827, 828,
-	829, 830, 831, 832, 833, 834, 835, 0, 841, 0,
-	0, 0, 0, 0, 0, 1190, 1191, 663, 0, 900,
-	900, 900, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 802, 0, 0, 31, 0,
-	898, 0, 802, 0, 0, 0, 0, 789, 0, 936,
-	936, 31, 803, 0, 921, 0, 933, 0, 943, 945,
-	950, 953, 0, 31, 0, 31, 1033, 0, 962, 802,
-	31, 967, 0, 0, 0, 0, 1035, 0, 790, 663,
-	0, 0, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 0, 1261, 0, 0, 810,
+var yyAct = [...]int16{
+	403, 425, 1633, 408, 1632, 787, 1511, 1519, 24, 945,
+	1497, 1512, 869, 1508, 1489, 1013, 1331, 1501, 1493, 1397,
+	835, 1262, 1558, 624, 1252, 1297, 1406, 629, 1189, 1298,
+	1244, 531, 697, 497, 45, 4, 1255, 691, 392, 32,
+	7, 1014, 1085, 1239, 699, 1070, 1132, 685, 950, 1188,
+	960, 1060, 1065, 1067, 964, 632, 675, 926, 23, 794,
+	7, 1062, 671, 906, 1091, 1002, 808, 903, 428, 560,
+	530, 539, 577, 823, 954, 1016, 391, 406, 397, 548,
+	366, 581, 1076, 546, 957, 28, 413, 28, 614, 582,
+	616, 1659, 584, 1123, 6, 1658, 596, 597, 598, 1644,
+	1639, 1627, 1571, 1626, 1123, 1598, 1568, 1618, 423, 1617,
+	1208, 1560, 1123, 600, 441, 1616, 1605, 1603, 1443, 1571,
+	1568, 586, 1586, 1575, 14, 1443, 1576, 609, 1573, 1570,
+	1567, 1571, 1571, 1568, 1542, 1540, 1528, 1123, 1541, 1443,
+	1469, 614, 1554, 1123, 1447, 584, 40, 1123, 1482, 596,
+	597, 598, 585, 1442, 958, 1388, 1443, 16, 1123, 1383,
+	1373, 1301, 1384, 1374, 1123, 1360, 600, 1224, 419, 373,
+	1123, 1306, 1165, 1242, 586, 1055, 1175, 1176, 1177, 1215,
+	609, 1207, 1123, 1203, 1208, 920, 1123, 1202, 528, 1201,
+	1123, 1200, 1123, 1438, 1123, 1129, 1125, 19, 1123, 614,
+	816, 1126, 801, 584, 534, 585, 583, 596, 597, 598,
+	17, 1042, 1124, 959, 38, 1042, 956, 1123, 1045, 1041,
+	1042, 1046, 1042, 790, 533, 14, 789, 394, 532, 14,
+	533, 18, 586, 21, 532, 676, 1282, 1282, 609, 1128,
+	676, 1254, 1092, 1092, 419, 1675, 440, 13, 1165, 687,
+	1631, 1581, 1175, 1176, 1177, 1579, 687, 1550, 16, 604,
+	1547, 1492, 16, 585, 610, 439, 419, 1487, 686, 1437,
+	1165, 1477, 1470, 1461, 1253, 686, 440, 1316, 1005, 1131,
+	1460, 1454, 1453, 1452, 684, 606, 607, 1451, 1432, 1420,
+	9, 688, 1654, 1414, 1375, 419, 1370, 1369, 19, 1165,
+	602, 1368, 19, 1175, 1176, 1177, 1311, 1303, 1221, 961,
+	1220, 17, 604, 1217, 1216, 1196, 1187, 610, 1164, 1161,
+	1300, 1159, 1157, 1156, 1155, 1154, 584, 1144, 1136, 1127,
+	1035, 608, 18, 419, 692, 916, 440, 1165, 606, 607,
+	394, 1175, 1176, 1177, 615, 393, 583, 601, 544, 633,
+	1333, 13, 13, 602, 1611, 586, 1565, 1562, 1543, 1537,
+	1510, 1507, 1467, 1422, 439, 1179, 1165, 1416, 1413, 634,
+	604, 1295, 1269, 1236, 614, 610, 1226, 1186, 584, 1152,
+	1151, 614, 1143, 1119, 608, 584, 585, 1117, 1112, 584,
+	908, 676, 679, 1022, 969, 929, 370, 615, 914, 599,
+	601, 430, 614, 939, 940, 941, 584, 586, 543, 694,
+	669, 602, 7, 955, 586, 1165, 668, 667, 586, 666,
+	609, 665, 664, 663, 1181, 605, 14, 662, 661, 660,
+	638, 639, 640, 659, 1089, 586, 658, 657, 585, 656,
+	655, 1179, 654, 653, 652, 585, 651, 650, 649, 585,
+	636, 648, 647, 567, 635, 615, 13, 537, 1580, 16,
+	1523, 1522, 1431, 1277, 633, 527, 585, 917, 1278, 1555,
+	687, 1609, 1266, 1061, 1591, 1219, 1218, 1094, 605, 584,
+	1165, 645, 1494, 1479, 1478, 1334, 1063, 965, 1386, 686,
+	672, 1620, 1179, 1549, 1656, 1672, 1395, 1146, 1048, 19,
+	1181, 1145, 1142, 1141, 1436, 1140, 10, 1139, 586, 1098,
+	378, 894, 17, 1029, 381, 1028, 871, 1657, 603, 30,
+	379, 1411, 1181, 593, 594, 595, 445, 587, 588, 589,
+	590, 591, 592, 18, 1077, 499, 605, 905, 27, 585,
+	1212, 599, 677, 689, 549, 673, 674, 905, 683, 13,
+	1548, 1181, 604, 682, 1082, 799, 1081, 610, 1080, 1032,
+	1079, 961, 696, 599, 812, 1321, 703, 702, 1648, 374,
+	599, 603, 547, 1324, 11, 1553, 593, 594, 595, 599,
+	587, 588, 589, 590, 591, 592, 1643, 29, 1036, 1181,
+	556, 1613, 419, 602, 1037, 1619, 1165, 375, 1544, 26,
+	1175, 1176, 1177, 1172, 1173, 1174, 693, 1166, 1167, 1168,
+	1169, 1170, 1171, 364, 834, 785, 670, 1299, 1614, 599,
+	599, 599, 599, 599, 1322, 1476, 599, 554, 1206, 603,
+	615, 498, 368, 623, 593, 594, 595, 615, 587, 588,
+	589, 590, 591, 592, 599, 912, 703, 702, 1647, 501,
+	500, 1059, 910, 1533, 984, 992, 918, 913, 615, 813,
+	822, 696, 833, 821, 1590, 1150, 28, 1407, 696, 961,
+	809, 810, 28, 805, 1629, 438, 695, 1421, 1232, 1172,
+	1173, 1174, 988, 1166, 1167, 1168, 1169, 1170, 1171, 925,
+	627, 20, 44, 44, 44, 394, 797, 1669, 44, 843,
+	792, 915, 525, 806, 807, 1166, 1167, 1168, 1169, 1170,
+	1171, 383, 804, 1464, 572, 1466, 1019, 44, 605, 815,
+	961, 1011, 1320, 1227, 555, 927, 690, 641, 1054, 832,
+	1172, 1173, 1174, 1039, 1166, 1167, 1168, 1169, 1170, 1171,
+	1229, 1247, 565, 1025, 1026, 1021, 1415, 798, 1040, 1237,
+	962, 1504, 953, 1402, 1401, 582, 970, 971, 972, 973,
+	22, 587, 588, 589, 590, 591, 592, 1033, 1172, 1173,
+	1174, 1250, 1166, 1167, 1168, 1169, 1170, 1171, 1646, 553,
+	965, 26, 1398, 1240, 968, 599, 1405, 637, 1024, 1179,
+	25, 1536, 957, 1027, 1463, 382, 1248, 1030, 382, 1031,
+	377, 599, 1190, 1168, 1169, 1170, 1171, 442, 1238, 1294,
+	1428, 603, 556, 587, 588, 589, 590, 591, 592, 1047,
+	587, 588, 589, 590, 591, 592, 589, 590, 591, 592,
+	936, 937, 938, 566, 930, 931, 932, 933, 934, 935,
+	1267, 587, 588, 589, 590, 591, 592, 1465, 1181, 554,
+	1166, 1167, 1168, 1169, 1170, 1171, 1355, 1087, 1358, 1160,
+	1100, 1043, 958, 382, 380, 1111, 824, 559, 1623, 1050,
+	904, 1049, 1191, 599, 599, 599, 599, 599, 599, 599,
+	599, 599, 599, 599, 599, 599, 599, 599, 599, 1056,
+	558, 550, 30, 1075, 599, 30, 30, 377, 1249, 557,
+	1090, 646, 552, 383, 992, 992, 689, 677, 1058, 683,
+	1078, 1072, 921, 1083, 942, 1052, 1053, 1088, 551, 592,
+	1171, 959, 1243, 1622, 956, 599, 967, 674, 673, 1044,
+	1595, 682, 380, 1018, 997, 1093, 1668, 579, 1007, 1008,
+	1009, 1010, 1593, 1121, 1607, 386, 555, 36, 584, 584,
+	692, 843, 599, 1641, 1130, 1023, 1101, 1134, 1135, 1099,
+	573, 681, 680, 574, 575, 580, 1640, 1589, 911, 1583,
+	1475, 383, 1118, 1034, 1017, 599, 1399, 586, 1084, 535,
+	1356, 390, 1517, 369, 1599, 1578, 842, 599, 786, 1178,
+	1357, 992, 992, 992, 1247, 1133, 1594, 599, 545, 599,
+	1280, 553, 1086, 1655, 599, 927, 1066, 599, 585, 585,
+	1254, 1165, 924, 584, 396, 1516, 599, 961, 1147, 3,
+	439, 599, 526, 39, 1250, 1258, 440, 1172, 1173, 1174,
+	1604, 1166, 1167, 1168, 1169, 1170, 1171, 1137, 1138, 1245,
+	371, 44, 387, 1253, 37, 703, 702, 398, 398, 1248,
+	524, 1509, 599, 703, 702, 385, 1204, 1574, 1257, 1412,
+	1, 953, 953, 953, 1211, 1193, 1194, 1195, 444, 443,
+	429, 839, 523, 840, 1246, 837, 704, 1481, 1385, 918,
+	1380, 1230, 388, 389, 1205, 7, 1472, 611, 1251, 407,
+	922, 1612, 1256, 1503, 1532, 1223, 891, 599, 599, 1457,
+	992, 992, 1268, 1149, 599, 1557, 1231, 436, 435, 1235,
+	418, 841, 417, 538, 1178, 1178, 963, 1263, 642, 412,
+	855, 955, 1259, 599, 1265, 1284, 703, 702, 678, 803,
+	1279, 1496, 1057, 909, 617, 952, 571, 1075, 814, 811,
+	1075, 384, 1314, 1315, 1317, 376, 1328, 568, 1283, 599,
+	802, 1249, 561, 918, 599, 1072, 995, 987, 1072, 1162,
+	985, 976, 1272, 1273, 1274, 1275, 975, 992, 992, 992,
+	992, 992, 992, 992, 992, 992, 992, 992, 992, 992,
+	1313, 992, 1309, 1178, 1178, 1178, 1310, 1115, 1337, 966,
+	644, 1323, 1325, 1326, 564, 1341, 1120, 818, 1281, 1335,
+	576, 825, 1234, 1642, 1410, 44, 1608, 1518, 701, 400,
+	44, 599, 1339, 44, 599, 1577, 854, 1363, 1064, 927,
+	44, 44, 1367, 1270, 34, 1271, 599, 843, 889, 35,
+	1276, 1502, 395, 892, 857, 856, 15, 536, 842, 1364,
+	1592, 1552, 599, 703, 702, 1038, 953, 12, 44, 791,
+	1378, 1488, 1327, 1490, 1379, 793, 542, 372, 44, 1391,
+	862, 44, 1394, 5, 2, 1389, 7, 1392, 1390, 888,
+	0, 0, 1185, 843, 0, 1408, 1409, 1404, 0, 1423,
+	843, 0, 1418, 1198, 1256, 0, 599, 599, 701, 1419,
+	599, 1178, 1178, 599, 1396, 1400, 1417, 599, 1403, 0,
+	696, 1214, 0, 599, 0, 0, 0, 843, 0, 599,
+	44, 1075, 0, 1449, 1075, 1433, 703, 702, 0, 599,
+	599, 1441, 0, 0, 0, 0, 0, 927, 0, 1072,
+	599, 0, 1072, 0, 0, 0, 0, 0, 0, 599,
+	0, 599, 0, 1178, 1178, 1178, 1178, 1178, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 0, 0, 422, 0,
+	1178, 1450, 0, 841, 0, 1313, 599, 599, 1462, 0,
+	0, 0, 855, 599, 0, 42, 367, 367, 0, 0,
+	0, 42, 0, 540, 953, 0, 1429, 1430, 0, 0,
+	0, 0, 838, 562, 890, 977, 569, 440, 1304, 0,
+	42, 0, 0, 578, 0, 0, 0, 0, 1485, 0,
+	843, 0, 1486, 0, 618, 619, 620, 621, 622, 1424,
+	1425, 1426, 1427, 1514, 625, 0, 0, 953, 1243, 0,
+	0, 953, 1521, 0, 0, 599, 599, 0, 0, 0,
+	599, 599, 0, 0, 0, 599, 599, 643, 0, 599,
+	0, 696, 0, 0, 1499, 1500, 0, 599, 1505, 1531,
+	599, 0, 0, 0, 992, 1361, 0, 0, 854, 599,
+	1075, 1075, 0, 1529, 1075, 0, 1371, 0, 0, 0,
+	1506, 599, 0, 0, 599, 0, 857, 856, 1072, 1072,
+	0, 1075, 1072, 1538, 0, 0, 599, 0, 980, 599,
+	1247, 0, 843, 0, 0, 1551, 1108, 44, 1110, 1520,
+	0, 0, 862, 0, 842, 1556, 0, 1569, 1561, 0,
+	0, 1566, 0, 0, 1566, 0, 599, 599, 599, 0,
+	1250, 0, 1106, 0, 0, 0, 1178, 992, 0, 0,
+	1524, 1582, 0, 1572, 0, 1245, 784, 0, 0, 0,
+	0, 0, 0, 0, 0, 1248, 0, 1564, 843, 1588,
+	842, 0, 0, 1585, 599, 0, 0, 842, 981, 1596,
+	0, 1600, 800, 1075, 0, 0, 1601, 0, 0, 0,
+	1246, 0, 843, 1606, 1610, 1178, 1113, 1114, 918, 0,
+	44, 1072, 0, 570, 842, 0, 398, 0, 599, 1621,
+	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
+	882, 883, 884, 885, 886, 887, 1625, 893, 1630, 1638,
+	1628, 1624, 0, 0, 0, 0, 0, 982, 843, 0,
+	979, 0, 0, 0, 0, 1104, 0, 1645, 599, 841,
+	1109, 0, 0, 0, 838, 1075, 1652, 1653, 855, 0,
+	951, 0, 843, 1473, 0, 0, 0, 1249, 1661, 0,
+	1662, 599, 0, 1520, 974, 638, 986, 0, 996, 998,
+	1003, 1006, 1670, 1182, 1183, 1184, 0, 1449, 1015, 1671,
+	1673, 1020, 0, 0, 0, 841, 0, 701, 0, 0,
+	0, 0, 841, 0, 855, 701, 0, 842, 0, 0,
+	0, 855, 0, 0, 900, 0, 902, 0, 44, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 44, 841,
+	0, 0, 44, 983, 367, 1663, 0, 0, 855, 0,
+	898, 44, 0, 0, 0, 0, 0, 1663, 0, 0,
+	0, 0, 0, 0, 854, 0, 0, 0, 0, 0,
+	0, 0, 44, 0, 44, 0, 0, 0, 0, 44,
+	0, 0, 857, 856, 0, 0, 1105, 0, 701, 0,
+	0, 0, 0, 0, 0, 0, 1107, 0, 0, 0,
+	0, 0, 1290, 1291, 953, 0, 0, 0, 862, 842,
+	854, 0, 0, 0, 0, 0, 0, 854, 0, 0,
+	0, 0, 540, 0, 44, 0, 0, 0, 857, 856,
+	0, 0, 0, 0, 569, 857, 856, 1051, 0, 0,
+	1587, 0, 841, 0, 854, 0, 44, 978, 896, 578,
+	0, 855, 0, 895, 862, 0, 0, 0, 901, 0,
+	0, 862, 857, 856, 1602, 842, 0, 0, 0, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1615, 1359, 989, 0, 0, 0, 862, 842,
+	0, 0, 0, 0, 1012, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 701, 0, 0, 42, 0,
+	0, 0, 0, 367, 0, 0, 788, 0, 0, 0,
+	0, 1096, 1097, 795, 796, 0, 0, 1103, 0, 0,
+	0, 0, 44, 0, 841, 842, 0, 0, 0, 0,
+	838, 0, 0, 855, 0, 0, 419, 854, 0, 0,
+	1165, 42, 0, 1122, 1175, 1176, 1177, 0, 0, 842,
+	0, 42, 0, 0, 870, 857, 856, 0, 44, 44,
+	44, 44, 0, 0, 0, 0, 0, 0, 701, 951,
+	951, 951, 0, 0, 897, 0, 838, 0, 0, 0,
+	841, 862, 0, 838, 899, 0, 0, 0, 1148, 855,
+	0, 0, 1153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 928, 841, 0, 0, 0, 0, 0,
+	838, 0, 0, 855, 0, 0, 625, 0, 0, 44,
+	0, 0, 1003, 1003, 1003, 0, 0, 0, 0, 854,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1210,
+	0, 414, 8, 0, 1213, 0, 0, 857, 856, 0,
+	841, 0, 0, 0, 31, 33, 0, 0, 0, 855,
+	1225, 0, 8, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 1165, 862, 841, 0, 1175, 1176, 1177, 0,
+	0, 44, 0, 855, 0, 854, 1241, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 790, 0, 31, 936, 936, 936, 936,
-	936, 936, 936, 936, 936, 936, 936, 936, 936, 0,
-	936, 0, 0, 0, 0, 0, 790, 31, 0, 0,
-	0, 789, 0, 0, 802, 810, 803, 0, 577, 0,
-	0, 547, 810, 0, 0, 0, 1278, 0, 0, 0,
-	0, 577, 805, 0, 547, 0, 791, 0, 0, 0,
-	0, 0, 804, 0, 0, 786, 789, 0, 0, 810,
-	549, 803, 790, 392, 0, 900, 1093, 0, 509, 0,
-	1103, 1104, 1105, 549, 0, 988, 663, 0, 0, 789,
-	0, 0, 0, 0, 803, 0, 0, 543, 805, 0,
-	548, 0, 791, 1003, 0, 805, 0, 31, 804, 791,
-	0, 786, 0, 548, 0, 804, 577, 0, 786, 547,
-	0, 31, 31, 31, 31, 0, 0, 0, 802, 0,
-	0, 663, 805, 0, 0, 789, 791, 0, 0, 0,
-	803, 0, 804, 0, 810, 786, 0, 0, 549, 0,
-	577, 0, 0, 547, 0, 0, 0, 559, 560, 561,
-	0, 0, 0, 802, 1187, 0, 0, 1024, 1025, 0,
-	0, 0, 392, 1031, 563, 1093, 0, 1030, 548, 1103,
-	1104, 1105, 549, 0, 31, 0, 802, 0, 572, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1050, 0,
-	0, 0, 0, 0, 0, 0, 0, 805, 0, 0,
-	0, 791, 548, 0, 0, 0, 0, 804, 0, 0,
-	786, 1391, 0, 0, 898, 898, 898, 577, 0, 900,
-	547, 0, 802, 900, 559, 560, 561, 0, 810, 0,
-	0, 392, 1023, 1076, 1093, 1108, 0, 1081, 1103, 1104,
-	1105, 563, 0, 0, 876, 936, 0, 0, 0, 549,
-	0, 886, 887, 888, 0, 572, 1107, 0, 1401, 0,
-	1418, 587, 0, 810, 0, 0, 0, 950, 950, 950,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 548,
-	578, 0, 0, 0, 1138, 0, 810, 0, 0, 1141,
-	0, 805, 0, 578, 936, 791, 0, 0, 0, 0,
-	0, 804, 0, 0, 786, 1153, 0, 567, 31, 0,
-	0, 0, 573, 0, 0, 1109, 0, 0, 0, 0,
-	0, 1163, 0, 0, 0, 0, 805, 0, 0, 0,
-	791, 0, 810, 569, 570, 0, 804, 0, 0, 786,
-	0, 0, 0, 1185, 1186, 0, 0, 1189, 565, 805,
-	0, 1192, 0, 791, 0, 1107, 0, 0, 578, 804,
-	1196, 0, 786, 0, 0, 31, 1202, 0, 0, 0,
-	0, 0, 1209, 0, 0, 0, 0, 0, 0, 571,
-	898, 0, 0, 0, 567, 0, 1219, 1220, 0, 573,
-	0, 0, 578, 0, 0, 805, 1231, 0, 0, 791,
-	0, 0, 0, 0, 0, 804, 0, 0, 786, 1240,
-	569, 570, 1242, 0, 1109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 565, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1267,
-	1268, 0, 0, 0, 0, 0, 0, 0, 1274, 0,
-	0, 0, 0, 0, 0, 0, 571, 962, 0, 0,
-	0, 0, 550, 551, 552, 553, 554, 555, 0, 578,
-	0, 0, 564, 568, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 1109, 0, 1003, 0, 0, 1003, 0,
-	0, 0, 0, 1100, 1101, 1102, 996, 1094, 1095, 1096,
-	1097, 1098, 1099, 0, 0, 0, 0, 0, 1315, 1316,
-	0, 0, 0, 0, 1320, 1321, 0, 0, 0, 0,
-	1325, 1326, 0, 0, 0, 0, 0, 1329, 0, 0,
-	0, 0, 0, 0, 898, 0, 0, 0, 898, 0,
-	550, 551, 552, 553, 554, 555, 0, 0, 0, 0,
-	568, 0, 0, 1336, 0, 0, 0, 1339, 0, 0,
-	0, 0, 0, 0, 0, 0, 566, 0, 0, 0,
-	556, 557, 558, 0, 550, 551, 552, 553, 554, 555,
-	0, 0, 0, 1348, 0, 0, 0, 0, 0, 0,
-	0, 0, 1100, 1101, 1102, 0, 1094, 1095, 1096, 1097,
-	1098, 1099, 0, 0, 0, 0, 0, 1360, 0, 1363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1370,
-	1373, 0, 1003, 1003, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1381, 1382,
-	1383, 0, 0, 566, 0, 0, 0, 556, 557, 558,
-	0, 550, 551, 552, 553, 554, 555, 0, 0, 0,
-	0, 1100, 1101, 1102, 1140, 1094, 1095, 1096, 1097, 1098,
-	1099, 0, 0, 0, 883, 884, 885, 0, 877, 878,
-	879, 880, 881, 882, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1413, 0,
+	0, 44, 44, 857, 856, 0, 614, 0, 0, 854,
+	584, 0, 1180, 838, 596, 597, 598, 1285, 1286, 0,
+	0, 1289, 0, 989, 989, 1292, 0, 857, 856, 862,
+	0, 600, 0, 1179, 1296, 0, 0, 0, 0, 586,
+	1302, 0, 0, 0, 0, 609, 1308, 0, 0, 0,
+	0, 0, 0, 862, 951, 854, 1535, 0, 0, 0,
+	1318, 1319, 0, 0, 0, 0, 0, 0, 0, 1329,
+	585, 0, 0, 857, 856, 0, 0, 0, 0, 854,
+	44, 0, 0, 1338, 0, 614, 1340, 0, 0, 584,
+	0, 0, 1181, 1074, 0, 0, 44, 857, 856, 862,
+	42, 0, 0, 0, 0, 838, 0, 0, 0, 0,
+	989, 989, 989, 1365, 1366, 0, 0, 0, 586, 0,
+	0, 0, 1372, 862, 0, 0, 614, 0, 0, 1584,
+	584, 1015, 0, 0, 0, 0, 0, 0, 0, 0,
+	44, 0, 0, 0, 0, 0, 0, 0, 0, 585,
+	0, 0, 0, 0, 0, 0, 0, 44, 0, 586,
+	0, 838, 0, 0, 0, 1179, 0, 0, 0, 0,
+	0, 0, 614, 0, 0, 0, 584, 604, 0, 44,
+	0, 0, 610, 928, 44, 838, 0, 0, 0, 0,
+	585, 0, 951, 44, 0, 614, 0, 44, 0, 584,
+	0, 0, 0, 606, 607, 586, 0, 0, 0, 0,
+	1434, 1435, 0, 0, 0, 1293, 1439, 1440, 602, 989,
+	989, 0, 1444, 1445, 1181, 44, 44, 44, 586, 1448,
+	0, 838, 0, 0, 0, 951, 585, 0, 0, 951,
+	0, 0, 0, 44, 44, 44, 0, 0, 0, 608,
+	0, 0, 0, 1456, 0, 838, 1288, 1459, 44, 585,
+	0, 0, 615, 0, 0, 601, 0, 0, 0, 0,
+	0, 1172, 1173, 1174, 0, 1166, 1167, 1168, 1169, 1170,
+	1171, 0, 0, 1468, 0, 0, 989, 989, 989, 989,
+	989, 989, 989, 989, 989, 989, 989, 989, 989, 0,
+	989, 1222, 1287, 0, 0, 0, 0, 1480, 0, 1483,
+	0, 1228, 0, 0, 8, 795, 0, 0, 0, 0,
+	0, 1495, 1498, 0, 42, 1102, 1376, 31, 0, 0,
+	31, 31, 0, 0, 0, 0, 0, 1261, 0, 0,
+	1074, 615, 0, 605, 0, 42, 0, 42, 0, 0,
+	0, 0, 42, 626, 0, 0, 0, 630, 631, 1525,
+	1526, 1527, 0, 614, 0, 0, 0, 584, 0, 0,
+	0, 596, 597, 598, 0, 0, 0, 0, 0, 0,
+	0, 0, 615, 0, 0, 0, 0, 0, 600, 0,
+	0, 0, 0, 0, 0, 0, 586, 928, 0, 0,
+	0, 0, 609, 1172, 1173, 1174, 0, 1166, 1167, 1168,
+	1169, 1170, 1171, 0, 0, 0, 0, 0, 0, 1332,
+	0, 0, 0, 0, 0, 0, 1563, 585, 615, 0,
+	0, 0, 0, 0, 0, 0, 603, 0, 0, 0,
+	0, 593, 594, 595, 0, 587, 588, 589, 590, 591,
+	592, 615, 0, 0, 0, 614, 0, 0, 1199, 584,
+	0, 0, 0, 596, 597, 598, 1015, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1597, 0,
+	600, 0, 0, 1498, 0, 0, 33, 0, 586, 0,
+	0, 0, 0, 0, 609, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 928, 0, 0, 0, 0,
+	0, 1074, 0, 0, 1074, 0, 0, 0, 614, 585,
+	0, 0, 584, 0, 587, 588, 589, 590, 591, 592,
+	0, 0, 0, 0, 604, 0, 1637, 1637, 0, 610,
+	0, 42, 42, 42, 42, 0, 0, 0, 0, 0,
+	0, 586, 907, 0, 0, 0, 0, 1637, 0, 0,
+	606, 607, 0, 0, 0, 587, 588, 589, 590, 591,
+	592, 0, 0, 0, 0, 602, 0, 1660, 1637, 0,
+	0, 0, 585, 989, 0, 614, 0, 0, 0, 584,
+	0, 0, 951, 596, 597, 598, 1545, 0, 0, 0,
+	0, 0, 1458, 0, 0, 0, 608, 0, 0, 0,
+	600, 587, 588, 589, 590, 591, 592, 0, 586, 615,
+	0, 0, 601, 0, 609, 0, 604, 0, 0, 0,
+	0, 610, 0, 0, 587, 588, 589, 590, 591, 592,
+	0, 0, 0, 0, 0, 0, 0, 0, 1095, 585,
+	0, 0, 606, 607, 0, 0, 989, 0, 0, 0,
+	0, 0, 0, 0, 1491, 0, 0, 602, 0, 0,
+	1074, 1074, 0, 0, 1074, 0, 0, 0, 0, 0,
+	1261, 0, 0, 0, 1513, 1513, 0, 0, 0, 0,
+	0, 1261, 0, 0, 0, 0, 0, 0, 608, 0,
+	605, 0, 0, 0, 0, 0, 0, 0, 614, 0,
+	0, 615, 584, 0, 601, 0, 596, 597, 598, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 600, 0, 0, 0, 0, 0, 0,
+	0, 586, 0, 0, 0, 0, 0, 609, 0, 0,
+	0, 0, 0, 0, 0, 0, 604, 0, 0, 0,
+	0, 610, 0, 1559, 0, 0, 1069, 0, 0, 0,
+	0, 0, 585, 1074, 615, 0, 0, 0, 0, 1513,
+	0, 0, 606, 607, 0, 0, 0, 0, 0, 0,
+	0, 0, 605, 603, 0, 0, 0, 602, 593, 594,
+	595, 0, 587, 588, 589, 590, 591, 592, 0, 0,
+	0, 0, 1667, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1491, 0, 0, 0, 0, 608, 0,
+	0, 0, 0, 0, 907, 0, 0, 0, 614, 0,
+	1513, 615, 584, 0, 601, 1261, 596, 597, 598, 0,
+	626, 1116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 788, 600, 0, 0, 0, 1559, 0, 0,
+	0, 586, 0, 0, 0, 0, 870, 609, 614, 604,
+	1513, 0, 584, 0, 610, 603, 596, 597, 598, 0,
+	593, 594, 595, 0, 587, 588, 589, 590, 591, 592,
+	0, 0, 585, 600, 1546, 606, 607, 0, 1651, 788,
+	788, 586, 0, 0, 0, 0, 0, 609, 0, 0,
+	602, 0, 605, 0, 0, 1261, 1664, 1665, 1666, 0,
+	614, 0, 0, 0, 584, 626, 0, 1261, 596, 597,
+	598, 1674, 585, 0, 0, 0, 0, 0, 0, 0,
+	0, 608, 0, 0, 0, 600, 0, 587, 588, 589,
+	590, 591, 592, 586, 615, 0, 0, 601, 0, 609,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	962, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1373, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 8, 0, 0,
+	0, 0, 0, 0, 585, 0, 0, 0, 614, 0,
+	0, 0, 584, 0, 0, 0, 596, 597, 598, 604,
+	1260, 0, 0, 1264, 610, 603, 0, 0, 0, 0,
+	593, 594, 595, 600, 587, 588, 589, 590, 591, 592,
+	0, 586, 0, 0, 1539, 606, 607, 609, 0, 0,
+	0, 0, 0, 0, 0, 605, 0, 0, 0, 604,
+	602, 0, 0, 0, 610, 0, 0, 0, 0, 626,
+	0, 0, 585, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 606, 607, 0, 0, 0,
+	0, 608, 0, 0, 0, 0, 0, 0, 0, 0,
+	602, 0, 0, 0, 615, 0, 0, 601, 0, 0,
+	0, 604, 0, 0, 0, 0, 610, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1450, 1450, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 662, 0, 1450, 0,
+	0, 608, 0, 0, 0, 0, 0, 606, 607, 0,
+	0, 0, 0, 0, 615, 0, 0, 601, 603, 0,
+	0, 0, 602, 593, 594, 595, 0, 587, 588, 589,
+	590, 591, 592, 0, 0, 0, 0, 1534, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 8, 604,
+	0, 0, 0, 608, 610, 605, 0, 0, 0, 0,
+	0, 0, 0, 0, 1069, 0, 615, 1069, 0, 601,
+	0, 0, 0, 0, 0, 606, 607, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1450, 33, 34, 35, 36, 37, 38, 39, 40, 669,
-	41, 42, 43, 670, 671, 672, 673, 674, 675, 676,
-	44, 45, 677, 46, 47, 475, 48, 49, 50, 301,
-	302, 476, 303, 304, 678, 51, 52, 53, 54, 55,
-	679, 680, 56, 57, 305, 306, 58, 681, 59, 60,
-	61, 62, 307, 682, 667, 683, 63, 64, 65, 66,
-	477, 67, 68, 69, 684, 70, 71, 72, 73, 74,
-	75, 685, 478, 76, 77, 78, 686, 687, 688, 668,
-	689, 690, 691, 79, 80, 81, 82, 83, 84, 308,
-	309, 85, 692, 86, 693, 87, 88, 89, 90, 91,
-	694, 92, 93, 94, 695, 696, 95, 96, 97, 98,
-	99, 697, 100, 101, 102, 698, 103, 104, 105, 699,
-	106, 107, 108, 109, 310, 110, 111, 112, 311, 700,
-	113, 701, 114, 115, 312, 116, 702, 117, 703, 118,
-	479, 704, 480, 119, 120, 121, 705, 122, 313, 706,
-	314, 123, 707, 124, 125, 126, 127, 128, 481, 129,
-	130, 131, 132, 708, 133, 134, 135, 136, 137, 138,
-	709, 139, 482, 315, 140, 141, 142, 143, 316, 317,
-	710, 318, 711, 144, 483, 484, 145, 485, 146, 147,
-	148, 149, 150, 712, 713, 151, 319, 486, 152, 487,
-	714, 153, 154, 155, 715, 716, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 320, 488, 321, 171, 172, 322, 717, 173, 174,
-	489, 175, 718, 323, 176, 324, 177, 178, 179, 719,
-	180, 720, 721, 181, 182, 183, 722, 723, 184, 325,
-	490, 185, 491, 326, 186, 187, 188, 189, 190, 191,
-	192, 724, 193, 194, 327, 195, 328, 198, 196, 197,
-	725, 199, 200, 201, 202, 203, 204, 205, 206, 329,
-	207, 208, 209, 210, 726, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 727, 222, 223, 492,
-	224, 225, 226, 330, 227, 228, 229, 230, 231, 232,
-	233, 234, 728, 235, 236, 237, 238, 239, 729, 240,
-	241, 331, 242, 243, 493, 244, 245, 332, 246, 730,
-	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
-	257, 333, 731, 258, 259, 732, 260, 494, 261, 262,
-	263, 264, 265, 733, 334, 335, 734, 735, 266, 267,
-	336, 268, 337, 736, 269, 270, 271, 272, 273, 274,
-	275, 737, 738, 276, 277, 278, 279, 280, 739, 740,
-	281, 282, 283, 284, 285, 338, 339, 741, 286, 495,
-	287, 288, 289, 290, 742, 743, 291, 744, 745, 292,
-	293, 294, 295, 296, 297, 340, 341, 342, 343, 344,
-	345, 346, 347, 348, 298, 299, 300, 662, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 660, 0, 0,
-	0, 0, 33, 34, 35, 36, 37, 38, 39, 40,
-	669, 41, 42, 43, 670, 671, 672, 673, 674, 675,
-	676, 44, 45, 677, 46, 47, 475, 48, 49, 50,
-	301, 302, 476, 303, 304, 678, 51, 52, 53, 54,
-	55, 679, 680, 56, 57, 305, 306, 58, 681, 59,
-	60, 61, 62, 307, 682, 667, 683, 63, 64, 65,
-	66, 477, 67, 68, 69, 684, 70, 71, 72, 73,
-	74, 75, 685, 478, 76, 77, 78, 686, 687, 688,
-	668, 689, 690, 691, 79, 80, 81, 82, 83, 84,
-	308, 309, 85, 692, 86, 693, 87, 88, 89, 90,
-	91, 694, 92, 93, 94, 695, 696, 95, 96, 97,
-	98, 99, 697, 100, 101, 102, 698, 103, 104, 105,
-	699, 106, 107, 108, 109, 310, 110, 111, 112, 311,
-	700, 113, 701, 114, 115, 312, 116, 702, 117, 703,
-	118, 479, 704, 480, 119, 120, 121, 705, 122, 313,
-	706, 314, 123, 707, 124, 125, 126, 127, 128, 481,
-	129, 130, 131, 132, 708, 133, 134, 135, 136, 137,
-	138, 709, 139, 482, 315, 140, 141, 142, 143, 316,
-	317, 710, 318, 711, 144, 483, 484, 145, 485, 146,
-	147, 148, 149, 150, 712, 713, 151, 319, 486, 152,
-	487, 714, 153, 154, 155, 715, 716, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 320, 488, 321, 171, 172, 322, 717, 173,
-	174, 489, 175, 718, 323, 176, 324, 177, 178, 179,
-	719, 180, 720, 721, 181, 182, 183, 722, 723, 184,
-	325, 490, 185, 491, 326, 186, 187, 188, 189, 190,
-	191, 192, 724, 193, 194, 327, 195, 328, 198, 196,
-	197, 725, 199, 200, 201, 202, 203, 204, 205, 206,
-	329, 207, 208, 209, 210, 726, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 727, 222, 223,
-	492, 224, 225, 226, 330, 227, 228, 229, 230, 231,
-	232, 233, 234, 728, 235, 236, 237, 238, 239, 729,
-	240, 241, 331, 242, 243, 493, 244, 245, 332, 246,
-	730, 247, 248, 249, 250, 251, 252, 253, 254, 255,
-	256, 257, 333, 731, 258, 259, 732, 260, 494, 261,
-	262, 263, 264, 265, 733, 334, 335, 734, 735, 266,
-	267, 336, 268, 337, 736, 269, 270, 271, 272, 273,
-	274, 275, 737, 738, 276, 277, 278, 279, 280, 739,
-	740, 281, 282, 283, 284, 285, 338, 339, 741, 286,
-	495, 287, 288, 289, 290, 742, 743, 291, 744, 745,
-	292, 293, 294, 295, 296, 297, 340, 341, 342, 343,
-	344, 345, 346, 347, 348, 298, 299, 300, 410, 397,
-	413, 399, 400, 392, 412, 0, 0, 0, 0, 0,
-	0, 0, 0, 33, 34, 35, 36, 37, 38, 39,
-	40, 894, 41, 42, 43, 0, 0, 0, 0, 389,
-	0, 0, 44, 45, 0, 46, 47, 475, 48, 49,
-	50, 301, 452, 476, 453, 454, 0, 51, 52, 53,
-	54, 55, 407, 432, 56, 57, 455, 456, 58, 0,
-	59, 60, 61, 62, 440, 0, 420, 0, 63, 64,
-	65, 66, 477, 67, 68, 69, 0, 70, 71, 72,
-	73, 74, 75, 0, 478, 76, 77, 78, 430, 421,
-	426, 431, 422, 423, 427, 79, 80, 81, 82, 83,
-	84, 457, 458, 85, 0, 86, 0, 87, 88, 89,
-	90, 91, 0, 92, 93, 94, 895, 0, 95, 96,
-	451, 98, 99, 0, 100, 101, 102, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 388, 110, 111, 112,
-	433, 405, 113, 0, 114, 115, 459, 116, 0, 117,
-	0, 118, 479, 0, 480, 119, 120, 121, 0, 122,
-	441, 0, 314, 123, 0, 124, 125, 126, 127, 128,
-	481, 129, 130, 131, 132, 0, 133, 134, 135, 136,
-	137, 138, 0, 139, 482, 315, 140, 141, 142, 143,
-	460, 461, 0, 419, 0, 144, 483, 484, 145, 485,
-	146, 147, 148, 149, 150, 0, 0, 151, 442, 486,
-	152, 487, 0, 153, 154, 155, 424, 425, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 462, 488, 463, 171, 172, 322, 379,
-	173, 174, 489, 175, 406, 439, 176, 464, 177, 178,
-	179, 0, 180, 0, 0, 393, 182, 183, 0, 0,
-	184, 325, 490, 185, 491, 434, 186, 187, 188, 189,
-	190, 191, 192, 0, 193, 194, 435, 195, 328, 198,
-	196, 197, 0, 199, 200, 201, 202, 203, 204, 205,
-	206, 465, 207, 208, 209, 210, 0, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 0, 222,
-	223, 492, 224, 225, 226, 394, 227, 228, 229, 230,
-	231, 232, 233, 234, 0, 235, 236, 237, 238, 239,
-	428, 240, 241, 331, 242, 243, 493, 244, 245, 466,
-	246, 0, 247, 248, 249, 250, 251, 252, 253, 254,
-	255, 256, 257, 436, 0, 258, 259, 0, 260, 494,
-	261, 262, 263, 264, 265, 0, 467, 468, 0, 0,
-	266, 267, 437, 268, 438, 404, 269, 270, 271, 272,
-	273, 274, 275, 0, 0, 276, 277, 278, 279, 280,
-	429, 0, 281, 282, 283, 284, 285, 338, 469, 893,
-	286, 495, 287, 288, 289, 290, 0, 0, 291, 0,
-	0, 292, 293, 294, 295, 296, 297, 340, 443, 444,
-	445, 446, 447, 448, 449, 450, 298, 299, 300, 380,
-	0, 0, 0, 0, 0, 0, 0, 376, 377, 896,
-	0, 0, 0, 0, 0, 0, 384, 891, 410, 397,
-	413, 399, 400, 392, 412, 0, 0, 0, 0, 0,
-	0, 0, 0, 33, 34, 35, 36, 37, 38, 39,
-	40, 0, 41, 42, 43, 0, 0, 0, 0, 389,
-	0, 0, 44, 45, 0, 46, 47, 475, 48, 49,
-	50, 301, 452, 476, 453, 454, 946, 51, 52, 53,
-	54, 55, 407, 432, 56, 57, 455, 456, 58, 0,
-	59, 60, 61, 62, 440, 0, 420, 0, 63, 64,
-	65, 66, 477, 67, 68, 69, 0, 70, 71, 72,
-	73, 74, 75, 0, 478, 76, 77, 78, 430, 421,
-	426, 431, 422, 423, 427, 79, 80, 81, 82, 83,
-	84, 457, 458, 85, 0, 86, 0, 87, 88, 89,
-	90, 91, 0, 92, 93, 94, 0, 0, 95, 96,
-	451, 98, 99, 0, 100, 101, 102, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 388, 110, 111, 112,
-	433, 405, 113, 0, 114, 115, 459, 116, 0, 117,
-	0, 118, 479, 951, 480, 119, 120, 121, 0, 122,
-	441, 0, 314, 123, 0, 124, 125, 126, 127, 128,
-	481, 129, 130, 131, 132, 0, 133, 134, 135, 136,
-	137, 138, 0, 139, 482, 315, 140, 141, 142, 143,
-	460, 461, 0, 419, 0, 144, 483, 484, 145, 485,
-	146, 147, 148, 149, 150, 0, 947, 151, 442, 486,
-	152, 487, 0, 153, 154, 155, 424, 425, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 462, 488, 463, 171, 172, 322, 379,
-	173, 174, 489, 175, 406, 439, 176, 464, 177, 178,
-	179, 0, 180, 0, 0, 393, 182, 183, 0, 0,
-	184, 325, 490, 185, 491, 434, 186, 187, 188, 189,
-	190, 191, 192, 0, 193, 194, 435, 195, 328, 198,
-	196, 197, 0, 199, 200, 201, 202, 203, 204, 205,
-	206, 465, 207, 208, 209, 210, 0, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 0, 222,
-	223, 492, 224, 225, 226, 394, 227, 228, 229, 230,
-	231, 232, 233, 234, 0, 235, 236, 237, 238, 239,
-	428, 240, 241, 331, 242, 243, 493, 244, 245, 466,
-	246, 0, 247, 248, 249, 250, 251, 252, 253, 254,
-	255, 256, 257, 436, 0, 258, 259, 0, 260, 494,
-	261, 262, 263, 264, 265, 0, 467, 468, 0, 948,
-	266, 267, 437, 268, 438, 404, 269, 270, 271, 272,
-	273, 274, 275, 0, 0, 276, 277, 278, 279, 280,
-	429, 0, 281, 282, 283, 284, 285, 338, 469, 0,
-	286, 495, 287, 288, 289, 290, 0, 0, 291, 0,
-	0, 292, 293, 294, 295, 296, 297, 340, 443, 444,
-	445, 446, 447, 448, 449, 450, 298, 299, 300, 380,
-	0, 0, 0, 0, 0, 0, 0, 376, 377, 410,
-	397, 413, 399, 400, 392, 412, 384, 0, 0, 0,
-	0, 0, 0, 0, 33, 34, 35, 36, 37, 38,
-	39, 40, 0, 41, 42, 43, 0, 0, 0, 0,
-	389, 0, 0, 44, 45, 0, 46, 47, 475, 48,
-	49, 50, 301, 452, 476, 453, 454, 0, 51, 52,
-	53, 54, 55, 407, 432, 56, 57, 455, 456, 58,
-	0, 59, 60, 61, 62, 440, 0, 420, 0, 63,
-	64, 65, 66, 477, 67, 68, 69, 0, 70, 71,
-	72, 73, 74, 75, 0, 478, 76, 77, 78, 430,
-	421, 426, 431, 422, 423, 427, 79, 80, 81, 82,
-	83, 84, 457, 458, 85, 0, 86, 0, 87, 88,
-	89, 90, 91, 0, 92, 93, 94, 0, 0, 95,
-	96, 451, 98, 99, 0, 100, 101, 102, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 388, 110, 111,
-	112, 433, 405, 113, 0, 114, 115, 459, 116, 0,
-	117, 0, 118, 479, 0, 480, 119, 120, 121, 0,
-	122, 441, 0, 314, 123, 0, 124, 125, 126, 127,
-	128, 481, 129, 130, 131, 132, 0, 133, 134, 135,
-	136, 137, 138, 0, 139, 482, 315, 140, 141, 142,
-	143, 460, 461, 0, 419, 0, 144, 483, 484, 145,
-	485, 146, 147, 148, 149, 150, 0, 0, 151, 442,
-	486, 152, 487, 0, 153, 154, 155, 424, 425, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 462, 488, 463, 171, 172, 322,
-	379, 173, 174, 489, 175, 406, 439, 176, 464, 177,
-	178, 179, 0, 180, 0, 0, 393, 182, 183, 0,
-	0, 184, 325, 490, 185, 491, 434, 186, 187, 188,
-	189, 190, 191, 192, 0, 193, 194, 435, 195, 328,
-	198, 196, 197, 0, 199, 200, 201, 202, 203, 204,
-	205, 206, 465, 207, 208, 209, 210, 0, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 0,
-	222, 223, 492, 224, 225, 226, 394, 227, 228, 229,
-	230, 231, 232, 233, 234, 0, 235, 236, 237, 238,
-	239, 428, 240, 241, 331, 242, 243, 493, 244, 245,
-	466, 246, 0, 247, 248, 249, 250, 251, 252, 253,
-	254, 255, 256, 257, 436, 0, 258, 259, 0, 260,
-	494, 261, 262, 263, 264, 265, 0, 467, 468, 0,
-	0, 266, 267, 437, 268, 438, 404, 269, 270, 271,
-	272, 273, 274, 275, 0, 0, 276, 277, 278, 279,
-	280, 429, 0, 281, 282, 283, 284, 285, 338, 469,
-	0, 286, 495, 287, 288, 289, 290, 0, 0, 291,
-	0, 0, 292, 293, 294, 295, 296, 297, 340, 443,
-	444, 445, 446, 447, 448, 449, 450, 298, 299, 300,
-	380, 0, 0, 0, 0, 0, 0, 0, 376, 377,
-	410, 397, 413, 399, 400, 392, 412, 384, 1264, 0,
-	0, 0, 0, 0, 0, 33, 34, 35, 36, 37,
-	38, 39, 40, 0, 41, 42, 43, 0, 0, 0,
-	0, 389, 0, 0, 44, 45, 0, 46, 47, 475,
-	48, 49, 50, 301, 452, 476, 453, 454, 0, 51,
-	52, 53, 54, 55, 407, 432, 56, 57, 455, 456,
-	58, 0, 59, 60, 61, 62, 440, 0, 420, 0,
-	63, 64, 65, 66, 477, 67, 68, 69, 0, 70,
-	71, 72, 73, 74, 75, 0, 478, 76, 77, 78,
-	430, 421, 426, 431, 422, 423, 427, 79, 80, 81,
-	82, 83, 84, 457, 458, 85, 0, 86, 0, 87,
-	88, 89, 90, 91, 0, 92, 93, 94, 0, 0,
-	95, 96, 451, 98, 99, 0, 100, 101, 102, 0,
-	103, 104, 105, 0, 106, 107, 108, 109, 388, 110,
-	111, 112, 433, 405, 113, 0, 114, 115, 459, 116,
-	0, 117, 0, 118, 479, 0, 480, 119, 120, 121,
-	0, 122, 441, 0, 314, 123, 0, 124, 125, 126,
-	127, 128, 481, 129, 130, 131, 132, 0, 133, 134,
-	135, 136, 137, 138, 0, 139, 482, 315, 140, 141,
-	142, 143, 460, 461, 0, 419, 0, 144, 483, 484,
-	145, 485, 146, 147, 148, 149, 150, 0, 0, 151,
-	442, 486, 152, 487, 0, 153, 154, 155, 424, 425,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 462, 488, 463, 171, 172,
-	322, 379, 173, 174, 489, 175, 406, 439, 176, 464,
-	177, 178, 179, 0, 180, 0, 0, 393, 182, 183,
-	0, 0, 184, 325, 490, 185, 491, 434, 186, 187,
-	188, 189, 190, 191, 192, 0, 193, 194, 435, 195,
-	328, 198, 196, 197, 0, 199, 200, 201, 202, 203,
-	204, 205, 206, 465, 207, 208, 209, 210, 0, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	0, 222, 223, 492, 224, 225, 226, 394, 227, 228,
-	229, 230, 231, 232, 233, 234, 0, 235, 236, 237,
-	238, 239, 428, 240, 241, 331, 242, 243, 493, 244,
-	245, 466, 246, 0, 247, 248, 249, 250, 251, 252,
-	253, 254, 255, 256, 257, 436, 0, 258, 259, 0,
-	260, 494, 261, 262, 263, 264, 265, 0, 467, 468,
-	0, 0, 266, 267, 437, 268, 438, 404, 269, 270,
-	271, 272, 273, 274, 275, 0, 0, 276, 277, 278,
-	279, 280, 429, 0, 281, 282, 283, 284, 285, 338,
-	469, 0, 286, 495, 287, 288, 289, 290, 0, 0,
-	291, 0, 0, 292, 293, 294, 295, 296, 297, 340,
-	443, 444, 445, 446, 447, 448, 449, 450, 298, 299,
-	300, 380, 0, 0, 0, 0, 0, 0, 0, 376,
-	377, 410, 397, 413, 399, 400, 392, 412, 384, 1205,
-	0, 0, 0, 0, 0, 0, 33, 34, 35, 36,
-	37, 38, 39, 40, 0, 41, 42, 43, 0, 0,
-	0, 0, 389, 0, 0, 44, 45, 0, 46, 47,
-	475, 48, 49, 50, 301, 452, 476, 453, 454, 0,
-	51, 52, 53, 54, 55, 407, 432, 56, 57, 455,
-	456, 58, 0, 59, 60, 61, 62, 440, 0, 420,
-	0, 63, 64, 65, 66, 477, 67, 68, 69, 0,
-	70, 71, 72, 73, 74, 75, 0, 478, 76, 77,
-	78, 430, 421, 426, 431, 422, 423, 427, 79, 80,
-	81, 82, 83, 84, 457, 458, 85, 0, 86, 0,
-	87, 88, 89, 90, 91, 0, 92, 93, 94, 0,
-	0, 95, 96, 451, 98, 99, 0, 100, 101, 102,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 388,
-	110, 111, 112, 433, 405, 113, 0, 114, 115, 459,
-	116, 0, 117, 0, 118, 479, 0, 480, 119, 120,
-	121, 0, 122, 441, 0, 314, 123, 0, 124, 125,
-	126, 127, 128, 481, 129, 130, 131, 132, 0, 133,
-	134, 135, 136, 137, 138, 0, 139, 482, 315, 140,
-	141, 142, 143, 460, 461, 0, 419, 0, 144, 483,
-	484, 145, 485, 146, 147, 148, 149, 150, 0, 0,
-	151, 442, 486, 152, 487, 0, 153, 154, 155, 424,
-	425, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 462, 488, 463, 171,
-	172, 322, 379, 173, 174, 489, 175, 406, 439, 176,
-	464, 177, 178, 179, 0, 180, 0, 0, 393, 182,
-	183, 0, 0, 184, 325, 490, 185, 491, 434, 186,
-	187, 188, 189, 190, 191, 192, 0, 193, 194, 435,
-	195, 328, 198, 196, 197, 0, 199, 200, 201, 202,
-	203, 204, 205, 206, 465, 207, 208, 209, 210, 0,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 0, 222, 223, 492, 224, 225, 226, 394, 227,
-	228, 229, 230, 231, 232, 233, 234, 8, 235, 236,
-	237, 238, 239, 428, 240, 241, 331, 242, 243, 493,
-	244, 245, 466, 246, 0, 247, 248, 249, 250, 251,
-	252, 253, 254, 255, 256, 257, 436, 0, 258, 259,
-	10, 260, 494, 261, 262, 263, 264, 265, 0, 467,
-	468, 0, 0, 266, 267, 437, 268, 438, 404, 269,
-	270, 271, 272, 273, 274, 275, 0, 0, 276, 277,
-	278, 279, 280, 429, 0, 281, 282, 283, 284, 285,
-	590, 469, 0, 286, 495, 287, 288, 289, 290, 0,
-	0, 291, 0, 0, 292, 293, 294, 295, 296, 297,
-	340, 443, 444, 445, 446, 447, 448, 449, 450, 298,
-	299, 300, 380, 0, 0, 0, 0, 0, 0, 0,
-	376, 377, 410, 397, 413, 399, 400, 392, 412, 384,
-	0, 0, 0, 0, 0, 0, 0, 33, 34, 35,
-	36, 37, 38, 39, 40, 0, 41, 42, 43, 0,
-	0, 0, 0, 389, 0, 0, 44, 45, 0, 46,
-	47, 475, 48, 49, 50, 301, 452, 476, 453, 454,
-	0, 51, 52, 53, 54, 55, 407, 432, 56, 57,
-	455, 456, 58, 0, 59, 60, 61, 62, 440, 0,
-	420, 0, 63, 64, 65, 66, 477, 67, 68, 69,
-	0, 70, 71, 72, 73, 74, 75, 0, 478, 76,
-	77, 78, 430, 421, 426, 431, 422, 423, 427, 79,
-	80, 81, 82, 83, 84, 457, 458, 85, 0, 86,
-	0, 87, 88, 89, 90, 91, 0, 92, 93, 94,
-	0, 0, 95, 96, 451, 98, 99, 0, 100, 101,
-	102, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	388, 110, 111, 112, 433, 405, 113, 0, 114, 115,
-	459, 116, 0, 117, 0, 118, 479, 0, 480, 119,
-	120, 121, 0, 122, 441, 0, 314, 123, 0, 124,
-	125, 126, 127, 128, 481, 129, 130, 131, 132, 0,
-	133, 134, 135, 136, 137, 138, 0, 139, 482, 315,
-	140, 141, 142, 143, 460, 461, 0, 419, 0, 144,
-	483, 484, 145, 485, 146, 147, 148, 149, 150, 0,
-	0, 151, 442, 486, 152, 487, 0, 153, 154, 155,
-	424, 425, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 462, 488, 463,
-	171, 172, 322, 379, 173, 174, 489, 175, 406, 439,
-	176, 464, 177, 178, 179, 0, 180, 0, 0, 393,
-	182, 183, 0, 0, 184, 325, 490, 185, 491, 434,
-	186, 187, 188, 189, 190, 191, 192, 0, 193, 194,
-	435, 195, 328, 198, 196, 197, 0, 199, 200, 201,
-	202, 203, 204, 205, 206, 465, 207, 208, 209, 210,
-	0, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 0, 222, 223, 492, 224, 225, 226, 394,
-	227, 228, 229, 230, 231, 232, 233, 234, 0, 235,
-	236, 237, 238, 239, 428, 240, 241, 331, 242, 243,
-	493, 244, 245, 466, 246, 0, 247, 248, 249, 250,
-	251, 252, 253, 254, 255, 256, 257, 436, 0, 258,
-	259, 0, 260, 494, 261, 262, 263, 264, 265, 0,
-	467, 468, 0, 0, 266, 267, 437, 268, 438, 404,
-	269, 270, 271, 272, 273, 274, 275, 0, 0, 276,
-	277, 278, 279, 280, 429, 0, 281, 282, 283, 284,
-	285, 338, 469, 0, 286, 495, 287, 288, 289, 290,
-	0, 0, 291, 0, 0, 292, 293, 294, 295, 296,
-	297, 340, 443, 444, 445, 446, 447, 448, 449, 450,
-	298, 299, 300, 380, 0, 0, 0, 0, 0, 0,
-	0, 376, 377, 410, 397, 413, 399, 400, 392, 412,
-	384, 890, 0, 0, 0, 0, 0, 0, 33, 34,
-	35, 36, 37, 38, 39, 40, 0, 41, 42, 43,
-	0, 0, 0, 0, 389, 0, 0, 44, 45, 0,
-	46, 47, 475, 48, 49, 50, 301, 452, 476, 453,
-	454, 0, 51, 52, 53, 54, 55, 407, 432, 56,
-	57, 455, 456, 58, 0, 59, 60, 61, 62, 440,
-	0, 420, 0, 63, 64, 65, 66, 477, 67, 68,
-	69, 0, 70, 71, 72, 73, 74, 75, 0, 478,
-	76, 77, 78, 430, 421, 426, 431, 422, 423, 427,
-	79, 80, 81, 82, 83, 84, 457, 458, 85, 0,
-	86, 0, 87, 88, 89, 90, 91, 0, 92, 93,
-	94, 0, 0, 95, 96, 451, 98, 99, 0, 100,
-	101, 102, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 388, 110, 111, 112, 433, 405, 113, 0, 114,
-	115, 459, 116, 0, 117, 0, 118, 479, 0, 480,
-	119, 120, 121, 0, 122, 441, 0, 314, 123, 0,
-	124, 125, 126, 127, 128, 481, 129, 130, 131, 132,
-	0, 133, 134, 135, 136, 137, 138, 0, 139, 482,
-	315, 140, 141, 142, 143, 460, 461, 0, 419, 0,
-	144, 483, 484, 145, 485, 146, 147, 148, 149, 150,
-	0, 0, 151, 442, 486, 152, 487, 0, 153, 154,
-	155, 424, 425, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 462, 488,
-	463, 171, 172, 322, 379, 173, 174, 489, 175, 406,
-	439, 176, 464, 177, 178, 179, 0, 180, 0, 0,
-	393, 182, 183, 0, 0, 184, 325, 490, 185, 491,
-	434, 186, 187, 188, 189, 190, 191, 192, 0, 193,
-	194, 435, 195, 328, 198, 196, 197, 0, 199, 200,
-	201, 202, 203, 204, 205, 206, 465, 207, 208, 209,
-	210, 0, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 0, 222, 223, 492, 224, 225, 226,
-	394, 227, 228, 229, 230, 231, 232, 233, 234, 0,
-	235, 236, 237, 238, 239, 428, 240, 241, 331, 242,
-	243, 493, 244, 245, 466, 246, 0, 247, 248, 249,
-	250, 251, 252, 253, 254, 255, 256, 257, 436, 0,
-	258, 259, 0, 260, 494, 261, 262, 263, 264, 265,
-	0, 467, 468, 0, 0, 266, 267, 437, 268, 438,
-	404, 269, 270, 271, 272, 273, 274, 275, 0, 0,
-	276, 277, 278, 279, 280, 429, 0, 281, 282, 283,
-	284, 285, 338, 469, 0, 286, 495, 287, 288, 289,
-	290, 0, 0, 291, 0, 0, 292, 293, 294, 295,
-	296, 297, 340, 443, 444, 445, 446, 447, 448, 449,
-	450, 298, 299, 300, 380, 0, 0, 0, 0, 0,
-	0, 0, 376, 377, 0, 0, 0, 0, 0, 595,
-	870, 384, 410, 397, 413, 399, 400, 392, 412, 0,
-	0, 0, 0, 0, 0, 0, 0, 33, 34, 35,
-	36, 37, 38, 39, 40, 0, 41, 42, 43, 0,
-	0, 0, 0, 389, 0, 0, 44, 45, 0, 46,
-	47, 475, 48, 49, 50, 301, 452, 476, 453, 454,
-	0, 51, 52, 53, 54, 55, 407, 432, 56, 57,
-	455, 456, 58, 0, 59, 60, 61, 62, 440, 0,
-	420, 0, 63, 64, 65, 66, 477, 67, 68, 69,
-	0, 70, 71, 72, 73, 74, 75, 0, 478, 76,
-	77, 78, 430, 421, 426, 431, 422, 423, 427, 79,
-	80, 81, 82, 83, 84, 457, 458, 85, 0, 86,
-	0, 87, 88, 89, 90, 91, 0, 92, 93, 94,
-	0, 0, 95, 96, 451, 98, 99, 0, 100, 101,
-	102, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	388, 110, 111, 112, 433, 405, 113, 0, 114, 115,
-	459, 116, 0, 117, 0, 118, 479, 0, 480, 119,
-	120, 121, 0, 122, 441, 0, 314, 123, 0, 124,
-	125, 126, 127, 128, 481, 129, 130, 131, 132, 0,
-	133, 134, 135, 136, 137, 138, 0, 139, 482, 315,
-	140, 141, 142, 143, 460, 461, 0, 419, 0, 144,
-	483, 484, 145, 485, 146, 147, 148, 149, 150, 0,
-	0, 151, 442, 486, 152, 487, 0, 153, 154, 155,
-	424, 425, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 462, 488, 463,
-	171, 172, 322, 379, 173, 174, 489, 175, 406, 439,
-	176, 464, 177, 178, 179, 0, 180, 0, 0, 393,
-	182, 183, 0, 0, 184, 325, 490, 185, 491, 434,
-	186, 187, 188, 189, 190, 191, 192, 0, 193, 194,
-	435, 195, 328, 198, 196, 197, 0, 199, 200, 201,
-	202, 203, 204, 205, 206, 465, 207, 208, 209, 210,
-	0, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 0, 222, 223, 492, 224, 225, 226, 394,
-	227, 228, 229, 230, 231, 232, 233, 234, 0, 235,
-	236, 237, 238, 239, 428, 240, 241, 331, 242, 243,
-	493, 244, 245, 466, 246, 0, 247, 248, 249, 250,
-	251, 252, 253, 254, 255, 256, 257, 436, 0, 258,
-	259, 0, 260, 494, 261, 262, 263, 264, 265, 0,
-	467, 468, 0, 0, 266, 267, 437, 268, 438, 404,
-	269, 270, 271, 272, 273, 274, 275, 0, 0, 276,
-	277, 278, 279, 280, 429, 0, 281, 282, 283, 284,
-	285, 338, 469, 1213, 286, 495, 287, 288, 289, 290,
-	0, 0, 291, 0, 0, 292, 293, 294, 295, 296,
-	297, 340, 443, 444, 445, 446, 447, 448, 449, 450,
-	298, 299, 300, 380, 0, 0, 0, 0, 0, 0,
-	0, 376, 377, 410, 397, 413, 399, 400, 392, 412,
-	384, 0, 0, 0, 0, 0, 0, 0, 33, 34,
-	35, 36, 37, 38, 39, 40, 0, 41, 42, 43,
-	0, 0, 0, 0, 389, 0, 0, 44, 45, 0,
-	46, 47, 475, 48, 49, 50, 301, 452, 476, 453,
-	454, 0, 51, 52, 53, 54, 55, 407, 432, 56,
-	57, 455, 456, 58, 0, 59, 60, 61, 62, 440,
-	0, 420, 0, 63, 64, 65, 66, 477, 67, 68,
-	69, 0, 70, 71, 72, 73, 74, 75, 0, 478,
-	76, 77, 78, 430, 421, 426, 431, 422, 423, 427,
-	79, 80, 81, 82, 83, 84, 457, 458, 85, 0,
-	86, 0, 87, 88, 89, 90, 91, 0, 92, 93,
-	94, 0, 0, 95, 96, 451, 98, 99, 0, 100,
-	101, 102, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 388, 110, 111, 112, 433, 405, 113, 0, 114,
-	115, 459, 116, 0, 117, 0, 118, 479, 951, 480,
-	119, 120, 121, 0, 122, 441, 0, 314, 123, 0,
-	124, 125, 126, 127, 128, 481, 129, 130, 131, 132,
-	0, 133, 134, 135, 136, 137, 138, 0, 139, 482,
-	315, 140, 141, 142, 143, 460, 461, 0, 419, 0,
-	144, 483, 484, 145, 485, 146, 147, 148, 149, 150,
-	0, 0, 151, 442, 486, 152, 487, 0, 153, 154,
-	155, 424, 425, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 462, 488,
-	463, 171, 172, 322, 379, 173, 174, 489, 175, 406,
-	439, 176, 464, 177, 178, 179, 0, 180, 0, 0,
-	393, 182, 183, 0, 0, 184, 325, 490, 185, 491,
-	434, 186, 187, 188, 189, 190, 191, 192, 0, 193,
-	194, 435, 195, 328, 198, 196, 197, 0, 199, 200,
-	201, 202, 203, 204, 205, 206, 465, 207, 208, 209,
-	210, 0, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 0, 222, 223, 492, 224, 225, 226,
-	394, 227, 228, 229, 230, 231, 232, 233, 234, 0,
-	235, 236, 237, 238, 239, 428, 240, 241, 331, 242,
-	243, 493, 244, 245, 466, 246, 0, 247, 248, 249,
-	250, 251, 252, 253, 254, 255, 256, 257, 436, 0,
-	258, 259, 0, 260, 494, 261, 262, 263, 264, 265,
-	0, 467, 468, 0, 0, 266, 267, 437, 268, 438,
-	404, 269, 270, 271, 272, 273, 274, 275, 0, 0,
-	276, 277, 278, 279, 280, 429, 0, 281, 282, 283,
-	284, 285, 338, 469, 0, 286, 495, 287, 288, 289,
-	290, 0, 0, 291, 0, 0, 292, 293, 294, 295,
-	296, 297, 340, 443, 444, 445, 446, 447, 448, 449,
-	450, 298, 299, 300, 380, 0, 0, 0, 0, 0,
-	0, 0, 376, 377, 410, 397, 413, 399, 400, 392,
-	412, 384, 0, 0, 0, 0, 0, 0, 0, 33,
-	34, 35, 36, 37, 38, 39, 40, 0, 41, 42,
-	43, 0, 0, 0, 0, 389, 0, 0, 44, 45,
-	0, 46, 47, 475, 48, 49, 50, 301, 452, 476,
-	453, 454, 0, 51, 52, 53, 54, 55, 407, 432,
-	56, 57, 455, 456, 58, 0, 59, 60, 61, 62,
-	440, 0, 420, 0, 63, 64, 65, 66, 477, 67,
-	68, 69, 0, 70, 71, 72, 73, 74, 75, 0,
-	478, 76, 77, 78, 430, 421, 426, 431, 422, 423,
-	427, 79, 80, 81, 82, 83, 84, 457, 458, 85,
-	510, 86, 0, 87, 88, 89, 90, 91, 0, 92,
-	93, 94, 0, 0, 95, 96, 451, 98, 99, 0,
-	100, 101, 102, 0, 103, 104, 105, 0, 106, 107,
-	108, 109, 388, 110, 111, 112, 433, 405, 113, 0,
-	114, 115, 459, 116, 0, 117, 0, 118, 479, 0,
-	480, 119, 120, 121, 0, 122, 441, 0, 314, 123,
-	0, 124, 125, 126, 127, 128, 481, 129, 130, 131,
-	132, 0, 133, 134, 135, 136, 137, 138, 0, 139,
-	482, 315, 140, 141, 142, 143, 460, 461, 0, 419,
-	0, 144, 483, 484, 145, 485, 146, 147, 148, 149,
-	150, 0, 0, 151, 442, 486, 152, 487, 0, 153,
-	154, 155, 424, 425, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 462,
-	488, 463, 171, 172, 322, 379, 173, 174, 489, 175,
-	406, 439, 176, 464, 177, 178, 179, 0, 180, 0,
-	0, 393, 182, 183, 0, 0, 184, 325, 490, 185,
-	491, 434, 186, 187, 188, 189, 190, 191, 192, 0,
-	193, 194, 435, 195, 328, 198, 196, 197, 0, 199,
-	200, 201, 202, 203, 204, 205, 206, 465, 207, 208,
-	209, 210, 0, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 0, 222, 223, 492, 224, 225,
-	226, 394, 227, 228, 229, 230, 231, 232, 233, 234,
-	0, 235, 236, 237, 238, 239, 428, 240, 241, 331,
-	242, 243, 493, 244, 245, 466, 246, 0, 247, 248,
-	249, 250, 251, 252, 253, 254, 255, 256, 257, 436,
-	0, 258, 259, 0, 260, 494, 261, 262, 263, 264,
-	265, 0, 467, 468, 0, 0, 266, 267, 437, 268,
-	438, 404, 269, 270, 271, 272, 273, 274, 275, 0,
-	0, 276, 277, 278, 279, 280, 429, 0, 281, 282,
-	283, 284, 285, 338, 469, 0, 286, 495, 287, 288,
-	289, 290, 0, 0, 291, 0, 0, 292, 293, 294,
-	295, 296, 297, 340, 443, 444, 445, 446, 447, 448,
-	449, 450, 298, 299, 300, 380, 0, 0, 0, 0,
-	0, 0, 0, 376, 377, 410, 397, 413, 399, 400,
-	392, 412, 384, 0, 0, 0, 0, 0, 0, 0,
-	33, 34, 35, 36, 37, 38, 39, 40, 0, 41,
-	42, 43, 0, 0, 0, 0, 389, 0, 0, 44,
-	45, 0, 46, 47, 475, 48, 49, 50, 301, 452,
-	476, 453, 454, 0, 51, 52, 53, 54, 55, 407,
-	432, 56, 57, 455, 456, 58, 0, 59, 60, 61,
-	62, 440, 0, 420, 0, 63, 64, 65, 66, 477,
-	67, 68, 69, 0, 70, 71, 72, 73, 74, 75,
-	0, 478, 76, 77, 78, 430, 421, 426, 431, 422,
-	423, 427, 79, 80, 81, 82, 83, 84, 457, 458,
-	85, 0, 86, 0, 87, 88, 89, 90, 91, 0,
-	92, 93, 94, 0, 0, 95, 96, 451, 98, 99,
-	0, 100, 101, 102, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 388, 110, 111, 112, 433, 405, 113,
-	0, 114, 115, 459, 116, 0, 117, 0, 118, 479,
-	0, 480, 119, 120, 121, 0, 122, 441, 0, 314,
-	123, 0, 124, 125, 126, 127, 128, 481, 129, 130,
-	131, 132, 0, 133, 134, 135, 136, 137, 138, 0,
-	139, 482, 315, 140, 141, 142, 143, 460, 461, 0,
-	419, 0, 144, 483, 484, 145, 485, 146, 147, 148,
-	149, 150, 0, 0, 151, 442, 486, 152, 487, 0,
-	153, 154, 155, 424, 425, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	462, 488, 463, 171, 172, 322, 379, 173, 174, 489,
-	175, 406, 439, 176, 464, 177, 178, 179, 0, 180,
-	0, 0, 393, 182, 183, 0, 0, 184, 325, 490,
-	185, 491, 434, 186, 187, 188, 189, 190, 191, 192,
-	0, 193, 194, 435, 195, 328, 198, 196, 197, 0,
-	199, 200, 201, 202, 203, 204, 205, 206, 465, 207,
-	208, 209, 210, 0, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 0, 222, 223, 492, 224,
-	225, 226, 394, 227, 228, 229, 230, 231, 232, 233,
-	234, 0, 235, 236, 237, 238, 239, 428, 240, 241,
-	331, 242, 243, 493, 244, 245, 466, 246, 0, 247,
-	248, 249, 250, 251, 252, 253, 254, 255, 256, 257,
-	436, 0, 258, 259, 0, 260, 494, 261, 262, 263,
-	264, 265, 0, 467, 468, 0, 0, 266, 267, 437,
-	268, 438, 404, 269, 270, 271, 272, 273, 274, 275,
-	0, 0, 276, 277, 278, 279, 280, 429, 0, 281,
-	282, 283, 284, 285, 338, 469, 0, 286, 495, 287,
-	288, 289, 290, 0, 0, 291, 0, 0, 292, 293,
-	294, 295, 296, 297, 340, 443, 444, 445, 446, 447,
-	448, 449, 450, 298, 299, 300, 380, 0, 0, 0,
-	0, 0, 0, 0, 376, 377, 374, 0, 0, 0,
-	0, 0, 0, 384, 410, 397, 413, 399, 400, 392,
-	412, 0, 0, 0, 0, 0, 0, 0, 0, 33,
-	34, 35, 36, 37, 38, 39, 40, 526, 41, 42,
-	43, 0, 0, 0, 0, 389, 0, 0, 44, 45,
-	0, 46, 47, 475, 48, 49, 50, 301, 452, 476,
-	453, 454, 0, 51, 52, 53, 54, 55, 407, 432,
-	56, 57, 455, 456, 58, 0, 59, 60, 61, 62,
-	440, 0, 420, 0, 63, 64, 65, 66, 477, 67,
-	68, 69, 0, 70, 71, 72, 73, 74, 75, 0,
-	478, 76, 77, 78, 430, 421, 426, 431, 422, 423,
-	427, 79, 80, 81, 82, 83, 84, 457, 458, 85,
-	0, 86, 0, 87, 88, 89, 90, 91, 0, 92,
-	93, 94, 0, 0, 95, 96, 451, 98, 99, 0,
-	100, 101, 102, 0, 103, 104, 105, 0, 106, 107,
-	108, 109, 388, 110, 111, 112, 433, 405, 113, 0,
-	114, 115, 459, 116, 0, 117, 0, 118, 479, 0,
-	480, 119, 120, 121, 0, 122, 441, 0, 314, 123,
-	0, 124, 125, 126, 127, 128, 481, 129, 130, 131,
-	132, 0, 133, 134, 135, 136, 137, 138, 0, 139,
-	482, 315, 140, 141, 142, 143, 460, 461, 0, 419,
-	0, 144, 483, 484, 145, 485, 146, 147, 148, 149,
-	150, 0, 0, 151, 442, 486, 152, 487, 0, 153,
-	154, 155, 424, 425, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 462,
-	488, 463, 171, 172, 322, 379, 173, 174, 489, 175,
-	406, 439, 176, 464, 177, 178, 179, 0, 180, 0,
-	0, 393, 182, 183, 0, 0, 184, 325, 490, 185,
-	491, 434, 186, 187, 188, 189, 190, 191, 192, 0,
-	193, 194, 435, 195, 328, 198, 196, 197, 0, 199,
-	200, 201, 202, 203, 204, 205, 206, 465, 207, 208,
-	209, 210, 0, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 0, 222, 223, 492, 224, 225,
-	226, 394, 227, 228, 229, 230, 231, 232, 233, 234,
-	0, 235, 236, 237, 238, 239, 428, 240, 241, 331,
-	242, 243, 493, 244, 245, 466, 246, 0, 247, 248,
-	249, 250, 251, 252, 253, 254, 255, 256, 257, 436,
-	0, 258, 259, 0, 260, 494, 261, 262, 263, 264,
-	265, 0, 467, 468, 0, 0, 266, 267, 437, 268,
-	438, 404, 269, 270, 271, 272, 273, 274, 275, 0,
-	0, 276, 277, 278, 279, 280, 429, 0, 281, 282,
-	283, 284, 285, 338, 469, 0, 286, 495, 287, 288,
-	289, 290, 0, 0, 291, 0, 0, 292, 293, 294,
-	295, 296, 297, 340, 443, 444, 445, 446, 447, 448,
-	449, 450, 298, 299, 300, 380, 0, 0, 0, 0,
-	0, 0, 0, 376, 377, 410, 397, 413, 399, 400,
-	392, 412, 384, 0, 0, 0, 0, 0, 0, 0,
-	33, 34, 35, 36, 37, 38, 39, 40, 0, 41,
-	42, 43, 0, 0, 0, 0, 389, 0, 0, 44,
-	45, 0, 46, 47, 475, 48, 49, 50, 301, 452,
-	476, 453, 454, 0, 51, 52, 53, 54, 55, 407,
-	432, 56, 57, 455, 456, 58, 0, 59, 60, 61,
-	62, 440, 0, 420, 0, 63, 64, 65, 66, 477,
-	67, 68, 69, 0, 70, 71, 72, 73, 74, 75,
-	0, 478, 76, 77, 1449, 430, 421, 426, 431, 422,
-	423, 427, 79, 80, 81, 82, 83, 84, 457, 458,
-	85, 0, 86, 0, 87, 88, 89, 90, 91, 0,
-	92, 93, 94, 0, 0, 95, 96, 451, 98, 99,
-	0, 100, 101, 102, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 388, 110, 111, 112, 433, 405, 113,
-	0, 114, 115, 459, 116, 0, 117, 0, 118, 479,
-	0, 480, 119, 120, 121, 0, 122, 441, 0, 314,
-	123, 0, 124, 125, 126, 127, 128, 481, 129, 130,
-	131, 132, 0, 133, 134, 135, 136, 137, 138, 0,
-	139, 482, 315, 140, 141, 142, 143, 460, 461, 0,
-	419, 0, 144, 483, 484, 145, 485, 146, 147, 148,
-	149, 150, 0, 0, 151, 442, 486, 152, 487, 0,
-	153, 154, 155, 424, 425, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	462, 488, 463, 171, 172, 322, 379, 173, 174, 489,
-	175, 406, 439, 176, 464, 177, 178, 179, 0, 180,
-	0, 0, 393, 182, 183, 0, 0, 184, 325, 490,
-	185, 491, 434, 186, 187, 188, 189, 190, 191, 192,
-	0, 193, 194, 435, 195, 328, 198, 196, 197, 0,
-	199, 200, 201, 202, 203, 204, 205, 206, 465, 207,
-	208, 209, 210, 0, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 0, 222, 223, 492, 224,
-	225, 226, 394, 227, 228, 229, 230, 231, 232, 233,
-	234, 0, 235, 236, 237, 238, 239, 428, 240, 241,
-	331, 242, 243, 493, 244, 245, 466, 246, 0, 247,
-	248, 249, 250, 251, 252, 253, 254, 255, 256, 257,
-	436, 0, 258, 259, 0, 260, 494, 261, 262, 263,
-	264, 265, 0, 467, 468, 0, 0, 266, 267, 437,
-	268, 438, 404, 269, 270, 271, 272, 1448, 274, 275,
-	0, 0, 276, 277, 278, 279, 280, 429, 0, 281,
-	282, 283, 284, 285, 338, 469, 0, 286, 495, 287,
-	288, 289, 290, 0, 0, 291, 0, 0, 292, 293,
-	294, 295, 296, 297, 340, 443, 444, 445, 446, 447,
-	448, 449, 450, 298, 299, 300, 380, 0, 0, 0,
-	0, 0, 0, 0, 376, 377, 410, 397, 413, 399,
-	400, 392, 412, 384, 0, 0, 0, 0, 0, 0,
-	0, 33, 34, 35, 36, 37, 38, 39, 40, 0,
-	41, 42, 43, 0, 0, 0, 0, 389, 0, 0,
-	44, 45, 0, 46, 47, 475, 48, 49, 50, 1447,
-	452, 476, 453, 454, 0, 51, 52, 53, 54, 55,
-	407, 432, 56, 57, 455, 456, 58, 0, 59, 60,
-	61, 62, 440, 0, 420, 0, 63, 64, 65, 66,
-	477, 67, 68, 69, 0, 70, 71, 72, 73, 74,
-	75, 0, 478, 76, 77, 1449, 430, 421, 426, 431,
-	422, 423, 427, 79, 80, 81, 82, 83, 84, 457,
-	458, 85, 0, 86, 0, 87, 88, 89, 90, 91,
-	0, 92, 93, 94, 0, 0, 95, 96, 451, 98,
-	99, 0, 100, 101, 102, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 388, 110, 111, 112, 433, 405,
-	113, 0, 114, 115, 459, 116, 0, 117, 0, 118,
-	479, 0, 480, 119, 120, 121, 0, 122, 441, 0,
-	314, 123, 0, 124, 125, 126, 127, 128, 481, 129,
-	130, 131, 132, 0, 133, 134, 135, 136, 137, 138,
-	0, 139, 482, 315, 140, 141, 142, 143, 460, 461,
-	0, 419, 0, 144, 483, 484, 145, 485, 146, 147,
-	148, 149, 150, 0, 0, 151, 442, 486, 152, 487,
-	0, 153, 154, 155, 424, 425, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 462, 488, 463, 171, 172, 322, 379, 173, 174,
-	489, 175, 406, 439, 176, 464, 177, 178, 179, 0,
-	180, 0, 0, 393, 182, 183, 0, 0, 184, 325,
-	490, 185, 491, 434, 186, 187, 188, 189, 190, 191,
-	192, 0, 193, 194, 435, 195, 328, 198, 196, 197,
-	0, 199, 200, 201, 202, 203, 204, 205, 206, 465,
-	207, 208, 209, 210, 0, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 0, 222, 223, 492,
-	224, 225, 226, 394, 227, 228, 229, 230, 231, 232,
-	233, 234, 0, 235, 236, 237, 238, 239, 428, 240,
-	241, 331, 242, 243, 493, 244, 245, 466, 246, 0,
-	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
-	257, 436, 0, 258, 259, 0, 260, 494, 261, 262,
-	263, 264, 265, 0, 467, 468, 0, 0, 266, 267,
-	437, 268, 438, 404, 269, 270, 271, 272, 1448, 274,
-	275, 0, 0, 276, 277, 278, 279, 280, 429, 0,
-	281, 282, 283, 284, 285, 338, 469, 0, 286, 495,
-	287, 288, 289, 290, 0, 0, 291, 0, 0, 292,
-	293, 294, 295, 296, 297, 340, 443, 444, 445, 446,
-	447, 448, 449, 450, 298, 299, 300, 380, 0, 0,
-	0, 0, 0, 0, 0, 376, 377, 410, 397, 413,
-	399, 400, 392, 412, 384, 0, 0, 0, 0, 0,
-	0, 0, 33, 34, 35, 36, 37, 38, 39, 40,
-	0, 41, 42, 43, 0, 0, 0, 0, 389, 0,
-	0, 44, 45, 0, 46, 47, 475, 48, 49, 50,
-	301, 452, 476, 453, 454, 0, 51, 52, 53, 54,
-	55, 407, 432, 56, 57, 455, 456, 58, 0, 59,
-	60, 61, 62, 440, 0, 420, 0, 63, 64, 65,
-	66, 477, 67, 68, 69, 0, 70, 71, 72, 73,
-	74, 75, 0, 478, 76, 77, 78, 430, 421, 426,
-	431, 422, 423, 427, 79, 80, 81, 82, 83, 84,
-	457, 458, 85, 0, 86, 0, 87, 88, 89, 90,
-	91, 0, 92, 93, 94, 0, 0, 95, 96, 451,
-	98, 99, 0, 100, 101, 102, 0, 103, 104, 105,
-	0, 106, 107, 108, 109, 388, 110, 111, 112, 433,
-	405, 113, 0, 114, 115, 459, 116, 0, 117, 0,
-	118, 479, 0, 480, 119, 120, 121, 0, 122, 441,
-	0, 314, 123, 0, 124, 125, 126, 127, 128, 481,
-	129, 130, 131, 132, 0, 133, 134, 135, 136, 137,
-	138, 0, 139, 482, 315, 140, 141, 142, 143, 460,
-	461, 0, 419, 0, 144, 483, 484, 145, 485, 146,
-	147, 148, 149, 150, 0, 0, 151, 442, 486, 152,
-	487, 0, 153, 154, 155, 424, 425, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 462, 488, 463, 171, 172, 322, 379, 173,
-	174, 489, 175, 406, 439, 176, 464, 177, 178, 179,
-	0, 180, 0, 0, 393, 182, 183, 0, 0, 184,
-	325, 490, 185, 491, 434, 186, 187, 188, 189, 190,
-	191, 192, 0, 193, 194, 435, 195, 328, 198, 196,
-	197, 0, 199, 200, 201, 202, 203, 204, 205, 206,
-	465, 207, 208, 209, 210, 0, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 0, 222, 223,
-	492, 224, 225, 226, 394, 227, 228, 229, 230, 231,
-	232, 233, 234, 0, 235, 236, 237, 238, 239, 428,
-	240, 241, 331, 242, 243, 493, 244, 245, 466, 246,
-	0, 247, 248, 249, 250, 251, 252, 253, 254, 255,
-	256, 257, 436, 0, 258, 259, 0, 260, 494, 261,
-	262, 263, 264, 265, 0, 467, 468, 0, 0, 266,
-	267, 437, 268, 438, 404, 269, 270, 271, 272, 273,
-	274, 275, 0, 0, 276, 277, 278, 279, 280, 429,
-	0, 281, 282, 283, 284, 285, 338, 469, 0, 286,
-	495, 287, 288, 289, 290, 0, 0, 291, 0, 0,
-	292, 293, 294, 295, 296, 297, 340, 443, 444, 445,
-	446, 447, 448, 449, 450, 298, 299, 300, 380, 0,
-	0, 0, 0, 0, 0, 0, 376, 377, 410, 397,
-	413, 399, 400, 392, 412, 384, 0, 0, 0, 0,
-	0, 0, 0, 33, 34, 35, 36, 37, 38, 39,
-	40, 0, 41, 42, 43, 0, 0, 0, 0, 389,
-	0, 0, 44, 45, 0, 46, 47, 475, 48, 49,
-	50, 301, 452, 476, 453, 454, 0, 51, 52, 53,
-	54, 55, 407, 432, 56, 57, 455, 456, 58, 0,
-	59, 60, 61, 62, 440, 0, 420, 0, 63, 64,
-	65, 66, 477, 67, 68, 69, 0, 70, 71, 72,
-	73, 74, 75, 0, 478, 76, 77, 78, 430, 421,
-	426, 431, 422, 423, 427, 79, 80, 81, 82, 83,
-	84, 457, 458, 85, 0, 86, 0, 87, 88, 89,
-	90, 91, 0, 92, 93, 94, 0, 0, 95, 96,
-	451, 98, 99, 0, 100, 101, 102, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 388, 110, 111, 112,
-	433, 405, 113, 0, 114, 115, 459, 116, 0, 117,
-	0, 118, 479, 0, 480, 119, 120, 121, 0, 122,
-	441, 0, 314, 123, 0, 124, 125, 126, 127, 128,
-	481, 129, 130, 131, 132, 0, 133, 134, 135, 136,
-	137, 138, 0, 139, 482, 315, 140, 141, 142, 143,
-	460, 461, 0, 419, 0, 144, 483, 484, 145, 485,
-	146, 147, 148, 149, 150, 0, 0, 151, 442, 486,
-	152, 487, 0, 153, 154, 155, 424, 425, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 462, 488, 463, 171, 1364, 322, 379,
-	173, 174, 489, 175, 406, 439, 176, 464, 177, 178,
-	179, 0, 180, 0, 0, 393, 182, 183, 0, 0,
-	184, 325, 490, 185, 491, 434, 186, 187, 188, 189,
-	190, 191, 192, 0, 193, 194, 435, 195, 328, 198,
-	196, 197, 0, 199, 200, 201, 202, 203, 204, 205,
-	206, 465, 207, 208, 209, 210, 0, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 0, 222,
-	223, 492, 224, 225, 226, 394, 227, 228, 229, 230,
-	231, 232, 233, 234, 0, 235, 236, 237, 238, 239,
-	428, 240, 241, 331, 242, 243, 493, 244, 245, 466,
-	246, 0, 247, 248, 249, 250, 251, 252, 253, 254,
-	255, 256, 257, 436, 0, 258, 259, 0, 260, 494,
-	261, 262, 263, 264, 265, 0, 467, 468, 0, 0,
-	266, 267, 437, 268, 438, 404, 269, 270, 271, 272,
-	273, 274, 275, 0, 0, 276, 277, 278, 279, 280,
-	429, 0, 281, 282, 283, 284, 285, 338, 469, 0,
-	286, 495, 287, 288, 289, 290, 0, 0, 291, 0,
-	0, 292, 293, 294, 295, 296, 297, 340, 443, 444,
-	445, 446, 447, 448, 449, 450, 298, 299, 300, 380,
-	0, 0, 0, 0, 0, 0, 0, 376, 377, 410,
-	397, 413, 399, 400, 392, 412, 384, 0, 0, 0,
-	0, 0, 0, 0, 33, 34, 35, 36, 37, 38,
-	39, 40, 0, 41, 42, 43, 0, 0, 0, 0,
-	389, 0, 0, 44, 45, 0, 46, 47, 475, 48,
-	49, 50, 301, 452, 476, 453, 454, 0, 51, 52,
-	53, 54, 55, 407, 432, 56, 57, 455, 456, 58,
-	0, 59, 60, 61, 62, 440, 0, 420, 0, 63,
-	64, 65, 66, 477, 67, 68, 69, 0, 70, 71,
-	72, 73, 74, 75, 0, 478, 76, 77, 78, 430,
-	421, 426, 431, 422, 423, 427, 79, 80, 81, 82,
-	83, 84, 457, 458, 85, 0, 86, 0, 87, 88,
-	89, 90, 91, 0, 92, 93, 94, 0, 0, 95,
-	96, 451, 98, 99, 0, 100, 101, 102, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 388, 110, 111,
-	112, 433, 405, 113, 0, 114, 115, 459, 116, 0,
-	117, 0, 118, 479, 0, 480, 119, 120, 121, 0,
-	122, 441, 0, 314, 123, 0, 124, 125, 126, 127,
-	128, 481, 129, 130, 131, 132, 0, 133, 134, 135,
-	136, 137, 138, 0, 139, 482, 315, 140, 141, 142,
-	143, 460, 461, 0, 419, 0, 144, 483, 484, 145,
-	485, 146, 147, 148, 149, 150, 0, 0, 151, 442,
-	486, 152, 487, 0, 153, 154, 155, 424, 425, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 462, 488, 463, 171, 172, 322,
-	379, 173, 174, 489, 175, 406, 439, 176, 464, 177,
-	178, 179, 0, 180, 0, 0, 393, 182, 183, 0,
-	0, 184, 325, 490, 185, 491, 434, 186, 187, 188,
-	189, 190, 191, 192, 0, 193, 194, 435, 195, 328,
-	198, 196, 197, 0, 199, 200, 201, 202, 203, 204,
-	205, 206, 465, 207, 208, 209, 210, 0, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 0,
-	222, 223, 492, 224, 225, 226, 394, 227, 228, 229,
-	230, 231, 232, 233, 234, 0, 235, 236, 237, 238,
-	239, 428, 240, 241, 331, 242, 243, 493, 244, 245,
-	466, 246, 0, 247, 248, 249, 250, 251, 252, 253,
-	254, 255, 256, 257, 436, 0, 258, 259, 0, 260,
-	494, 261, 262, 263, 264, 265, 0, 467, 468, 0,
-	0, 266, 267, 437, 268, 438, 404, 269, 270, 271,
-	272, 273, 274, 275, 0, 0, 276, 277, 278, 279,
-	280, 429, 0, 281, 282, 283, 284, 285, 338, 469,
-	0, 286, 495, 287, 288, 289, 290, 0, 0, 291,
-	0, 0, 292, 293, 294, 295, 296, 297, 1354, 443,
-	444, 445, 446, 447, 448, 449, 450, 298, 299, 300,
-	380, 0, 0, 0, 0, 0, 0, 0, 376, 377,
-	410, 397, 413, 399, 400, 392, 412, 384, 0, 0,
-	0, 0, 0, 0, 0, 33, 34, 35, 36, 37,
-	38, 39, 40, 0, 41, 42, 43, 0, 0, 0,
-	0, 389, 0, 0, 44, 45, 0, 46, 47, 475,
-	48, 49, 50, 301, 452, 476, 453, 454, 0, 51,
-	52, 53, 54, 55, 407, 432, 56, 57, 455, 456,
-	58, 0, 59, 60, 61, 62, 440, 0, 420, 0,
-	63, 64, 65, 66, 477, 67, 68, 69, 0, 70,
-	71, 72, 73, 74, 75, 0, 478, 76, 77, 78,
-	430, 421, 426, 431, 422, 423, 427, 79, 80, 81,
-	82, 83, 84, 457, 458, 85, 0, 86, 0, 87,
-	88, 89, 90, 91, 0, 92, 93, 94, 0, 0,
-	95, 96, 451, 98, 99, 0, 100, 101, 102, 0,
-	103, 104, 105, 0, 106, 107, 108, 109, 388, 110,
-	111, 112, 433, 405, 113, 0, 114, 115, 459, 116,
-	0, 117, 0, 118, 479, 0, 480, 119, 120, 121,
-	0, 122, 441, 0, 314, 123, 0, 124, 125, 126,
-	127, 128, 481, 129, 130, 131, 132, 0, 133, 134,
-	135, 136, 137, 138, 0, 139, 482, 315, 140, 141,
-	142, 143, 460, 461, 0, 419, 0, 144, 483, 484,
-	145, 485, 146, 147, 148, 149, 150, 0, 0, 151,
-	442, 486, 152, 487, 0, 153, 154, 155, 424, 425,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 462, 488, 463, 171, 172,
-	322, 0, 173, 174, 489, 175, 406, 439, 176, 464,
-	177, 178, 179, 0, 180, 0, 0, 393, 182, 183,
-	0, 0, 184, 325, 490, 185, 491, 434, 186, 187,
-	188, 189, 190, 191, 192, 0, 193, 194, 435, 195,
-	328, 198, 196, 197, 0, 199, 200, 201, 202, 203,
-	204, 205, 206, 465, 207, 208, 209, 210, 0, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	0, 222, 223, 492, 224, 225, 226, 941, 227, 228,
-	229, 230, 231, 232, 233, 234, 0, 235, 236, 237,
-	238, 239, 428, 240, 241, 331, 242, 243, 493, 244,
-	245, 466, 246, 0, 247, 248, 249, 250, 251, 252,
-	253, 254, 255, 256, 257, 436, 0, 258, 259, 0,
-	260, 494, 261, 262, 263, 264, 265, 0, 467, 468,
-	0, 0, 266, 267, 437, 268, 438, 404, 269, 270,
-	271, 272, 273, 274, 275, 0, 0, 276, 277, 278,
-	279, 280, 429, 0, 281, 282, 283, 284, 285, 338,
-	469, 0, 286, 495, 287, 288, 289, 290, 0, 0,
-	291, 0, 0, 292, 293, 294, 295, 296, 297, 340,
-	443, 444, 445, 446, 447, 448, 449, 450, 298, 299,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 937,
-	938, 410, 397, 413, 399, 400, 392, 412, 940, 0,
-	0, 0, 0, 0, 0, 0, 33, 34, 35, 36,
-	37, 38, 39, 40, 0, 41, 42, 43, 0, 0,
-	0, 0, 389, 0, 0, 44, 45, 0, 46, 47,
-	475, 48, 49, 50, 301, 452, 476, 453, 454, 0,
-	51, 52, 53, 54, 55, 407, 432, 56, 57, 455,
-	456, 58, 0, 59, 60, 61, 62, 440, 0, 420,
-	0, 63, 64, 65, 66, 477, 67, 68, 69, 0,
-	70, 71, 72, 73, 74, 75, 0, 478, 76, 77,
-	78, 430, 421, 426, 431, 422, 423, 427, 79, 80,
-	81, 82, 83, 84, 457, 458, 85, 0, 86, 0,
-	87, 88, 89, 90, 91, 0, 92, 93, 94, 0,
-	0, 95, 96, 451, 98, 99, 0, 100, 101, 102,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 388,
-	110, 111, 112, 433, 405, 113, 0, 114, 115, 459,
-	116, 0, 117, 0, 118, 479, 0, 480, 119, 120,
-	121, 0, 122, 441, 0, 314, 123, 0, 124, 125,
-	126, 127, 128, 481, 129, 130, 131, 132, 0, 133,
-	134, 135, 136, 137, 138, 0, 139, 482, 315, 140,
-	141, 142, 143, 460, 461, 0, 419, 0, 144, 0,
-	484, 145, 485, 146, 147, 148, 149, 150, 0, 0,
-	151, 442, 486, 152, 487, 0, 153, 154, 155, 424,
-	425, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 462, 488, 463, 171,
-	172, 322, 0, 173, 174, 489, 175, 406, 439, 176,
-	464, 177, 178, 179, 0, 180, 0, 0, 393, 182,
-	183, 0, 0, 184, 325, 490, 185, 491, 434, 186,
-	187, 188, 189, 190, 191, 192, 0, 193, 194, 435,
-	195, 328, 198, 196, 197, 0, 199, 200, 201, 202,
-	203, 204, 205, 206, 465, 207, 208, 209, 210, 0,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 0, 222, 223, 492, 224, 225, 226, 941, 227,
-	228, 229, 230, 231, 232, 233, 234, 0, 235, 236,
-	237, 238, 239, 428, 240, 241, 331, 242, 243, 493,
-	244, 245, 466, 246, 0, 247, 248, 249, 250, 251,
-	252, 253, 254, 255, 256, 257, 436, 0, 258, 259,
-	0, 260, 494, 261, 262, 263, 264, 265, 0, 467,
-	468, 0, 0, 266, 267, 437, 268, 438, 404, 269,
-	270, 271, 272, 273, 274, 275, 0, 0, 276, 277,
-	278, 279, 280, 429, 0, 281, 282, 283, 284, 285,
-	338, 469, 0, 286, 495, 287, 288, 289, 290, 0,
-	0, 291, 0, 0, 292, 293, 294, 295, 296, 297,
-	340, 443, 444, 445, 446, 447, 448, 449, 450, 298,
-	299, 300, 0, 0, 0, 0, 0, 0, 0, 0,
-	937, 938, 410, 397, 413, 399, 400, 0, 412, 940,
-	0, 0, 0, 0, 0, 0, 0, 33, 34, 35,
-	36, 37, 38, 39, 40, 0, 41, 42, 43, 0,
-	0, 0, 0, 389, 0, 0, 44, 45, 0, 46,
-	47, 475, 48, 49, 50, 301, 452, 476, 453, 454,
-	0, 51, 52, 53, 54, 55, 407, 432, 56, 57,
-	455, 456, 58, 0, 59, 60, 61, 62, 440, 0,
-	420, 0, 63, 64, 65, 66, 477, 67, 68, 69,
-	0, 70, 71, 72, 73, 74, 75, 0, 478, 76,
-	77, 78, 430, 421, 426, 431, 422, 423, 427, 79,
-	80, 81, 82, 83, 84, 457, 458, 85, 0, 86,
-	0, 87, 88, 89, 90, 91, 0, 92, 93, 94,
-	0, 0, 95, 96, 451, 98, 99, 0, 100, 101,
-	102, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	388, 110, 111, 112, 433, 405, 113, 0, 114, 115,
-	459, 116, 0, 117, 0, 118, 479, 0, 480, 119,
-	120, 121, 0, 122, 441, 0, 314, 123, 0, 124,
-	125, 126, 127, 128, 481, 129, 130, 131, 132, 0,
-	133, 134, 135, 136, 137, 138, 0, 139, 482, 315,
-	140, 141, 142, 143, 460, 461, 0, 419, 0, 144,
-	483, 484, 145, 485, 146, 147, 148, 149, 150, 0,
-	0, 151, 442, 486, 152, 487, 0, 153, 154, 155,
-	424, 425, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 462, 488, 463,
-	171, 172, 322, 0, 173, 174, 489, 175, 406, 439,
-	176, 464, 177, 178, 179, 0, 180, 0, 0, 181,
-	182, 183, 0, 0, 184, 325, 490, 185, 491, 434,
-	186, 187, 188, 189, 190, 191, 192, 0, 193, 194,
-	435, 195, 328, 198, 196, 197, 0, 199, 200, 201,
-	202, 203, 204, 205, 206, 465, 207, 208, 209, 210,
-	0, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 0, 222, 223, 492, 224, 225, 226, 941,
-	227, 228, 229, 230, 231, 232, 233, 234, 0, 235,
-	236, 237, 238, 239, 428, 240, 241, 331, 242, 243,
-	493, 244, 245, 466, 246, 0, 247, 248, 249, 250,
-	251, 252, 253, 254, 255, 256, 257, 436, 0, 258,
-	259, 0, 260, 494, 261, 262, 263, 264, 265, 0,
-	467, 468, 0, 0, 266, 267, 437, 268, 438, 404,
-	269, 270, 271, 272, 273, 274, 275, 0, 0, 276,
-	277, 278, 279, 280, 429, 0, 281, 282, 283, 284,
-	285, 338, 469, 0, 286, 495, 287, 288, 289, 290,
-	0, 0, 291, 0, 0, 292, 293, 294, 295, 296,
-	297, 340, 443, 444, 445, 446, 447, 448, 449, 450,
-	298, 299, 300, 0, 0, 0, 410, 397, 413, 399,
-	400, 0, 412, 0, 0, 0, 0, 0, 0, 0,
-	940, 33, 34, 35, 36, 37, 38, 39, 40, 0,
-	41, 42, 43, 0, 0, 0, 0, 389, 0, 0,
-	44, 45, 0, 46, 47, 475, 48, 49, 50, 301,
-	452, 476, 453, 454, 0, 1279, 52, 53, 54, 55,
-	407, 432, 56, 57, 455, 456, 58, 0, 59, 60,
-	61, 62, 440, 0, 420, 0, 63, 64, 65, 66,
-	477, 67, 68, 69, 0, 70, 71, 72, 73, 74,
-	75, 0, 478, 76, 77, 78, 430, 421, 426, 431,
-	422, 423, 427, 79, 80, 81, 82, 83, 84, 457,
-	458, 85, 0, 86, 0, 87, 88, 89, 90, 91,
-	0, 92, 93, 94, 0, 0, 95, 96, 451, 98,
-	99, 0, 100, 101, 102, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 388, 110, 111, 112, 433, 405,
-	113, 0, 114, 115, 459, 116, 0, 117, 0, 118,
-	479, 0, 480, 119, 120, 121, 0, 122, 441, 0,
-	314, 123, 0, 124, 125, 126, 127, 128, 481, 129,
-	130, 131, 132, 0, 133, 134, 135, 136, 137, 138,
-	0, 139, 482, 315, 140, 141, 142, 143, 460, 461,
-	0, 419, 0, 144, 483, 484, 145, 485, 146, 147,
-	148, 149, 150, 0, 0, 151, 442, 486, 152, 487,
-	0, 153, 154, 155, 424, 425, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 462, 488, 463, 171, 172, 322, 0, 173, 174,
-	489, 175, 406, 439, 176, 464, 177, 178, 179, 0,
-	180, 0, 0, 181, 182, 183, 0, 0, 184, 325,
-	490, 185, 491, 434, 186, 187, 188, 189, 190, 191,
-	192, 0, 193, 194, 435, 195, 328, 198, 196, 197,
-	0, 199, 200, 201, 202, 203, 204, 205, 206, 465,
-	207, 208, 209, 210, 0, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 0, 222, 223, 492,
-	224, 225, 226, 941, 227, 228, 229, 230, 231, 232,
-	233, 234, 0, 235, 236, 237, 238, 239, 428, 240,
-	241, 331, 242, 243, 493, 244, 245, 466, 246, 0,
-	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
-	257, 436, 0, 258, 259, 0, 260, 494, 261, 262,
-	263, 264, 265, 0, 467, 468, 0, 0, 266, 267,
-	437, 268, 438, 404, 269, 270, 271, 272, 273, 274,
-	275, 0, 0, 276, 277, 278, 279, 280, 429, 0,
-	281, 282, 283, 284, 285, 338, 469, 0, 286, 495,
-	287, 288, 289, 290, 0, 0, 291, 0, 0, 292,
-	293, 294, 295, 296, 297, 340, 443, 444, 445, 446,
-	447, 448, 449, 450, 298, 299, 300, 0, 0, 0,
-	410, 397, 413, 399, 400, 392, 412, 0, 0, 0,
-	0, 0, 0, 0, 940, 33, 34, 35, 36, 37,
-	38, 39, 40, 0, 41, 42, 43, 0, 0, 0,
-	0, 389, 0, 0, 44, 45, 0, 46, 47, 475,
-	48, 49, 50, 0, 452, 476, 453, 454, 0, 51,
-	52, 53, 54, 55, 407, 432, 56, 57, 455, 456,
-	58, 0, 59, 60, 61, 62, 440, 0, 420, 0,
-	63, 64, 65, 66, 477, 67, 68, 69, 0, 70,
-	71, 72, 73, 74, 75, 0, 478, 76, 77, 1449,
-	430, 421, 426, 431, 422, 423, 427, 79, 80, 81,
-	82, 83, 84, 457, 458, 85, 0, 86, 0, 87,
-	88, 89, 90, 91, 0, 92, 93, 94, 0, 0,
-	95, 96, 451, 98, 99, 0, 100, 101, 102, 0,
-	103, 104, 105, 0, 106, 107, 108, 109, 388, 110,
-	111, 112, 433, 405, 113, 0, 114, 115, 459, 116,
-	0, 117, 0, 118, 479, 0, 480, 119, 120, 121,
-	0, 122, 441, 0, 314, 123, 0, 124, 125, 126,
-	127, 128, 0, 129, 130, 131, 132, 0, 133, 134,
-	135, 136, 137, 138, 0, 139, 482, 315, 140, 141,
-	142, 143, 460, 461, 0, 419, 0, 144, 0, 0,
-	145, 485, 146, 147, 148, 149, 150, 0, 0, 151,
-	442, 486, 152, 0, 0, 153, 154, 155, 424, 425,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 462, 488, 463, 171, 172,
-	322, 379, 173, 174, 0, 175, 406, 439, 176, 464,
-	177, 178, 179, 0, 180, 0, 0, 393, 182, 183,
-	0, 0, 184, 325, 490, 185, 491, 434, 186, 187,
-	188, 189, 190, 191, 192, 0, 193, 194, 435, 195,
-	328, 198, 196, 197, 0, 199, 200, 201, 202, 203,
-	204, 205, 206, 465, 207, 208, 209, 210, 0, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	0, 222, 223, 492, 224, 225, 226, 394, 227, 228,
-	229, 230, 231, 232, 233, 234, 0, 235, 236, 237,
-	238, 239, 428, 240, 241, 331, 242, 243, 0, 244,
-	245, 466, 246, 0, 247, 248, 249, 250, 251, 252,
-	253, 254, 255, 256, 257, 436, 0, 258, 259, 0,
-	260, 494, 261, 262, 263, 264, 265, 0, 467, 468,
-	0, 0, 266, 267, 437, 268, 438, 404, 269, 270,
-	271, 272, 1448, 274, 275, 0, 0, 276, 277, 278,
-	279, 280, 429, 0, 281, 282, 283, 284, 285, 338,
-	469, 0, 286, 495, 287, 288, 289, 290, 0, 0,
-	291, 0, 0, 292, 293, 294, 295, 296, 297, 340,
-	443, 444, 445, 446, 447, 448, 449, 450, 298, 299,
-	300, 0, 0, 0, 0, 0, 0, 0, 0, 376,
-	377, 410, 397, 413, 399, 400, 392, 412, 384, 0,
-	0, 0, 0, 0, 0, 0, 33, 34, 35, 36,
-	37, 38, 39, 40, 0, 41, 42, 43, 0, 0,
-	0, 0, 389, 0, 0, 44, 45, 0, 46, 47,
-	475, 48, 49, 50, 0, 452, 476, 453, 454, 0,
-	51, 52, 53, 54, 55, 407, 432, 56, 57, 455,
-	456, 58, 0, 59, 60, 61, 62, 440, 0, 420,
-	0, 63, 64, 65, 66, 477, 67, 68, 69, 0,
-	70, 71, 72, 73, 74, 75, 0, 478, 76, 77,
-	78, 430, 421, 426, 431, 422, 423, 427, 79, 80,
-	81, 82, 83, 84, 457, 458, 85, 0, 86, 0,
-	87, 88, 89, 90, 91, 0, 92, 93, 94, 0,
-	0, 95, 96, 451, 98, 99, 0, 100, 101, 102,
-	0, 103, 0, 105, 0, 106, 107, 108, 109, 388,
-	110, 111, 112, 433, 405, 113, 0, 114, 115, 459,
-	116, 0, 117, 0, 118, 479, 0, 480, 119, 120,
-	121, 0, 122, 441, 0, 314, 123, 0, 124, 125,
-	126, 127, 128, 0, 129, 130, 131, 132, 0, 133,
-	134, 135, 136, 137, 138, 0, 139, 482, 315, 140,
-	141, 142, 143, 460, 461, 0, 419, 0, 144, 0,
-	0, 145, 485, 146, 147, 148, 149, 150, 0, 0,
-	151, 442, 486, 152, 0, 0, 153, 154, 155, 424,
-	425, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 462, 488, 463, 171,
-	172, 322, 379, 173, 174, 0, 175, 406, 439, 176,
-	464, 177, 178, 179, 0, 180, 0, 0, 393, 182,
-	183, 0, 0, 184, 325, 490, 185, 491, 434, 186,
-	187, 188, 189, 190, 191, 192, 0, 193, 194, 435,
-	195, 328, 198, 196, 197, 0, 199, 200, 201, 202,
-	203, 204, 205, 206, 465, 207, 208, 209, 210, 0,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 0, 222, 223, 492, 224, 225, 226, 394, 227,
-	228, 229, 230, 231, 232, 233, 234, 0, 235, 236,
-	237, 238, 239, 428, 240, 241, 331, 242, 243, 0,
-	244, 245, 466, 246, 0, 247, 248, 249, 250, 251,
-	252, 253, 254, 255, 256, 257, 436, 0, 258, 259,
-	0, 260, 494, 261, 262, 263, 264, 265, 0, 467,
-	468, 0, 0, 266, 267, 437, 268, 438, 404, 269,
-	270, 271, 272, 273, 274, 275, 0, 0, 276, 277,
-	278, 279, 280, 429, 0, 281, 282, 283, 284, 285,
-	338, 469, 0, 286, 495, 287, 288, 289, 290, 0,
-	0, 291, 0, 0, 292, 293, 294, 295, 296, 297,
-	340, 443, 444, 445, 446, 447, 448, 449, 450, 298,
-	299, 300, 0, 0, 0, 0, 0, 0, 30, 0,
-	376, 377, 0, 876, 0, 0, 0, 0, 0, 384,
-	886, 887, 888, 33, 34, 35, 36, 37, 38, 39,
-	40, 0, 41, 42, 43, 0, 0, 0, 0, 0,
-	0, 0, 44, 45, 0, 46, 47, 0, 48, 49,
-	50, 301, 302, 0, 303, 304, 0, 51, 52, 53,
-	54, 55, 0, 0, 56, 57, 305, 306, 58, 0,
-	59, 60, 61, 62, 307, 0, 0, 0, 63, 64,
-	65, 66, 0, 67, 68, 69, 0, 70, 71, 72,
-	73, 74, 75, 0, 0, 76, 77, 78, 0, 0,
-	0, 0, 0, 0, 0, 79, 80, 81, 82, 83,
-	84, 308, 309, 85, 0, 86, 0, 87, 88, 89,
-	90, 91, 0, 92, 93, 94, 0, 0, 95, 96,
-	97, 98, 99, 0, 100, 101, 102, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 310, 110, 111, 112,
-	311, 0, 113, 0, 114, 115, 312, 116, 0, 117,
-	0, 118, 0, 0, 0, 119, 120, 121, 0, 122,
-	313, 0, 314, 123, 0, 124, 125, 126, 127, 128,
-	0, 129, 130, 131, 132, 0, 133, 134, 135, 136,
-	137, 138, 0, 139, 0, 315, 140, 141, 142, 143,
-	316, 317, 0, 318, 0, 144, 0, 0, 145, 0,
-	146, 147, 148, 149, 150, 0, 0, 151, 319, 0,
-	152, 0, 0, 153, 154, 155, 0, 0, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 320, 0, 321, 171, 172, 322, 0,
-	173, 174, 0, 175, 0, 323, 176, 324, 177, 178,
-	179, 0, 180, 0, 0, 181, 182, 183, 0, 0,
-	184, 325, 0, 185, 0, 326, 186, 187, 188, 189,
-	190, 191, 192, 0, 193, 194, 327, 195, 328, 198,
-	196, 197, 0, 199, 200, 201, 202, 203, 204, 205,
-	206, 329, 207, 208, 209, 210, 0, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 0, 222,
-	223, 0, 224, 225, 226, 330, 227, 228, 229, 230,
-	231, 232, 233, 234, 0, 235, 236, 237, 238, 239,
-	0, 240, 241, 331, 242, 243, 0, 244, 245, 332,
-	246, 0, 247, 248, 249, 250, 251, 252, 253, 254,
-	255, 256, 257, 333, 0, 258, 259, 0, 260, 0,
-	261, 262, 263, 264, 265, 0, 334, 335, 0, 0,
-	266, 267, 336, 268, 337, 0, 269, 270, 271, 272,
-	273, 274, 275, 0, 0, 276, 277, 278, 279, 280,
-	0, 0, 281, 282, 283, 284, 285, 338, 339, 0,
-	286, 0, 287, 288, 289, 290, 0, 0, 291, 0,
-	0, 292, 293, 294, 295, 296, 297, 340, 341, 342,
-	343, 344, 345, 346, 347, 348, 298, 299, 300, 30,
-	0, 0, 0, 883, 884, 885, 0, 877, 878, 879,
-	880, 881, 882, 0, 33, 34, 35, 36, 37, 38,
-	39, 40, 0, 41, 42, 43, 0, 0, 0, 0,
-	0, 0, 0, 44, 45, 0, 46, 47, 0, 48,
-	49, 50, 301, 302, 0, 303, 304, 0, 51, 52,
-	53, 54, 55, 0, 0, 56, 57, 305, 306, 58,
-	0, 59, 60, 61, 62, 307, 0, 0, 0, 63,
-	64, 65, 66, 0, 67, 68, 69, 0, 70, 71,
-	72, 73, 74, 75, 0, 0, 76, 77, 78, 0,
-	0, 0, 0, 0, 0, 0, 79, 80, 81, 82,
-	83, 84, 308, 309, 85, 0, 86, 0, 87, 88,
-	89, 90, 91, 0, 92, 93, 94, 0, 0, 95,
-	96, 97, 98, 99, 0, 100, 101, 102, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 310, 110, 111,
-	112, 311, 0, 113, 0, 114, 115, 312, 116, 0,
-	117, 0, 118, 0, 0, 0, 119, 120, 121, 0,
-	122, 313, 0, 314, 123, 0, 124, 125, 126, 127,
-	128, 0, 129, 130, 131, 132, 0, 133, 134, 135,
-	136, 137, 138, 0, 139, 0, 315, 140, 141, 142,
-	143, 316, 317, 0, 318, 0, 144, 0, 0, 145,
-	0, 146, 147, 148, 149, 150, 0, 0, 151, 319,
-	0, 152, 0, 0, 153, 154, 155, 0, 0, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 320, 0, 321, 171, 172, 322,
-	0, 173, 174, 0, 175, 0, 323, 176, 324, 177,
-	178, 179, 0, 180, 0, 0, 181, 182, 183, 0,
-	0, 184, 325, 0, 185, 0, 326, 186, 187, 188,
-	189, 190, 191, 192, 0, 193, 194, 327, 195, 328,
-	198, 196, 197, 0, 199, 200, 201, 202, 203, 204,
-	205, 206, 329, 207, 208, 209, 210, 0, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 0,
-	222, 223, 0, 224, 225, 226, 330, 227, 228, 229,
-	230, 231, 232, 233, 234, 0, 235, 236, 237, 238,
-	239, 0, 240, 241, 331, 242, 243, 0, 244, 245,
-	332, 246, 0, 247, 248, 249, 250, 251, 252, 253,
-	254, 255, 256, 257, 333, 0, 258, 259, 0, 260,
-	0, 261, 262, 263, 264, 265, 0, 334, 335, 0,
-	0, 266, 267, 336, 268, 337, 0, 269, 270, 271,
-	272, 273, 274, 275, 0, 0, 276, 277, 278, 279,
-	280, 0, 0, 281, 282, 283, 284, 285, 338, 339,
-	0, 286, 0, 287, 288, 289, 290, 0, 0, 291,
-	0, 0, 292, 293, 294, 295, 296, 297, 340, 341,
-	342, 343, 344, 345, 346, 347, 348, 298, 299, 300,
-	0, 0, 0, 30, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1235, 33, 34,
-	35, 36, 37, 38, 39, 40, 0, 41, 42, 43,
-	0, 0, 0, 0, 0, 0, 0, 44, 45, 0,
-	46, 47, 0, 48, 49, 50, 301, 302, 0, 303,
-	304, 0, 51, 52, 53, 54, 55, 0, 0, 56,
-	57, 305, 306, 58, 0, 59, 60, 61, 62, 307,
-	0, 0, 0, 63, 64, 65, 66, 0, 67, 68,
-	69, 0, 70, 71, 72, 73, 74, 75, 0, 0,
-	76, 77, 78, 0, 0, 0, 0, 0, 0, 0,
-	79, 80, 81, 82, 83, 84, 308, 309, 85, 0,
-	86, 0, 87, 88, 89, 90, 91, 0, 92, 93,
-	94, 0, 0, 95, 96, 97, 98, 99, 0, 100,
-	101, 102, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 310, 110, 111, 112, 311, 0, 113, 0, 114,
-	115, 312, 116, 0, 117, 0, 118, 0, 0, 0,
-	119, 120, 121, 0, 122, 313, 0, 314, 123, 0,
-	124, 125, 126, 127, 128, 0, 129, 130, 131, 132,
-	0, 133, 134, 135, 136, 137, 138, 0, 139, 0,
-	315, 140, 141, 142, 143, 316, 317, 0, 318, 0,
-	144, 0, 0, 145, 0, 146, 147, 148, 149, 150,
-	0, 0, 151, 319, 0, 152, 0, 0, 153, 154,
-	155, 0, 0, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 320, 0,
-	321, 171, 172, 322, 0, 173, 174, 0, 175, 0,
-	323, 176, 324, 177, 178, 179, 0, 180, 0, 0,
-	181, 182, 183, 0, 0, 184, 325, 0, 185, 0,
-	326, 186, 187, 188, 189, 190, 191, 192, 0, 193,
-	194, 327, 195, 328, 198, 196, 197, 0, 199, 200,
-	201, 202, 203, 204, 205, 206, 329, 207, 208, 209,
-	210, 0, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 0, 222, 223, 0, 224, 225, 226,
-	330, 227, 228, 229, 230, 231, 232, 233, 234, 0,
-	235, 236, 237, 238, 239, 0, 240, 241, 331, 242,
-	243, 0, 244, 245, 332, 246, 0, 247, 248, 249,
-	250, 251, 252, 253, 254, 255, 256, 257, 333, 0,
-	258, 259, 0, 260, 0, 261, 262, 263, 264, 265,
-	0, 334, 335, 0, 0, 266, 267, 336, 268, 337,
-	0, 269, 270, 271, 272, 273, 274, 275, 0, 0,
-	276, 277, 278, 279, 280, 0, 0, 281, 282, 283,
-	284, 285, 338, 339, 0, 286, 0, 287, 288, 289,
-	290, 0, 0, 291, 0, 0, 292, 293, 294, 295,
-	296, 297, 340, 341, 342, 343, 344, 345, 346, 347,
-	348, 298, 299, 300, 0, 0, 0, 30, 0, 0,
+	602, 0, 0, 0, 0, 605, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 502, 33, 34, 35, 36, 37, 38, 39, 40,
-	0, 41, 42, 43, 0, 0, 0, 0, 0, 0,
-	0, 44, 45, 0, 46, 47, 0, 48, 49, 50,
-	301, 302, 0, 303, 304, 0, 51, 52, 53, 54,
-	55, 0, 0, 56, 57, 305, 306, 58, 0, 59,
-	60, 61, 62, 307, 0, 0, 0, 63, 64, 65,
-	66, 0, 67, 68, 69, 0, 70, 71, 72, 73,
-	74, 75, 0, 0, 76, 77, 78, 0, 0, 0,
-	0, 0, 0, 0, 79, 80, 81, 82, 83, 84,
-	308, 309, 85, 0, 86, 0, 87, 88, 89, 90,
-	91, 0, 92, 93, 94, 0, 0, 95, 96, 97,
-	98, 99, 0, 100, 101, 102, 0, 103, 104, 105,
-	0, 106, 107, 108, 109, 310, 110, 111, 112, 311,
-	0, 113, 0, 114, 115, 312, 116, 0, 117, 0,
-	118, 0, 0, 0, 119, 120, 777, 0, 122, 313,
-	0, 314, 123, 0, 124, 125, 126, 127, 128, 0,
-	129, 130, 131, 132, 0, 133, 134, 135, 136, 137,
-	138, 0, 139, 0, 315, 140, 141, 142, 143, 316,
-	317, 0, 318, 0, 144, 0, 0, 145, 0, 146,
-	147, 148, 149, 150, 0, 0, 151, 319, 0, 152,
-	0, 0, 153, 154, 776, 0, 0, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 320, 0, 321, 171, 172, 322, 0, 173,
-	174, 0, 175, 0, 323, 176, 324, 177, 178, 179,
-	0, 180, 0, 0, 181, 182, 183, 0, 0, 184,
-	325, 0, 185, 0, 326, 186, 187, 188, 189, 190,
-	191, 192, 0, 193, 194, 327, 195, 328, 198, 196,
-	197, 0, 199, 200, 201, 202, 203, 204, 205, 206,
-	329, 207, 208, 209, 210, 0, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 0, 222, 223,
-	0, 224, 225, 226, 330, 227, 228, 229, 230, 231,
-	232, 233, 234, 0, 235, 236, 237, 238, 239, 0,
-	240, 241, 331, 242, 243, 0, 244, 245, 332, 246,
-	0, 247, 248, 249, 250, 251, 252, 253, 254, 255,
-	256, 257, 333, 0, 258, 259, 779, 260, 0, 261,
-	775, 263, 774, 265, 0, 334, 335, 0, 0, 266,
-	267, 336, 268, 337, 0, 269, 270, 271, 272, 273,
-	274, 275, 0, 0, 276, 277, 778, 279, 280, 0,
-	0, 281, 282, 283, 284, 285, 338, 339, 0, 286,
-	0, 287, 288, 289, 290, 0, 0, 291, 0, 0,
-	292, 293, 294, 295, 296, 297, 340, 341, 342, 343,
-	344, 345, 346, 347, 348, 298, 299, 300, 30, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 33, 34, 35, 36, 37, 38, 39,
-	40, 0, 41, 42, 43, 0, 0, 0, 0, 0,
-	0, 0, 44, 45, 0, 46, 47, 0, 48, 49,
-	50, 301, 302, 0, 303, 304, 0, 51, 52, 53,
-	54, 55, 0, 0, 56, 57, 305, 306, 58, 0,
-	59, 60, 61, 62, 307, 0, 0, 0, 63, 64,
-	65, 66, 0, 67, 68, 69, 0, 70, 71, 72,
-	73, 74, 75, 0, 0, 76, 77, 78, 0, 0,
-	0, 0, 0, 0, 0, 79, 80, 81, 82, 83,
-	84, 308, 309, 85, 0, 86, 0, 87, 88, 89,
-	90, 91, 0, 92, 93, 94, 0, 0, 95, 96,
-	97, 98, 99, 0, 100, 101, 102, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 310, 110, 111, 112,
-	311, 0, 113, 0, 114, 115, 312, 116, 0, 117,
-	0, 118, 0, 0, 0, 119, 120, 121, 0, 122,
-	313, 0, 314, 123, 0, 124, 125, 126, 127, 128,
-	0, 129, 130, 131, 132, 0, 133, 134, 135, 136,
-	137, 138, 0, 139, 0, 315, 140, 141, 142, 143,
-	316, 317, 0, 318, 0, 144, 0, 0, 145, 0,
-	146, 147, 148, 149, 150, 0, 0, 151, 319, 0,
-	152, 0, 0, 153, 154, 155, 0, 0, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 320, 0, 321, 171, 172, 322, 0,
-	173, 174, 0, 175, 0, 323, 176, 324, 177, 178,
-	179, 0, 180, 0, 28, 181, 182, 183, 0, 0,
-	184, 325, 0, 185, 0, 326, 186, 187, 188, 189,
-	190, 191, 192, 0, 193, 194, 327, 195, 328, 198,
-	196, 197, 0, 199, 200, 201, 202, 203, 204, 205,
-	206, 329, 207, 208, 209, 210, 0, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 0, 222,
-	223, 0, 224, 225, 226, 330, 227, 228, 229, 230,
-	231, 232, 233, 234, 0, 235, 236, 237, 238, 239,
-	0, 240, 241, 331, 242, 243, 0, 244, 245, 332,
-	246, 0, 247, 248, 249, 250, 251, 252, 253, 254,
-	255, 256, 257, 333, 0, 258, 259, 0, 260, 0,
-	261, 262, 263, 264, 265, 0, 334, 335, 0, 0,
-	266, 267, 336, 268, 337, 0, 269, 270, 271, 272,
-	273, 274, 275, 0, 0, 276, 277, 278, 279, 280,
-	0, 0, 281, 282, 283, 284, 285, 338, 339, 0,
-	286, 0, 287, 288, 289, 290, 0, 0, 291, 0,
-	0, 292, 293, 294, 295, 296, 297, 340, 341, 342,
-	343, 344, 345, 346, 347, 348, 298, 299, 300, 30,
+	0, 608, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 615, 0, 0, 601, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 605, 603, 0,
+	0, 0, 0, 593, 594, 595, 0, 587, 588, 589,
+	590, 591, 592, 0, 0, 0, 0, 1530, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 33, 34, 35, 36, 37, 38,
-	39, 40, 0, 41, 42, 43, 0, 0, 0, 0,
-	0, 0, 0, 44, 45, 0, 46, 47, 0, 48,
-	49, 50, 301, 302, 0, 303, 304, 0, 51, 52,
-	53, 54, 55, 0, 0, 56, 57, 305, 306, 58,
-	0, 59, 60, 61, 62, 307, 0, 0, 0, 63,
-	64, 65, 66, 0, 67, 68, 69, 0, 70, 71,
-	72, 73, 74, 75, 0, 0, 76, 77, 78, 0,
-	0, 0, 0, 0, 0, 0, 79, 80, 81, 82,
-	83, 84, 308, 309, 85, 0, 86, 0, 87, 88,
-	89, 90, 91, 0, 92, 93, 94, 0, 0, 95,
-	96, 97, 98, 99, 0, 100, 101, 102, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 310, 110, 111,
-	112, 311, 0, 113, 0, 114, 115, 312, 116, 0,
-	117, 0, 118, 0, 0, 0, 119, 120, 121, 0,
-	122, 313, 0, 314, 123, 0, 124, 125, 126, 127,
-	128, 0, 129, 130, 131, 132, 0, 133, 134, 135,
-	136, 137, 138, 0, 139, 0, 315, 140, 141, 142,
-	143, 316, 317, 0, 318, 0, 144, 0, 0, 145,
-	0, 146, 147, 148, 149, 150, 0, 0, 151, 319,
-	0, 152, 0, 0, 153, 154, 155, 0, 0, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 320, 0, 321, 171, 172, 322,
-	0, 173, 174, 0, 175, 0, 323, 176, 324, 177,
-	178, 179, 0, 180, 0, 0, 181, 182, 183, 0,
-	0, 184, 325, 0, 185, 0, 326, 186, 187, 188,
-	189, 190, 191, 192, 0, 193, 194, 327, 195, 328,
-	198, 196, 197, 0, 199, 200, 201, 202, 203, 204,
-	205, 206, 329, 207, 208, 209, 210, 0, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 0,
-	222, 223, 0, 224, 225, 226, 330, 227, 228, 229,
-	230, 231, 232, 233, 234, 0, 235, 236, 237, 238,
-	239, 0, 240, 241, 331, 242, 243, 0, 244, 245,
-	332, 246, 0, 247, 248, 249, 250, 251, 252, 253,
-	254, 255, 256, 257, 333, 0, 258, 259, 0, 260,
-	0, 261, 262, 263, 264, 265, 0, 334, 335, 0,
-	0, 266, 267, 336, 268, 337, 0, 269, 270, 271,
-	272, 273, 274, 275, 0, 0, 276, 277, 278, 279,
-	280, 0, 0, 281, 282, 283, 284, 285, 338, 339,
-	0, 286, 0, 287, 288, 289, 290, 0, 0, 291,
-	0, 0, 292, 293, 294, 295, 296, 297, 340, 341,
-	342, 343, 344, 345, 346, 347, 348, 298, 299, 300,
-	30, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 33, 34, 35, 36, 37,
-	38, 39, 40, 0, 41, 42, 43, 0, 0, 0,
-	0, 0, 0, 0, 44, 45, 0, 46, 47, 0,
-	48, 49, 50, 301, 302, 0, 303, 304, 0, 51,
-	52, 53, 54, 55, 0, 0, 56, 57, 305, 306,
-	58, 0, 59, 60, 61, 62, 307, 0, 0, 0,
-	63, 64, 65, 66, 0, 67, 68, 69, 0, 70,
-	71, 72, 73, 74, 75, 0, 0, 76, 77, 78,
-	0, 0, 0, 0, 0, 0, 0, 79, 80, 81,
-	82, 83, 84, 308, 309, 85, 0, 86, 0, 87,
-	88, 89, 90, 91, 0, 92, 93, 94, 0, 0,
-	95, 96, 97, 98, 99, 0, 100, 101, 102, 0,
-	103, 104, 105, 0, 106, 107, 108, 109, 310, 110,
-	111, 112, 311, 0, 113, 0, 114, 115, 312, 116,
-	0, 117, 0, 118, 0, 0, 0, 119, 120, 121,
-	0, 122, 313, 0, 314, 123, 0, 124, 125, 126,
-	127, 128, 0, 129, 130, 131, 132, 0, 133, 134,
-	135, 136, 137, 138, 0, 139, 0, 315, 140, 141,
-	142, 143, 316, 317, 0, 318, 0, 144, 0, 0,
-	145, 0, 146, 147, 148, 149, 150, 0, 0, 151,
-	319, 0, 152, 0, 0, 153, 154, 155, 0, 0,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 320, 0, 321, 171, 172,
-	322, 0, 173, 174, 0, 175, 0, 323, 176, 324,
-	177, 178, 179, 0, 180, 0, 0, 181, 182, 183,
-	0, 0, 184, 325, 0, 185, 0, 326, 186, 187,
-	188, 189, 0, 191, 192, 0, 193, 194, 327, 195,
-	328, 198, 196, 197, 0, 199, 200, 201, 202, 203,
-	204, 0, 206, 329, 207, 208, 209, 210, 0, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	0, 222, 223, 0, 224, 225, 226, 330, 0, 228,
-	229, 230, 231, 232, 233, 234, 0, 235, 236, 237,
-	238, 239, 0, 240, 241, 331, 242, 243, 0, 244,
-	245, 332, 246, 0, 247, 248, 249, 250, 251, 252,
-	253, 254, 255, 256, 257, 333, 0, 258, 259, 0,
-	260, 0, 261, 262, 263, 264, 265, 0, 334, 335,
-	0, 0, 266, 267, 336, 268, 337, 0, 269, 270,
-	271, 272, 273, 274, 275, 0, 0, 276, 277, 278,
-	279, 280, 0, 0, 281, 282, 283, 284, 285, 338,
-	339, 0, 286, 0, 287, 288, 289, 290, 0, 0,
-	291, 0, 0, 292, 293, 294, 295, 296, 297, 340,
-	341, 342, 343, 344, 345, 346, 347, 348, 298, 299,
-	300, 809, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 33, 34, 35, 36,
-	37, 38, 39, 40, 0, 41, 42, 43, 0, 0,
-	0, 0, 0, 0, 0, 44, 45, 0, 46, 47,
-	475, 48, 49, 50, 0, 795, 476, 811, 801, 0,
-	51, 52, 53, 54, 55, 0, 0, 56, 57, 813,
-	812, 58, 0, 59, 60, 61, 62, 0, 0, 667,
-	0, 63, 64, 65, 66, 477, 67, 68, 69, 0,
-	70, 71, 72, 73, 74, 75, 0, 478, 76, 77,
-	78, 0, 0, 0, 668, 0, 0, 0, 79, 80,
-	81, 82, 83, 84, 799, 798, 85, 0, 86, 0,
-	87, 88, 89, 90, 91, 0, 92, 93, 94, 0,
-	0, 95, 96, 451, 98, 99, 0, 100, 101, 102,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 0,
-	110, 111, 112, 0, 0, 113, 0, 114, 115, 797,
-	116, 0, 117, 0, 118, 479, 0, 480, 119, 120,
-	121, 0, 122, 0, 0, 0, 123, 0, 124, 125,
-	126, 127, 128, 481, 129, 130, 131, 132, 0, 133,
-	134, 135, 136, 137, 138, 0, 139, 482, 0, 140,
-	141, 142, 143, 792, 793, 0, 808, 0, 144, 483,
-	484, 145, 485, 146, 147, 148, 149, 150, 0, 0,
-	151, 0, 486, 152, 487, 0, 153, 154, 155, 0,
-	0, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 815, 488, 816, 171,
-	172, 0, 0, 173, 174, 489, 175, 0, 0, 176,
-	800, 177, 178, 179, 0, 180, 0, 0, 181, 182,
-	183, 0, 0, 184, 0, 490, 185, 491, 0, 186,
-	187, 188, 189, 190, 191, 192, 0, 193, 194, 0,
-	195, 0, 198, 196, 197, 0, 199, 200, 201, 202,
-	203, 204, 205, 206, 796, 207, 208, 209, 210, 0,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 0, 222, 223, 492, 224, 225, 226, 0, 227,
-	228, 229, 230, 231, 232, 233, 234, 0, 235, 236,
-	237, 238, 239, 0, 240, 241, 784, 242, 243, 493,
-	244, 245, 794, 246, 0, 247, 248, 249, 250, 251,
-	252, 253, 254, 255, 256, 257, 0, 0, 258, 259,
-	0, 260, 494, 261, 262, 263, 264, 265, 0, 807,
-	806, 0, 0, 266, 267, 0, 268, 0, 0, 269,
-	270, 271, 272, 273, 274, 275, 0, 0, 276, 277,
-	278, 279, 280, 0, 0, 281, 282, 283, 284, 285,
-	0, 814, 0, 286, 495, 287, 288, 289, 290, 0,
-	0, 291, 0, 0, 292, 293, 294, 295, 296, 297,
-	809, 0, 0, 0, 0, 0, 0, 0, 0, 298,
-	299, 300, 0, 0, 0, 33, 34, 35, 36, 37,
-	38, 39, 40, 0, 41, 42, 43, 0, 0, 0,
-	0, 0, 0, 0, 44, 45, 0, 46, 47, 475,
-	48, 49, 50, 0, 795, 476, 811, 801, 0, 51,
-	52, 53, 54, 55, 0, 0, 56, 57, 813, 812,
-	58, 0, 59, 60, 61, 62, 0, 0, 667, 0,
-	63, 64, 65, 66, 477, 67, 68, 69, 0, 70,
-	71, 72, 73, 74, 75, 0, 478, 76, 77, 78,
-	0, 0, 0, 668, 0, 0, 0, 79, 80, 81,
-	82, 83, 84, 799, 798, 85, 0, 86, 0, 87,
-	88, 89, 90, 91, 0, 92, 93, 94, 0, 0,
-	95, 96, 451, 98, 99, 0, 100, 101, 102, 0,
-	103, 104, 105, 0, 106, 107, 108, 109, 0, 110,
-	111, 112, 0, 0, 113, 0, 114, 115, 797, 116,
-	0, 117, 0, 118, 479, 0, 480, 119, 120, 121,
-	0, 122, 0, 0, 0, 123, 0, 124, 125, 126,
-	127, 128, 481, 129, 130, 131, 132, 0, 133, 134,
-	135, 136, 137, 138, 0, 139, 482, 0, 140, 141,
-	142, 143, 792, 793, 0, 808, 0, 144, 483, 484,
-	145, 485, 146, 147, 148, 149, 150, 0, 0, 151,
-	0, 486, 152, 487, 0, 153, 154, 155, 0, 0,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 815, 488, 816, 171, 172,
-	0, 0, 173, 174, 489, 175, 0, 0, 176, 800,
-	177, 178, 179, 0, 180, 0, 0, 181, 182, 183,
-	0, 0, 184, 0, 490, 185, 491, 0, 186, 187,
-	188, 189, 190, 191, 192, 0, 193, 194, 0, 195,
-	0, 198, 196, 197, 0, 199, 200, 201, 202, 203,
-	204, 205, 206, 796, 207, 208, 209, 210, 0, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	0, 222, 223, 492, 224, 225, 226, 0, 227, 228,
-	229, 230, 231, 232, 233, 234, 0, 235, 236, 237,
-	238, 239, 0, 240, 241, 0, 242, 243, 493, 244,
-	245, 794, 246, 0, 247, 248, 249, 250, 251, 252,
-	253, 254, 255, 256, 257, 0, 0, 258, 259, 0,
-	260, 494, 261, 262, 263, 264, 265, 0, 807, 806,
-	0, 0, 266, 267, 0, 268, 0, 0, 269, 270,
-	271, 272, 273, 274, 275, 0, 0, 276, 277, 278,
-	279, 280, 0, 0, 281, 282, 283, 284, 285, 0,
-	814, 0, 286, 495, 287, 288, 289, 290, 0, 0,
-	291, 0, 0, 292, 293, 294, 295, 296, 297, 0,
-	0, 0, 0, 0, 577, 0, 0, 547, 298, 299,
-	300, 559, 560, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 577, 0, 0, 547, 0, 0, 563, 559,
-	560, 561, 0, 0, 0, 0, 549, 0, 0, 0,
-	0, 577, 572, 0, 547, 0, 563, 0, 559, 560,
-	561, 0, 0, 0, 549, 0, 0, 0, 0, 0,
-	572, 577, 0, 0, 547, 563, 548, 0, 559, 560,
-	561, 0, 0, 549, 0, 0, 0, 0, 0, 572,
-	0, 0, 0, 0, 548, 563, 0, 0, 0, 0,
-	0, 0, 0, 549, 0, 0, 0, 0, 0, 572,
-	0, 0, 0, 548, 577, 0, 0, 547, 0, 0,
-	0, 559, 560, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 548, 0, 0, 0, 0, 563, 0,
-	0, 0, 0, 0, 0, 0, 549, 577, 0, 0,
-	547, 0, 572, 0, 559, 560, 561, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 603, 0,
+	0, 0, 0, 593, 594, 595, 0, 587, 588, 589,
+	590, 591, 592, 0, 0, 0, 0, 1471, 0, 0,
+	0, 0, 0, 0, 0, 605, 0, 0, 0, 0,
+	0, 0, 0, 1069, 1069, 0, 0, 1069, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	603, 0, 0, 0, 0, 593, 594, 595, 0, 587,
+	588, 589, 590, 591, 592, 0, 0, 0, 0, 1446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 603, 0,
+	0, 700, 0, 593, 594, 595, 0, 587, 588, 589,
+	590, 591, 592, 0, 0, 1393, 1069, 46, 47, 48,
+	49, 50, 51, 52, 53, 707, 54, 55, 56, 708,
+	709, 710, 711, 712, 713, 714, 57, 58, 715, 59,
+	60, 502, 61, 62, 63, 316, 317, 503, 318, 319,
+	716, 64, 65, 66, 67, 68, 69, 717, 718, 70,
+	71, 320, 321, 72, 719, 73, 74, 75, 76, 322,
+	720, 705, 721, 77, 78, 79, 80, 504, 81, 82,
+	83, 722, 84, 85, 86, 87, 88, 89, 723, 505,
+	90, 91, 92, 724, 725, 726, 706, 727, 728, 729,
+	93, 94, 95, 96, 97, 98, 323, 324, 99, 730,
+	100, 731, 101, 102, 103, 104, 105, 106, 732, 107,
+	108, 109, 733, 734, 110, 111, 112, 113, 0, 114,
+	735, 115, 116, 117, 736, 118, 119, 120, 737, 121,
+	122, 123, 124, 325, 125, 126, 127, 326, 738, 128,
+	739, 129, 130, 327, 131, 740, 132, 741, 133, 506,
+	742, 507, 134, 135, 136, 743, 137, 328, 744, 329,
+	138, 745, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 746, 148, 149, 150, 151, 152, 153, 747,
+	154, 509, 330, 155, 156, 157, 158, 331, 332, 748,
+	333, 749, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 750, 751, 166, 334, 513, 167, 514, 752,
+	168, 169, 170, 753, 754, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	335, 515, 336, 186, 187, 337, 755, 188, 189, 516,
+	190, 756, 338, 191, 339, 192, 193, 194, 757, 195,
+	758, 759, 196, 197, 198, 760, 761, 199, 340, 517,
+	200, 518, 341, 201, 202, 203, 204, 205, 206, 207,
+	762, 208, 209, 342, 210, 343, 213, 211, 212, 763,
+	214, 215, 216, 217, 218, 219, 220, 221, 344, 222,
+	223, 224, 225, 764, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 765, 237, 238, 519, 239,
+	240, 241, 345, 242, 243, 244, 245, 246, 247, 248,
+	249, 766, 250, 251, 252, 253, 254, 767, 255, 256,
+	346, 257, 258, 520, 259, 260, 347, 261, 768, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	348, 769, 273, 274, 770, 275, 521, 276, 277, 278,
+	279, 280, 771, 349, 350, 772, 773, 281, 282, 351,
+	283, 352, 774, 284, 285, 286, 287, 288, 289, 290,
+	775, 776, 291, 292, 293, 294, 295, 777, 778, 296,
+	297, 298, 299, 300, 353, 354, 779, 301, 522, 302,
+	303, 304, 305, 780, 781, 306, 782, 783, 307, 308,
+	309, 310, 311, 312, 355, 356, 357, 358, 359, 360,
+	361, 362, 363, 313, 314, 315, 700, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 698, 0, 0,
+	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
+	707, 54, 55, 56, 708, 709, 710, 711, 712, 713,
+	714, 57, 58, 715, 59, 60, 502, 61, 62, 63,
+	316, 317, 503, 318, 319, 716, 64, 65, 66, 67,
+	68, 69, 717, 718, 70, 71, 320, 321, 72, 719,
+	73, 74, 75, 76, 322, 720, 705, 721, 77, 78,
+	79, 80, 504, 81, 82, 83, 722, 84, 85, 86,
+	87, 88, 89, 723, 505, 90, 91, 92, 724, 725,
+	726, 706, 727, 728, 729, 93, 94, 95, 96, 97,
+	98, 323, 324, 99, 730, 100, 731, 101, 102, 103,
+	104, 105, 106, 732, 107, 108, 109, 733, 734, 110,
+	111, 112, 113, 0, 114, 735, 115, 116, 117, 736,
+	118, 119, 120, 737, 121, 122, 123, 124, 325, 125,
+	126, 127, 326, 738, 128, 739, 129, 130, 327, 131,
+	740, 132, 741, 133, 506, 742, 507, 134, 135, 136,
+	743, 137, 328, 744, 329, 138, 745, 139, 140, 141,
+	142, 143, 508, 144, 145, 146, 147, 746, 148, 149,
+	150, 151, 152, 153, 747, 154, 509, 330, 155, 156,
+	157, 158, 331, 332, 748, 333, 749, 159, 510, 511,
+	160, 512, 161, 162, 163, 164, 165, 750, 751, 166,
+	334, 513, 167, 514, 752, 168, 169, 170, 753, 754,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 335, 515, 336, 186, 187,
+	337, 755, 188, 189, 516, 190, 756, 338, 191, 339,
+	192, 193, 194, 757, 195, 758, 759, 196, 197, 198,
+	760, 761, 199, 340, 517, 200, 518, 341, 201, 202,
+	203, 204, 205, 206, 207, 762, 208, 209, 342, 210,
+	343, 213, 211, 212, 763, 214, 215, 216, 217, 218,
+	219, 220, 221, 344, 222, 223, 224, 225, 764, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	765, 237, 238, 519, 239, 240, 241, 345, 242, 243,
+	244, 245, 246, 247, 248, 249, 766, 250, 251, 252,
+	253, 254, 767, 255, 256, 346, 257, 258, 520, 259,
+	260, 347, 261, 768, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 348, 769, 273, 274, 770,
+	275, 521, 276, 277, 278, 279, 280, 771, 349, 350,
+	772, 773, 281, 282, 351, 283, 352, 774, 284, 285,
+	286, 287, 288, 289, 290, 775, 776, 291, 292, 293,
+	294, 295, 777, 778, 296, 297, 298, 299, 300, 353,
+	354, 779, 301, 522, 302, 303, 304, 305, 780, 781,
+	306, 782, 783, 307, 308, 309, 310, 311, 312, 355,
+	356, 357, 358, 359, 360, 361, 362, 363, 313, 314,
+	315, 437, 424, 440, 426, 427, 419, 439, 409, 410,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 947, 54, 55, 56, 0,
+	0, 0, 0, 416, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 316, 479, 503, 480, 481,
+	0, 64, 65, 66, 67, 68, 69, 434, 459, 70,
+	71, 482, 483, 72, 0, 73, 74, 75, 76, 467,
+	0, 447, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 92, 457, 448, 453, 458, 449, 450, 454,
+	93, 94, 95, 96, 97, 98, 484, 485, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 948, 0, 110, 111, 478, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 415, 125, 126, 127, 460, 432, 128,
+	0, 129, 130, 486, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 468, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 330, 155, 156, 157, 158, 487, 488, 0,
+	446, 0, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 0, 0, 166, 469, 513, 167, 514, 0,
+	168, 169, 170, 451, 452, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	489, 515, 490, 186, 187, 337, 404, 188, 189, 516,
+	190, 433, 466, 191, 491, 192, 193, 194, 0, 195,
+	0, 0, 420, 197, 198, 0, 0, 199, 340, 517,
+	200, 518, 461, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 462, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 492, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 421, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 455, 255, 256,
+	346, 257, 258, 520, 259, 260, 493, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	463, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 494, 495, 0, 0, 281, 282, 464,
+	283, 465, 431, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 456, 0, 296,
+	297, 298, 299, 300, 353, 496, 946, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 470, 471, 472, 473, 474,
+	475, 476, 477, 313, 314, 315, 405, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 949, 0, 0,
+	0, 0, 0, 0, 411, 944, 437, 424, 440, 426,
+	427, 419, 439, 409, 410, 0, 0, 0, 0, 0,
+	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
+	0, 54, 55, 56, 0, 0, 0, 0, 416, 0,
+	0, 57, 58, 0, 59, 60, 502, 61, 62, 63,
+	316, 479, 503, 480, 481, 0, 64, 65, 66, 67,
+	68, 69, 434, 459, 70, 71, 482, 483, 72, 0,
+	73, 74, 75, 76, 467, 0, 447, 0, 77, 78,
+	79, 80, 504, 81, 82, 83, 0, 84, 85, 86,
+	87, 88, 89, 0, 505, 90, 91, 92, 457, 448,
+	453, 458, 449, 450, 454, 93, 94, 95, 96, 97,
+	98, 484, 485, 99, 0, 100, 0, 101, 102, 103,
+	104, 105, 106, 0, 107, 108, 109, 0, 0, 110,
+	111, 478, 113, 0, 114, 0, 115, 116, 117, 0,
+	118, 119, 120, 0, 121, 122, 123, 124, 415, 125,
+	126, 127, 460, 432, 128, 0, 129, 130, 486, 131,
+	0, 132, 0, 133, 506, 0, 507, 134, 135, 136,
+	0, 137, 468, 0, 329, 138, 0, 139, 140, 141,
+	142, 143, 508, 144, 145, 146, 147, 0, 148, 149,
+	150, 151, 152, 153, 0, 154, 509, 330, 155, 156,
+	157, 158, 487, 488, 0, 446, 0, 159, 510, 511,
+	160, 512, 161, 162, 163, 164, 165, 0, 0, 166,
+	469, 513, 167, 514, 0, 168, 169, 170, 451, 452,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 489, 515, 490, 186, 187,
+	337, 404, 188, 189, 516, 190, 433, 466, 191, 491,
+	192, 193, 194, 0, 195, 0, 0, 420, 197, 198,
+	0, 0, 199, 340, 517, 200, 518, 461, 201, 202,
+	203, 204, 205, 206, 207, 0, 208, 209, 462, 210,
+	343, 213, 211, 212, 0, 214, 215, 216, 217, 218,
+	219, 220, 221, 492, 222, 223, 224, 225, 0, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	0, 237, 238, 519, 239, 240, 241, 421, 242, 243,
+	244, 245, 246, 247, 248, 249, 14, 250, 251, 252,
+	253, 254, 455, 255, 256, 346, 257, 258, 520, 259,
+	260, 493, 261, 0, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 463, 0, 273, 274, 16,
+	275, 521, 276, 277, 278, 279, 280, 0, 494, 495,
+	0, 0, 281, 282, 464, 283, 465, 431, 284, 285,
+	286, 287, 288, 289, 290, 0, 0, 291, 292, 293,
+	294, 295, 456, 0, 296, 297, 298, 299, 300, 628,
+	496, 0, 301, 522, 302, 303, 304, 305, 0, 0,
+	306, 0, 17, 307, 308, 309, 310, 311, 312, 355,
+	470, 471, 472, 473, 474, 475, 476, 477, 313, 314,
+	315, 405, 0, 18, 0, 0, 0, 0, 0, 0,
+	401, 402, 0, 0, 0, 0, 0, 0, 0, 411,
+	437, 424, 440, 426, 427, 419, 439, 409, 410, 0,
+	0, 0, 0, 0, 0, 0, 46, 47, 48, 49,
+	50, 51, 52, 53, 0, 54, 55, 56, 0, 0,
+	0, 0, 416, 0, 0, 57, 58, 0, 59, 60,
+	502, 61, 62, 63, 316, 479, 503, 480, 481, 999,
+	64, 65, 66, 67, 68, 69, 434, 459, 70, 71,
+	482, 483, 72, 0, 73, 74, 75, 76, 467, 0,
+	447, 0, 77, 78, 79, 80, 504, 81, 82, 83,
+	0, 84, 85, 86, 87, 88, 89, 0, 505, 90,
+	91, 92, 457, 448, 453, 458, 449, 450, 454, 93,
+	94, 95, 96, 97, 98, 484, 485, 99, 0, 100,
+	0, 101, 102, 103, 104, 105, 106, 0, 107, 108,
+	109, 0, 0, 110, 111, 478, 113, 0, 114, 0,
+	115, 116, 117, 0, 118, 119, 120, 0, 121, 122,
+	123, 124, 415, 125, 126, 127, 460, 432, 128, 0,
+	129, 130, 486, 131, 0, 132, 0, 133, 506, 1004,
+	507, 134, 135, 136, 0, 137, 468, 0, 329, 138,
+	0, 139, 140, 141, 142, 143, 508, 144, 145, 146,
+	147, 0, 148, 149, 150, 151, 152, 153, 0, 154,
+	509, 330, 155, 156, 157, 158, 487, 488, 0, 446,
+	0, 159, 510, 511, 160, 512, 161, 162, 163, 164,
+	165, 0, 1000, 166, 469, 513, 167, 514, 0, 168,
+	169, 170, 451, 452, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 489,
+	515, 490, 186, 187, 337, 404, 188, 189, 516, 190,
+	433, 466, 191, 491, 192, 193, 194, 0, 195, 0,
+	0, 420, 197, 198, 0, 0, 199, 340, 517, 200,
+	518, 461, 201, 202, 203, 204, 205, 206, 207, 0,
+	208, 209, 462, 210, 343, 213, 211, 212, 0, 214,
+	215, 216, 217, 218, 219, 220, 221, 492, 222, 223,
+	224, 225, 0, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 0, 237, 238, 519, 239, 240,
+	241, 421, 242, 243, 244, 245, 246, 247, 248, 249,
+	0, 250, 251, 252, 253, 254, 455, 255, 256, 346,
+	257, 258, 520, 259, 260, 493, 261, 0, 262, 263,
+	264, 265, 266, 267, 268, 269, 270, 271, 272, 463,
+	0, 273, 274, 0, 275, 521, 276, 277, 278, 279,
+	280, 0, 494, 495, 0, 1001, 281, 282, 464, 283,
+	465, 431, 284, 285, 286, 287, 288, 289, 290, 0,
+	0, 291, 292, 293, 294, 295, 456, 0, 296, 297,
+	298, 299, 300, 353, 496, 0, 301, 522, 302, 303,
+	304, 305, 0, 0, 306, 0, 0, 307, 308, 309,
+	310, 311, 312, 355, 470, 471, 472, 473, 474, 475,
+	476, 477, 313, 314, 315, 405, 0, 0, 0, 0,
+	0, 0, 0, 0, 401, 402, 0, 0, 0, 0,
+	0, 0, 0, 411, 437, 424, 440, 426, 427, 419,
+	439, 409, 410, 0, 0, 0, 0, 0, 0, 0,
+	46, 47, 48, 49, 50, 51, 52, 53, 0, 54,
+	55, 56, 0, 0, 0, 0, 416, 0, 0, 57,
+	58, 0, 59, 60, 502, 61, 62, 63, 316, 479,
+	503, 480, 481, 0, 64, 65, 66, 67, 68, 69,
+	434, 459, 70, 71, 482, 483, 72, 0, 73, 74,
+	75, 76, 467, 0, 447, 0, 77, 78, 79, 80,
+	504, 81, 82, 83, 0, 84, 85, 86, 87, 88,
+	89, 0, 505, 90, 91, 92, 457, 448, 453, 458,
+	449, 450, 454, 93, 94, 95, 96, 97, 98, 484,
+	485, 99, 0, 100, 0, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 110, 111, 478,
+	113, 0, 114, 0, 115, 116, 117, 0, 118, 119,
+	120, 0, 121, 122, 123, 124, 415, 125, 126, 127,
+	460, 432, 128, 0, 129, 130, 486, 131, 0, 132,
+	0, 133, 506, 0, 507, 134, 135, 136, 0, 137,
+	468, 0, 329, 138, 0, 139, 140, 141, 142, 143,
+	508, 144, 145, 146, 147, 0, 148, 149, 150, 151,
+	152, 153, 0, 154, 509, 330, 155, 156, 157, 158,
+	487, 488, 0, 446, 0, 159, 510, 511, 160, 512,
+	161, 162, 163, 164, 165, 0, 0, 166, 469, 513,
+	167, 514, 0, 168, 169, 170, 451, 452, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 489, 515, 490, 186, 187, 337, 404,
+	188, 189, 516, 190, 433, 466, 191, 491, 192, 193,
+	194, 0, 195, 0, 0, 420, 197, 198, 0, 0,
+	199, 340, 517, 200, 518, 461, 201, 202, 203, 204,
+	205, 206, 207, 0, 208, 209, 462, 210, 343, 213,
+	211, 212, 0, 214, 215, 216, 217, 218, 219, 220,
+	221, 492, 222, 223, 224, 225, 0, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 0, 237,
+	238, 519, 239, 240, 241, 421, 242, 243, 244, 245,
+	246, 247, 248, 249, 0, 250, 251, 252, 253, 254,
+	455, 255, 256, 346, 257, 258, 520, 259, 260, 493,
+	261, 0, 262, 263, 264, 265, 266, 267, 268, 269,
+	270, 271, 272, 463, 0, 273, 274, 0, 275, 521,
+	276, 277, 278, 279, 280, 0, 494, 495, 0, 0,
+	281, 282, 464, 283, 465, 431, 284, 285, 286, 287,
+	288, 289, 290, 0, 0, 291, 292, 293, 294, 295,
+	456, 0, 296, 297, 298, 299, 300, 353, 496, 0,
+	301, 522, 302, 303, 304, 305, 0, 0, 306, 0,
+	0, 307, 308, 309, 310, 311, 312, 355, 470, 471,
+	472, 473, 474, 475, 476, 477, 313, 314, 315, 405,
+	0, 0, 0, 0, 0, 0, 0, 0, 401, 402,
+	0, 0, 0, 0, 0, 0, 0, 411, 1362, 437,
+	424, 440, 426, 427, 419, 439, 409, 410, 0, 0,
+	0, 0, 0, 0, 0, 46, 47, 48, 49, 50,
+	51, 52, 53, 0, 54, 55, 56, 0, 0, 0,
+	0, 416, 0, 0, 57, 58, 0, 59, 60, 502,
+	61, 62, 63, 316, 479, 503, 480, 481, 0, 64,
+	65, 66, 67, 68, 69, 434, 459, 70, 71, 482,
+	483, 72, 0, 73, 74, 75, 76, 467, 0, 447,
+	0, 77, 78, 79, 80, 504, 81, 82, 83, 0,
+	84, 85, 86, 87, 88, 89, 0, 505, 90, 91,
+	92, 457, 448, 453, 458, 449, 450, 454, 93, 94,
+	95, 96, 97, 98, 484, 485, 99, 0, 100, 0,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 110, 111, 478, 113, 0, 114, 0, 115,
+	116, 117, 0, 118, 119, 120, 0, 121, 122, 123,
+	124, 415, 125, 126, 127, 460, 432, 128, 0, 129,
+	130, 486, 131, 0, 132, 0, 133, 506, 0, 507,
+	134, 135, 136, 0, 137, 468, 0, 329, 138, 0,
+	139, 140, 141, 142, 143, 508, 144, 145, 146, 147,
+	0, 148, 149, 150, 151, 152, 153, 0, 154, 509,
+	330, 155, 156, 157, 158, 487, 488, 0, 446, 0,
+	159, 510, 511, 160, 512, 161, 162, 163, 164, 165,
+	0, 0, 166, 469, 513, 167, 514, 0, 168, 169,
+	170, 451, 452, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 489, 515,
+	490, 186, 187, 337, 404, 188, 189, 516, 190, 433,
+	466, 191, 491, 192, 193, 194, 0, 195, 0, 0,
+	420, 197, 198, 0, 0, 199, 340, 517, 200, 518,
+	461, 201, 202, 203, 204, 205, 206, 207, 0, 208,
+	209, 462, 210, 343, 213, 211, 212, 0, 214, 215,
+	216, 217, 218, 219, 220, 221, 492, 222, 223, 224,
+	225, 0, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 0, 237, 238, 519, 239, 240, 241,
+	421, 242, 243, 244, 245, 246, 247, 248, 249, 0,
+	250, 251, 252, 253, 254, 455, 255, 256, 346, 257,
+	258, 520, 259, 260, 493, 261, 0, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 463, 0,
+	273, 274, 0, 275, 521, 276, 277, 278, 279, 280,
+	0, 494, 495, 0, 0, 281, 282, 464, 283, 465,
+	431, 284, 285, 286, 287, 288, 289, 290, 0, 0,
+	291, 292, 293, 294, 295, 456, 0, 296, 297, 298,
+	299, 300, 353, 496, 0, 301, 522, 302, 303, 304,
+	305, 0, 0, 306, 0, 0, 307, 308, 309, 310,
+	311, 312, 355, 470, 471, 472, 473, 474, 475, 476,
+	477, 313, 314, 315, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 0, 0, 0, 0, 0,
+	0, 0, 411, 1305, 437, 424, 440, 426, 427, 419,
+	439, 409, 410, 0, 0, 0, 0, 0, 0, 0,
+	46, 47, 48, 49, 50, 51, 52, 53, 0, 54,
+	55, 56, 0, 0, 0, 0, 416, 0, 0, 57,
+	58, 0, 59, 60, 502, 61, 62, 63, 316, 479,
+	503, 480, 481, 0, 64, 65, 66, 67, 68, 69,
+	434, 459, 70, 71, 482, 483, 72, 0, 73, 74,
+	75, 76, 467, 0, 447, 0, 77, 78, 79, 80,
+	504, 81, 82, 83, 0, 84, 85, 86, 87, 88,
+	89, 0, 505, 90, 91, 92, 457, 448, 453, 458,
+	449, 450, 454, 93, 94, 95, 96, 97, 98, 484,
+	485, 99, 0, 100, 0, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 110, 111, 478,
+	113, 0, 114, 0, 115, 116, 117, 0, 118, 119,
+	120, 0, 121, 122, 123, 124, 415, 125, 126, 127,
+	460, 432, 128, 0, 129, 130, 486, 131, 0, 132,
+	0, 133, 506, 0, 507, 134, 135, 136, 0, 137,
+	468, 0, 329, 138, 0, 139, 140, 141, 142, 143,
+	508, 144, 145, 146, 147, 0, 148, 149, 150, 151,
+	152, 153, 0, 154, 509, 330, 155, 156, 157, 158,
+	487, 488, 0, 446, 0, 159, 510, 511, 160, 512,
+	161, 162, 163, 164, 165, 0, 0, 166, 469, 513,
+	167, 514, 0, 168, 169, 170, 451, 452, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 489, 515, 490, 186, 187, 337, 404,
+	188, 189, 516, 190, 433, 466, 191, 491, 192, 193,
+	194, 0, 195, 0, 0, 420, 197, 198, 0, 0,
+	199, 340, 517, 200, 518, 461, 201, 202, 203, 204,
+	205, 206, 207, 0, 208, 209, 462, 210, 343, 213,
+	211, 212, 0, 214, 215, 216, 217, 218, 219, 220,
+	221, 492, 222, 223, 224, 225, 0, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 0, 237,
+	238, 519, 239, 240, 241, 421, 242, 243, 244, 245,
+	246, 247, 248, 249, 0, 250, 251, 252, 253, 254,
+	455, 255, 256, 346, 257, 258, 520, 259, 260, 493,
+	261, 0, 262, 263, 264, 265, 266, 267, 268, 269,
+	270, 271, 272, 463, 0, 273, 274, 0, 275, 521,
+	276, 277, 278, 279, 280, 0, 494, 495, 0, 0,
+	281, 282, 464, 283, 465, 431, 284, 285, 286, 287,
+	288, 289, 290, 0, 0, 291, 292, 293, 294, 295,
+	456, 0, 296, 297, 298, 299, 300, 353, 496, 0,
+	301, 522, 302, 303, 304, 305, 0, 0, 306, 0,
+	0, 307, 308, 309, 310, 311, 312, 355, 470, 471,
+	472, 473, 474, 475, 476, 477, 313, 314, 315, 405,
+	0, 0, 0, 0, 0, 0, 0, 0, 401, 402,
+	0, 0, 0, 0, 0, 0, 0, 411, 943, 437,
+	424, 440, 426, 427, 419, 439, 409, 410, 0, 0,
+	0, 0, 0, 0, 0, 46, 47, 48, 49, 50,
+	51, 52, 53, 0, 54, 55, 56, 0, 0, 0,
+	0, 416, 0, 0, 57, 58, 0, 59, 60, 502,
+	61, 62, 63, 316, 479, 503, 480, 481, 0, 64,
+	65, 66, 67, 68, 69, 434, 459, 70, 71, 482,
+	483, 72, 0, 73, 74, 75, 76, 467, 0, 447,
+	0, 77, 78, 79, 80, 504, 81, 82, 83, 0,
+	84, 85, 86, 87, 88, 89, 0, 505, 90, 91,
+	92, 457, 448, 453, 458, 449, 450, 454, 93, 94,
+	95, 96, 97, 98, 484, 485, 99, 0, 100, 0,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 110, 111, 478, 113, 0, 114, 0, 115,
+	116, 117, 0, 118, 119, 120, 0, 121, 122, 123,
+	124, 415, 125, 126, 127, 460, 432, 128, 0, 129,
+	130, 486, 131, 0, 132, 0, 133, 506, 0, 507,
+	134, 135, 136, 0, 137, 468, 0, 329, 138, 0,
+	139, 140, 141, 142, 143, 508, 144, 145, 146, 147,
+	0, 148, 149, 150, 151, 152, 153, 0, 154, 509,
+	330, 155, 156, 157, 158, 487, 488, 0, 446, 0,
+	159, 510, 511, 160, 512, 161, 162, 163, 164, 165,
+	0, 0, 166, 469, 513, 167, 514, 0, 168, 169,
+	170, 451, 452, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 489, 515,
+	490, 186, 187, 337, 404, 188, 189, 516, 190, 433,
+	466, 191, 491, 192, 193, 194, 0, 195, 0, 0,
+	420, 197, 198, 0, 0, 199, 340, 517, 200, 518,
+	461, 201, 202, 203, 204, 205, 206, 207, 0, 208,
+	209, 462, 210, 343, 213, 211, 212, 0, 214, 215,
+	216, 217, 218, 219, 220, 221, 492, 222, 223, 224,
+	225, 0, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 0, 237, 238, 519, 239, 240, 241,
+	421, 242, 243, 244, 245, 246, 247, 248, 249, 0,
+	250, 251, 252, 253, 254, 455, 255, 256, 346, 257,
+	258, 520, 259, 260, 493, 261, 0, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 463, 0,
+	273, 274, 0, 275, 521, 276, 277, 278, 279, 280,
+	0, 494, 495, 0, 0, 281, 282, 464, 283, 465,
+	431, 284, 285, 286, 287, 288, 289, 290, 0, 0,
+	291, 292, 293, 294, 295, 456, 0, 296, 297, 298,
+	299, 300, 353, 496, 0, 301, 522, 302, 303, 304,
+	305, 0, 0, 306, 0, 0, 307, 308, 309, 310,
+	311, 312, 355, 470, 471, 472, 473, 474, 475, 476,
+	477, 313, 314, 315, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 0, 0, 0, 0, 0,
+	633, 923, 411, 437, 424, 440, 426, 427, 419, 439,
+	409, 410, 0, 0, 0, 0, 0, 0, 0, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 416, 0, 0, 57, 58,
+	0, 59, 60, 502, 61, 62, 63, 316, 479, 503,
+	480, 481, 0, 64, 65, 66, 67, 68, 69, 434,
+	459, 70, 71, 482, 483, 72, 0, 73, 74, 75,
+	76, 467, 0, 447, 0, 77, 78, 79, 80, 504,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 505, 90, 91, 92, 457, 448, 453, 458, 449,
+	450, 454, 93, 94, 95, 96, 97, 98, 484, 485,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 478, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 415, 125, 126, 127, 460,
+	432, 128, 0, 129, 130, 486, 131, 0, 132, 0,
+	133, 506, 0, 507, 134, 135, 136, 0, 137, 468,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 508,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 509, 330, 155, 156, 157, 158, 487,
+	488, 0, 446, 0, 159, 510, 511, 160, 512, 161,
+	162, 163, 164, 165, 0, 0, 166, 469, 513, 167,
+	514, 0, 168, 169, 170, 451, 452, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 489, 515, 490, 186, 187, 337, 404, 188,
+	189, 516, 190, 433, 466, 191, 491, 192, 193, 194,
+	0, 195, 0, 0, 420, 197, 198, 0, 0, 199,
+	340, 517, 200, 518, 461, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 462, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	492, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	519, 239, 240, 241, 421, 242, 243, 244, 245, 246,
+	247, 248, 249, 0, 250, 251, 252, 253, 254, 455,
+	255, 256, 346, 257, 258, 520, 259, 260, 493, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 463, 0, 273, 274, 0, 275, 521, 276,
+	277, 278, 279, 280, 0, 494, 495, 0, 0, 281,
+	282, 464, 283, 465, 431, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 456,
+	0, 296, 297, 298, 299, 300, 353, 496, 1312, 301,
+	522, 302, 303, 304, 305, 0, 0, 306, 0, 0,
+	307, 308, 309, 310, 311, 312, 355, 470, 471, 472,
+	473, 474, 475, 476, 477, 313, 314, 315, 405, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 0,
+	0, 0, 0, 0, 0, 0, 411, 437, 424, 440,
+	426, 427, 419, 439, 409, 410, 0, 0, 0, 0,
+	0, 0, 0, 46, 47, 48, 49, 50, 51, 52,
+	53, 0, 54, 55, 56, 0, 0, 0, 0, 416,
+	0, 0, 57, 58, 0, 59, 60, 502, 61, 62,
+	63, 316, 479, 503, 480, 481, 0, 64, 65, 66,
+	67, 68, 69, 434, 459, 70, 71, 482, 483, 72,
+	0, 73, 74, 75, 76, 467, 0, 447, 0, 77,
+	78, 79, 80, 504, 81, 82, 83, 0, 84, 85,
+	86, 87, 88, 89, 0, 505, 90, 91, 92, 457,
+	448, 453, 458, 449, 450, 454, 93, 94, 95, 96,
+	97, 98, 484, 485, 99, 0, 100, 0, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	110, 111, 478, 113, 0, 114, 0, 115, 116, 117,
+	0, 118, 119, 120, 0, 121, 122, 123, 124, 415,
+	125, 126, 127, 460, 432, 128, 0, 129, 130, 486,
+	131, 0, 132, 0, 133, 506, 1004, 507, 134, 135,
+	136, 0, 137, 468, 0, 329, 138, 0, 139, 140,
+	141, 142, 143, 508, 144, 145, 146, 147, 0, 148,
+	149, 150, 151, 152, 153, 0, 154, 509, 330, 155,
+	156, 157, 158, 487, 488, 0, 446, 0, 159, 510,
+	511, 160, 512, 161, 162, 163, 164, 165, 0, 0,
+	166, 469, 513, 167, 514, 0, 168, 169, 170, 451,
+	452, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 489, 515, 490, 186,
+	187, 337, 404, 188, 189, 516, 190, 433, 466, 191,
+	491, 192, 193, 194, 0, 195, 0, 0, 420, 197,
+	198, 0, 0, 199, 340, 517, 200, 518, 461, 201,
+	202, 203, 204, 205, 206, 207, 0, 208, 209, 462,
+	210, 343, 213, 211, 212, 0, 214, 215, 216, 217,
+	218, 219, 220, 221, 492, 222, 223, 224, 225, 0,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 0, 237, 238, 519, 239, 240, 241, 421, 242,
+	243, 244, 245, 246, 247, 248, 249, 0, 250, 251,
+	252, 253, 254, 455, 255, 256, 346, 257, 258, 520,
+	259, 260, 493, 261, 0, 262, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 463, 0, 273, 274,
+	0, 275, 521, 276, 277, 278, 279, 280, 0, 494,
+	495, 0, 0, 281, 282, 464, 283, 465, 431, 284,
+	285, 286, 287, 288, 289, 290, 0, 0, 291, 292,
+	293, 294, 295, 456, 0, 296, 297, 298, 299, 300,
+	353, 496, 0, 301, 522, 302, 303, 304, 305, 0,
+	0, 306, 0, 0, 307, 308, 309, 310, 311, 312,
+	355, 470, 471, 472, 473, 474, 475, 476, 477, 313,
+	314, 315, 405, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 0, 0, 0, 0, 0, 0, 0,
+	411, 437, 424, 440, 426, 427, 419, 439, 409, 410,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 416, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 316, 479, 503, 480, 481,
+	0, 64, 65, 66, 67, 68, 69, 434, 459, 70,
+	71, 482, 483, 72, 0, 73, 74, 75, 76, 467,
+	0, 447, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 92, 457, 448, 453, 458, 449, 450, 454,
+	93, 94, 95, 96, 97, 98, 484, 485, 99, 541,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 478, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 415, 125, 126, 127, 460, 432, 128,
+	0, 129, 130, 486, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 468, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 330, 155, 156, 157, 158, 487, 488, 0,
+	446, 0, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 0, 0, 166, 469, 513, 167, 514, 0,
+	168, 169, 170, 451, 452, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	489, 515, 490, 186, 187, 337, 404, 188, 189, 516,
+	190, 433, 466, 191, 491, 192, 193, 194, 0, 195,
+	0, 0, 420, 197, 198, 0, 0, 199, 340, 517,
+	200, 518, 461, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 462, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 492, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 421, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 455, 255, 256,
+	346, 257, 258, 520, 259, 260, 493, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	463, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 494, 495, 0, 0, 281, 282, 464,
+	283, 465, 431, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 456, 0, 296,
+	297, 298, 299, 300, 353, 496, 0, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 470, 471, 472, 473, 474,
+	475, 476, 477, 313, 314, 315, 405, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 0, 0, 0,
+	0, 0, 0, 0, 411, 437, 424, 440, 426, 427,
+	419, 439, 409, 410, 0, 0, 0, 0, 0, 0,
+	0, 46, 47, 48, 49, 50, 51, 52, 53, 0,
+	54, 55, 56, 0, 0, 0, 0, 416, 0, 0,
+	57, 58, 0, 59, 60, 502, 61, 62, 63, 316,
+	479, 503, 480, 481, 0, 64, 65, 66, 67, 68,
+	69, 434, 459, 70, 71, 482, 483, 72, 0, 73,
+	74, 75, 76, 467, 0, 447, 0, 77, 78, 79,
+	80, 504, 81, 82, 83, 0, 84, 85, 86, 87,
+	88, 89, 0, 505, 90, 91, 92, 457, 448, 453,
+	458, 449, 450, 454, 93, 94, 95, 96, 97, 98,
+	484, 485, 99, 0, 100, 0, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 110, 111,
+	478, 113, 0, 114, 0, 115, 116, 117, 0, 118,
+	119, 120, 0, 121, 122, 123, 124, 415, 125, 126,
+	127, 460, 432, 128, 0, 129, 130, 486, 131, 0,
+	132, 0, 133, 506, 0, 507, 134, 135, 136, 0,
+	137, 468, 0, 329, 138, 0, 139, 140, 141, 142,
+	143, 508, 144, 145, 146, 147, 0, 148, 149, 150,
+	151, 152, 153, 0, 154, 509, 330, 155, 156, 157,
+	158, 487, 488, 0, 446, 0, 159, 510, 511, 160,
+	512, 161, 162, 163, 164, 165, 0, 0, 166, 469,
+	513, 167, 514, 0, 168, 169, 170, 451, 452, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 489, 515, 490, 186, 187, 337,
+	404, 188, 189, 516, 190, 433, 466, 191, 491, 192,
+	193, 194, 0, 195, 0, 0, 420, 197, 198, 0,
+	0, 199, 340, 517, 200, 518, 461, 201, 202, 203,
+	204, 205, 206, 207, 0, 208, 209, 462, 210, 343,
+	213, 211, 212, 0, 214, 215, 216, 217, 218, 219,
+	220, 221, 492, 222, 223, 224, 225, 0, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 0,
+	237, 238, 519, 239, 240, 241, 421, 242, 243, 244,
+	245, 246, 247, 248, 249, 0, 250, 251, 252, 253,
+	254, 455, 255, 256, 346, 257, 258, 520, 259, 260,
+	493, 261, 0, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 463, 0, 273, 274, 0, 275,
+	521, 276, 277, 278, 279, 280, 0, 494, 495, 0,
+	0, 281, 282, 464, 283, 465, 431, 284, 285, 286,
+	287, 288, 289, 290, 0, 0, 291, 292, 293, 294,
+	295, 456, 0, 296, 297, 298, 299, 300, 353, 496,
+	0, 301, 522, 302, 303, 304, 305, 0, 0, 306,
+	0, 0, 307, 308, 309, 310, 311, 312, 355, 470,
+	471, 472, 473, 474, 475, 476, 477, 313, 314, 315,
+	405, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 399, 0, 0, 0, 0, 0, 0, 411, 437,
+	424, 440, 426, 427, 419, 439, 409, 410, 0, 0,
+	0, 0, 0, 0, 0, 46, 47, 48, 49, 50,
+	51, 52, 53, 563, 54, 55, 56, 0, 0, 0,
+	0, 416, 0, 0, 57, 58, 0, 59, 60, 502,
+	61, 62, 63, 316, 479, 503, 480, 481, 0, 64,
+	65, 66, 67, 68, 69, 434, 459, 70, 71, 482,
+	483, 72, 0, 73, 74, 75, 76, 467, 0, 447,
+	0, 77, 78, 79, 80, 504, 81, 82, 83, 0,
+	84, 85, 86, 87, 88, 89, 0, 505, 90, 91,
+	92, 457, 448, 453, 458, 449, 450, 454, 93, 94,
+	95, 96, 97, 98, 484, 485, 99, 0, 100, 0,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 110, 111, 478, 113, 0, 114, 0, 115,
+	116, 117, 0, 118, 119, 120, 0, 121, 122, 123,
+	124, 415, 125, 126, 127, 460, 432, 128, 0, 129,
+	130, 486, 131, 0, 132, 0, 133, 506, 0, 507,
+	134, 135, 136, 0, 137, 468, 0, 329, 138, 0,
+	139, 140, 141, 142, 143, 508, 144, 145, 146, 147,
+	0, 148, 149, 150, 151, 152, 153, 0, 154, 509,
+	330, 155, 156, 157, 158, 487, 488, 0, 446, 0,
+	159, 510, 511, 160, 512, 161, 162, 163, 164, 165,
+	0, 0, 166, 469, 513, 167, 514, 0, 168, 169,
+	170, 451, 452, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 489, 515,
+	490, 186, 187, 337, 404, 188, 189, 516, 190, 433,
+	466, 191, 491, 192, 193, 194, 0, 195, 0, 0,
+	420, 197, 198, 0, 0, 199, 340, 517, 200, 518,
+	461, 201, 202, 203, 204, 205, 206, 207, 0, 208,
+	209, 462, 210, 343, 213, 211, 212, 0, 214, 215,
+	216, 217, 218, 219, 220, 221, 492, 222, 223, 224,
+	225, 0, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 0, 237, 238, 519, 239, 240, 241,
+	421, 242, 243, 244, 245, 246, 247, 248, 249, 0,
+	250, 251, 252, 253, 254, 455, 255, 256, 346, 257,
+	258, 520, 259, 260, 493, 261, 0, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 463, 0,
+	273, 274, 0, 275, 521, 276, 277, 278, 279, 280,
+	0, 494, 495, 0, 0, 281, 282, 464, 283, 465,
+	431, 284, 285, 286, 287, 288, 289, 290, 0, 0,
+	291, 292, 293, 294, 295, 456, 0, 296, 297, 298,
+	299, 300, 353, 496, 0, 301, 522, 302, 303, 304,
+	305, 0, 0, 306, 0, 0, 307, 308, 309, 310,
+	311, 312, 355, 470, 471, 472, 473, 474, 475, 476,
+	477, 313, 314, 315, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 0, 0, 0, 0, 0,
+	0, 0, 411, 437, 424, 440, 426, 427, 419, 439,
+	409, 410, 0, 0, 0, 0, 0, 0, 0, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 416, 0, 0, 57, 58,
+	0, 59, 60, 502, 61, 62, 63, 316, 479, 503,
+	480, 481, 0, 64, 65, 66, 67, 68, 69, 434,
+	459, 70, 71, 482, 483, 72, 0, 73, 74, 75,
+	76, 467, 0, 447, 0, 77, 78, 79, 80, 504,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 505, 90, 91, 92, 457, 448, 453, 458, 449,
+	450, 454, 93, 94, 95, 96, 97, 98, 484, 485,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 478, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 415, 125, 126, 127, 460,
+	432, 128, 0, 129, 130, 486, 131, 0, 132, 0,
+	133, 506, 0, 507, 134, 135, 136, 0, 137, 468,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 508,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 509, 330, 155, 156, 157, 158, 487,
+	488, 0, 446, 0, 159, 510, 511, 160, 512, 161,
+	162, 163, 164, 165, 0, 0, 166, 469, 513, 167,
+	514, 0, 168, 169, 170, 451, 452, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 489, 515, 490, 186, 187, 337, 404, 188,
+	189, 516, 190, 433, 466, 191, 491, 192, 193, 194,
+	0, 195, 0, 0, 420, 197, 198, 0, 0, 199,
+	340, 517, 200, 518, 461, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 462, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	492, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	519, 239, 240, 241, 421, 242, 243, 244, 245, 246,
+	247, 248, 249, 0, 250, 251, 252, 253, 254, 455,
+	255, 256, 346, 257, 258, 520, 259, 260, 493, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 463, 0, 273, 274, 0, 275, 521, 276,
+	277, 278, 279, 280, 0, 494, 495, 0, 0, 281,
+	282, 464, 283, 465, 431, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 456,
+	0, 296, 297, 298, 299, 300, 353, 496, 0, 301,
+	522, 302, 303, 304, 305, 0, 0, 306, 0, 0,
+	307, 308, 309, 310, 311, 312, 355, 470, 471, 472,
+	473, 474, 475, 476, 477, 313, 314, 315, 405, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 0,
+	0, 0, 0, 0, 0, 0, 411, 437, 424, 440,
+	426, 427, 419, 439, 409, 410, 0, 0, 0, 0,
+	0, 0, 0, 46, 47, 48, 49, 50, 51, 52,
+	53, 0, 54, 55, 56, 0, 0, 0, 0, 416,
+	0, 0, 57, 58, 0, 59, 60, 502, 61, 62,
+	63, 316, 479, 503, 480, 481, 0, 64, 65, 66,
+	67, 68, 69, 434, 459, 70, 71, 482, 483, 72,
+	0, 73, 74, 75, 76, 467, 0, 447, 0, 77,
+	78, 79, 80, 504, 81, 82, 83, 0, 84, 85,
+	86, 87, 88, 89, 0, 505, 90, 91, 1636, 457,
+	448, 453, 458, 449, 450, 454, 93, 94, 95, 96,
+	97, 98, 484, 485, 99, 0, 100, 0, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	110, 111, 478, 113, 0, 114, 0, 115, 116, 117,
+	0, 118, 119, 120, 0, 121, 122, 123, 124, 415,
+	125, 126, 127, 460, 432, 128, 0, 129, 130, 486,
+	131, 0, 132, 0, 133, 506, 0, 507, 134, 135,
+	136, 0, 137, 468, 0, 329, 138, 0, 139, 140,
+	141, 142, 143, 508, 144, 145, 146, 147, 0, 148,
+	149, 150, 151, 152, 153, 0, 154, 509, 330, 155,
+	156, 157, 158, 487, 488, 0, 446, 0, 159, 510,
+	511, 160, 512, 161, 162, 163, 164, 165, 0, 0,
+	166, 469, 513, 167, 514, 0, 168, 169, 170, 451,
+	452, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 489, 515, 490, 186,
+	187, 337, 404, 188, 189, 516, 190, 433, 466, 191,
+	491, 192, 193, 194, 0, 195, 0, 0, 420, 197,
+	198, 0, 0, 199, 340, 517, 200, 518, 461, 201,
+	202, 203, 204, 205, 206, 207, 0, 208, 209, 462,
+	210, 343, 213, 211, 212, 0, 214, 215, 216, 217,
+	218, 219, 220, 221, 492, 222, 223, 224, 225, 0,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 0, 237, 238, 519, 239, 240, 241, 421, 242,
+	243, 244, 245, 246, 247, 248, 249, 0, 250, 251,
+	252, 253, 254, 455, 255, 256, 346, 257, 258, 520,
+	259, 260, 493, 261, 0, 262, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 463, 0, 273, 274,
+	0, 275, 521, 276, 277, 278, 279, 280, 0, 494,
+	495, 0, 0, 281, 282, 464, 283, 465, 431, 284,
+	285, 286, 287, 1635, 289, 290, 0, 0, 291, 292,
+	293, 294, 295, 456, 0, 296, 297, 298, 299, 300,
+	353, 496, 0, 301, 522, 302, 303, 304, 305, 0,
+	0, 306, 0, 0, 307, 308, 309, 310, 311, 312,
+	355, 470, 471, 472, 473, 474, 475, 476, 477, 313,
+	314, 315, 405, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 0, 0, 0, 0, 0, 0, 0,
+	411, 437, 424, 440, 426, 427, 419, 439, 409, 410,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 416, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 1634, 479, 503, 480, 481,
+	0, 64, 65, 66, 67, 68, 69, 434, 459, 70,
+	71, 482, 483, 72, 0, 73, 74, 75, 76, 467,
+	0, 447, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 1636, 457, 448, 453, 458, 449, 450, 454,
+	93, 94, 95, 96, 97, 98, 484, 485, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 478, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 415, 125, 126, 127, 460, 432, 128,
+	0, 129, 130, 486, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 468, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 330, 155, 156, 157, 158, 487, 488, 0,
+	446, 0, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 0, 0, 166, 469, 513, 167, 514, 0,
+	168, 169, 170, 451, 452, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	489, 515, 490, 186, 187, 337, 404, 188, 189, 516,
+	190, 433, 466, 191, 491, 192, 193, 194, 0, 195,
+	0, 0, 420, 197, 198, 0, 0, 199, 340, 517,
+	200, 518, 461, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 462, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 492, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 421, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 455, 255, 256,
+	346, 257, 258, 520, 259, 260, 493, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	463, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 494, 495, 0, 0, 281, 282, 464,
+	283, 465, 431, 284, 285, 286, 287, 1635, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 456, 0, 296,
+	297, 298, 299, 300, 353, 496, 0, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 470, 471, 472, 473, 474,
+	475, 476, 477, 313, 314, 315, 405, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 0, 0, 0,
+	0, 0, 0, 0, 411, 437, 424, 440, 426, 427,
+	419, 439, 409, 410, 0, 0, 0, 0, 0, 0,
+	0, 46, 47, 48, 49, 50, 51, 52, 53, 0,
+	54, 55, 56, 0, 0, 0, 0, 416, 0, 0,
+	57, 58, 0, 59, 60, 502, 61, 62, 63, 316,
+	479, 503, 480, 481, 0, 64, 65, 66, 67, 68,
+	69, 434, 459, 70, 71, 482, 483, 72, 0, 73,
+	74, 75, 76, 467, 0, 447, 0, 77, 78, 79,
+	80, 504, 81, 82, 83, 0, 84, 85, 86, 87,
+	88, 89, 0, 505, 90, 91, 92, 457, 448, 453,
+	458, 449, 450, 454, 93, 94, 95, 96, 97, 98,
+	484, 485, 99, 0, 100, 0, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 110, 111,
+	478, 113, 0, 114, 0, 115, 116, 117, 0, 118,
+	119, 120, 0, 121, 122, 123, 124, 415, 125, 126,
+	127, 460, 432, 128, 0, 129, 130, 486, 131, 0,
+	132, 0, 133, 506, 0, 507, 134, 135, 136, 0,
+	137, 468, 0, 329, 138, 0, 139, 140, 141, 142,
+	143, 508, 144, 145, 146, 147, 0, 148, 149, 150,
+	151, 152, 153, 0, 154, 509, 330, 155, 156, 157,
+	158, 487, 488, 0, 446, 0, 159, 510, 511, 160,
+	512, 161, 162, 163, 164, 165, 0, 0, 166, 469,
+	513, 167, 514, 0, 168, 169, 170, 451, 452, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 489, 515, 490, 186, 1484, 337,
+	404, 188, 189, 516, 190, 433, 466, 191, 491, 192,
+	193, 194, 0, 195, 0, 0, 420, 197, 198, 0,
+	0, 199, 340, 517, 200, 518, 461, 201, 202, 203,
+	204, 205, 206, 207, 0, 208, 209, 462, 210, 343,
+	213, 211, 212, 0, 214, 215, 216, 217, 218, 219,
+	220, 221, 492, 222, 223, 224, 225, 0, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 0,
+	237, 238, 519, 239, 240, 241, 421, 242, 243, 244,
+	245, 246, 247, 248, 249, 0, 250, 251, 252, 253,
+	254, 455, 255, 256, 346, 257, 258, 520, 259, 260,
+	493, 261, 0, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 463, 0, 273, 274, 0, 275,
+	521, 276, 277, 278, 279, 280, 0, 494, 495, 0,
+	0, 281, 282, 464, 283, 465, 431, 284, 285, 286,
+	287, 288, 289, 290, 0, 0, 291, 292, 293, 294,
+	295, 456, 0, 296, 297, 298, 299, 300, 353, 496,
+	0, 301, 522, 302, 303, 304, 305, 0, 0, 306,
+	0, 0, 307, 308, 309, 310, 311, 312, 355, 470,
+	471, 472, 473, 474, 475, 476, 477, 313, 314, 315,
+	405, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 0, 0, 0, 0, 0, 0, 0, 411, 437,
+	424, 440, 426, 427, 419, 439, 409, 410, 0, 0,
+	0, 0, 0, 0, 0, 46, 47, 48, 49, 50,
+	51, 52, 53, 0, 54, 55, 56, 0, 0, 0,
+	0, 416, 0, 0, 57, 58, 0, 59, 60, 502,
+	61, 62, 63, 316, 479, 503, 480, 481, 0, 64,
+	65, 66, 67, 68, 69, 434, 459, 70, 71, 482,
+	483, 72, 0, 73, 74, 75, 76, 467, 0, 447,
+	0, 77, 78, 79, 80, 504, 81, 82, 83, 0,
+	84, 85, 86, 87, 88, 89, 0, 505, 90, 91,
+	92, 457, 448, 453, 458, 449, 450, 454, 93, 94,
+	95, 96, 97, 98, 484, 485, 99, 0, 100, 0,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 110, 111, 478, 113, 0, 114, 0, 115,
+	116, 117, 0, 118, 119, 120, 0, 121, 122, 123,
+	124, 415, 125, 126, 127, 460, 432, 128, 0, 129,
+	130, 486, 131, 0, 132, 0, 133, 506, 0, 507,
+	134, 135, 136, 0, 137, 468, 0, 329, 138, 0,
+	139, 140, 141, 142, 143, 508, 144, 145, 146, 147,
+	0, 148, 149, 150, 151, 152, 153, 0, 154, 509,
+	330, 155, 156, 157, 158, 487, 488, 0, 446, 0,
+	159, 510, 511, 160, 512, 161, 162, 163, 164, 165,
+	0, 0, 166, 469, 513, 167, 514, 0, 168, 169,
+	170, 451, 452, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 489, 515,
+	490, 186, 187, 337, 404, 188, 189, 516, 190, 433,
+	466, 191, 491, 192, 193, 194, 0, 195, 0, 0,
+	420, 197, 198, 0, 0, 199, 340, 517, 200, 518,
+	461, 201, 202, 203, 204, 205, 206, 207, 0, 208,
+	209, 462, 210, 343, 213, 211, 212, 0, 214, 215,
+	216, 217, 218, 219, 220, 221, 492, 222, 223, 224,
+	225, 0, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 0, 237, 238, 519, 239, 240, 241,
+	421, 242, 243, 244, 245, 246, 247, 248, 249, 0,
+	250, 251, 252, 253, 254, 455, 255, 256, 346, 257,
+	258, 520, 259, 260, 493, 261, 0, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 463, 0,
+	273, 274, 0, 275, 521, 276, 277, 278, 279, 280,
+	0, 494, 495, 0, 0, 281, 282, 464, 283, 465,
+	431, 284, 285, 286, 287, 288, 289, 290, 0, 0,
+	291, 292, 293, 294, 295, 456, 0, 296, 297, 298,
+	299, 300, 353, 496, 0, 301, 522, 302, 303, 304,
+	305, 0, 0, 306, 0, 0, 307, 308, 309, 310,
+	311, 312, 1474, 470, 471, 472, 473, 474, 475, 476,
+	477, 313, 314, 315, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 0, 0, 0, 0, 0,
+	0, 0, 411, 437, 424, 440, 426, 427, 419, 439,
+	409, 410, 0, 0, 0, 0, 0, 0, 0, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 416, 0, 0, 57, 58,
+	0, 59, 60, 502, 61, 62, 63, 316, 479, 503,
+	480, 481, 0, 64, 65, 66, 67, 68, 69, 434,
+	459, 70, 71, 482, 483, 72, 0, 73, 74, 75,
+	76, 467, 0, 447, 0, 77, 78, 79, 80, 504,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 505, 90, 91, 92, 457, 448, 453, 458, 449,
+	450, 454, 93, 94, 95, 96, 97, 98, 484, 485,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 478, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 415, 125, 126, 127, 460,
+	432, 128, 0, 129, 130, 486, 131, 0, 132, 0,
+	133, 506, 0, 507, 134, 135, 136, 0, 137, 468,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 508,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 509, 330, 155, 156, 157, 158, 487,
+	488, 0, 446, 0, 159, 510, 511, 160, 512, 161,
+	162, 163, 164, 165, 0, 0, 166, 469, 513, 167,
+	514, 0, 168, 169, 170, 451, 452, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 489, 515, 490, 186, 187, 337, 0, 188,
+	189, 516, 190, 433, 466, 191, 491, 192, 193, 194,
+	0, 195, 0, 0, 420, 197, 198, 0, 0, 199,
+	340, 517, 200, 518, 461, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 462, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	492, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	519, 239, 240, 241, 994, 242, 243, 244, 245, 246,
+	247, 248, 249, 0, 250, 251, 252, 253, 254, 455,
+	255, 256, 346, 257, 258, 520, 259, 260, 493, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 463, 0, 273, 274, 0, 275, 521, 276,
+	277, 278, 279, 280, 0, 494, 495, 0, 0, 281,
+	282, 464, 283, 465, 431, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 456,
+	0, 296, 297, 298, 299, 300, 353, 496, 0, 301,
+	522, 302, 303, 304, 305, 0, 0, 306, 0, 0,
+	307, 308, 309, 310, 311, 312, 355, 470, 471, 472,
+	473, 474, 475, 476, 477, 313, 314, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 990, 991, 0,
+	0, 0, 0, 0, 0, 0, 993, 437, 424, 440,
+	426, 427, 419, 439, 409, 410, 0, 0, 0, 0,
+	0, 0, 0, 46, 47, 48, 49, 50, 51, 52,
+	53, 0, 54, 55, 56, 0, 0, 0, 0, 416,
+	0, 0, 57, 58, 0, 59, 60, 502, 61, 62,
+	63, 316, 479, 503, 480, 481, 0, 64, 65, 66,
+	67, 68, 69, 434, 459, 70, 71, 482, 483, 72,
+	0, 73, 74, 75, 76, 467, 0, 447, 0, 77,
+	78, 79, 80, 504, 81, 82, 83, 0, 84, 85,
+	86, 87, 88, 89, 0, 505, 90, 91, 92, 457,
+	448, 453, 458, 449, 450, 454, 93, 94, 95, 96,
+	97, 98, 484, 485, 99, 0, 100, 0, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	110, 111, 478, 113, 0, 114, 0, 115, 116, 117,
+	0, 118, 119, 120, 0, 121, 122, 123, 124, 415,
+	125, 126, 127, 460, 432, 128, 0, 129, 130, 486,
+	131, 0, 132, 0, 133, 506, 0, 507, 134, 135,
+	136, 0, 137, 468, 0, 329, 138, 0, 139, 140,
+	141, 142, 143, 508, 144, 145, 146, 147, 0, 148,
+	149, 150, 151, 152, 153, 0, 154, 509, 330, 155,
+	156, 157, 158, 487, 488, 0, 446, 0, 159, 0,
+	511, 160, 512, 161, 162, 163, 164, 165, 0, 0,
+	166, 469, 513, 167, 514, 0, 168, 169, 170, 451,
+	452, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 489, 515, 490, 186,
+	187, 337, 0, 188, 189, 516, 190, 433, 466, 191,
+	491, 192, 193, 194, 0, 195, 0, 0, 420, 197,
+	198, 0, 0, 199, 340, 517, 200, 518, 461, 201,
+	202, 203, 204, 205, 206, 207, 0, 208, 209, 462,
+	210, 343, 213, 211, 212, 0, 214, 215, 216, 217,
+	218, 219, 220, 221, 492, 222, 223, 224, 225, 0,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 0, 237, 238, 519, 239, 240, 241, 994, 242,
+	243, 244, 245, 246, 247, 248, 249, 0, 250, 251,
+	252, 253, 254, 455, 255, 256, 346, 257, 258, 520,
+	259, 260, 493, 261, 0, 262, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 463, 0, 273, 274,
+	0, 275, 521, 276, 277, 278, 279, 280, 0, 494,
+	495, 0, 0, 281, 282, 464, 283, 465, 431, 284,
+	285, 286, 287, 288, 289, 290, 0, 0, 291, 292,
+	293, 294, 295, 456, 0, 296, 297, 298, 299, 300,
+	353, 496, 0, 301, 522, 302, 303, 304, 305, 0,
+	0, 306, 0, 0, 307, 308, 309, 310, 311, 312,
+	355, 470, 471, 472, 473, 474, 475, 476, 477, 313,
+	314, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 990, 991, 0, 0, 437, 424, 440, 426, 427,
+	993, 439, 409, 410, 0, 0, 0, 0, 0, 0,
+	0, 46, 47, 48, 49, 50, 51, 52, 53, 0,
+	54, 55, 56, 0, 0, 0, 0, 416, 0, 0,
+	57, 58, 0, 59, 60, 502, 61, 62, 63, 316,
+	479, 503, 480, 481, 0, 64, 65, 66, 67, 68,
+	69, 434, 459, 70, 71, 482, 483, 72, 0, 73,
+	74, 75, 76, 467, 0, 447, 0, 77, 78, 79,
+	80, 504, 81, 82, 83, 0, 84, 85, 86, 87,
+	88, 89, 0, 505, 90, 91, 92, 457, 448, 453,
+	458, 449, 450, 454, 93, 94, 95, 96, 97, 98,
+	484, 485, 99, 0, 100, 0, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 110, 111,
+	478, 113, 0, 114, 0, 115, 116, 117, 0, 118,
+	119, 120, 0, 121, 122, 123, 124, 415, 125, 126,
+	127, 460, 432, 128, 0, 129, 130, 486, 131, 0,
+	132, 0, 133, 506, 0, 507, 134, 135, 136, 0,
+	137, 468, 0, 329, 138, 0, 139, 140, 141, 142,
+	143, 508, 144, 145, 146, 147, 0, 148, 149, 150,
+	151, 152, 153, 0, 154, 509, 330, 155, 156, 157,
+	158, 487, 488, 0, 446, 0, 159, 510, 511, 160,
+	512, 161, 162, 163, 164, 165, 0, 0, 166, 469,
+	513, 167, 514, 0, 168, 169, 170, 451, 452, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 489, 515, 490, 186, 187, 337,
+	0, 188, 189, 516, 190, 433, 466, 191, 491, 192,
+	193, 194, 0, 195, 0, 0, 196, 197, 198, 0,
+	0, 199, 340, 517, 200, 518, 461, 201, 202, 203,
+	204, 205, 206, 207, 0, 208, 209, 462, 210, 343,
+	213, 211, 212, 0, 214, 215, 216, 217, 218, 219,
+	220, 221, 492, 222, 223, 224, 225, 0, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 0,
+	237, 238, 519, 239, 240, 241, 994, 242, 243, 244,
+	245, 246, 247, 248, 249, 0, 250, 251, 252, 253,
+	254, 455, 255, 256, 346, 257, 258, 520, 259, 260,
+	493, 261, 0, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 463, 0, 273, 274, 0, 275,
+	521, 276, 277, 278, 279, 280, 0, 494, 495, 0,
+	0, 281, 282, 464, 283, 465, 431, 284, 285, 286,
+	287, 288, 289, 290, 0, 0, 291, 292, 293, 294,
+	295, 456, 0, 296, 297, 298, 299, 300, 353, 496,
+	0, 301, 522, 302, 303, 304, 305, 0, 0, 306,
+	0, 0, 307, 308, 309, 310, 311, 312, 355, 470,
+	471, 472, 473, 474, 475, 476, 477, 313, 314, 315,
+	0, 0, 0, 437, 424, 440, 426, 427, 0, 439,
+	409, 410, 0, 0, 0, 0, 0, 0, 993, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 416, 0, 0, 57, 58,
+	0, 59, 60, 502, 61, 62, 63, 316, 479, 503,
+	480, 481, 0, 64, 1377, 66, 67, 68, 69, 434,
+	459, 70, 71, 482, 483, 72, 0, 73, 74, 75,
+	76, 467, 0, 447, 0, 77, 78, 79, 80, 504,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 505, 90, 91, 92, 457, 448, 453, 458, 449,
+	450, 454, 93, 94, 95, 96, 97, 98, 484, 485,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 478, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 415, 125, 126, 127, 460,
+	432, 128, 0, 129, 130, 486, 131, 0, 132, 0,
+	133, 506, 0, 507, 134, 135, 136, 0, 137, 468,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 508,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 509, 330, 155, 156, 157, 158, 487,
+	488, 0, 446, 0, 159, 510, 511, 160, 512, 161,
+	162, 163, 164, 165, 0, 0, 166, 469, 513, 167,
+	514, 0, 168, 169, 170, 451, 452, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 489, 515, 490, 186, 187, 337, 0, 188,
+	189, 516, 190, 433, 466, 191, 491, 192, 193, 194,
+	0, 195, 0, 0, 196, 197, 198, 0, 0, 199,
+	340, 517, 200, 518, 461, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 462, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	492, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	519, 239, 240, 241, 994, 242, 243, 244, 245, 246,
+	247, 248, 249, 0, 250, 251, 252, 253, 254, 455,
+	255, 256, 346, 257, 258, 520, 259, 260, 493, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 463, 0, 273, 274, 0, 275, 521, 276,
+	277, 278, 279, 280, 0, 494, 495, 0, 0, 281,
+	282, 464, 283, 465, 431, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 456,
+	0, 296, 297, 298, 299, 300, 353, 496, 0, 301,
+	522, 302, 303, 304, 305, 0, 0, 306, 0, 0,
+	307, 308, 309, 310, 311, 312, 355, 470, 471, 472,
+	473, 474, 475, 476, 477, 313, 314, 315, 0, 0,
+	0, 437, 424, 440, 426, 427, 419, 439, 409, 410,
+	0, 0, 0, 0, 0, 0, 993, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 416, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 0, 479, 503, 480, 481,
+	0, 64, 65, 66, 67, 68, 69, 434, 459, 70,
+	71, 482, 483, 72, 0, 73, 74, 75, 76, 467,
+	0, 447, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 1636, 457, 448, 453, 458, 449, 450, 454,
+	93, 94, 95, 96, 97, 98, 484, 485, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 478, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 415, 125, 126, 127, 460, 432, 128,
+	0, 129, 130, 486, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 468, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 0, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 330, 155, 156, 157, 158, 487, 488, 0,
+	446, 0, 159, 0, 0, 160, 512, 161, 162, 163,
+	164, 165, 0, 0, 166, 469, 513, 167, 0, 0,
+	168, 169, 170, 451, 452, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	489, 515, 490, 186, 187, 337, 404, 188, 189, 0,
+	190, 433, 466, 191, 491, 192, 193, 194, 0, 195,
+	0, 0, 420, 197, 198, 0, 0, 199, 340, 517,
+	200, 518, 461, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 462, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 492, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 421, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 455, 255, 256,
+	346, 257, 258, 0, 259, 260, 493, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	463, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 494, 495, 0, 0, 281, 282, 464,
+	283, 465, 431, 284, 285, 286, 287, 1635, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 456, 0, 296,
+	297, 298, 299, 300, 353, 496, 0, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 470, 471, 472, 473, 474,
+	475, 476, 477, 313, 314, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 0, 0, 0,
+	0, 0, 0, 0, 411, 437, 424, 440, 426, 427,
+	419, 439, 409, 410, 0, 0, 0, 0, 0, 0,
+	0, 46, 47, 48, 49, 50, 51, 52, 53, 0,
+	54, 55, 56, 0, 0, 0, 0, 416, 0, 0,
+	57, 58, 0, 59, 60, 502, 61, 62, 63, 0,
+	479, 503, 480, 481, 0, 64, 65, 66, 67, 68,
+	69, 434, 459, 70, 71, 482, 483, 72, 0, 73,
+	74, 75, 76, 467, 0, 447, 0, 77, 78, 79,
+	80, 504, 81, 82, 83, 0, 84, 85, 86, 87,
+	88, 89, 0, 505, 90, 91, 92, 457, 448, 453,
+	458, 449, 450, 454, 93, 94, 95, 96, 97, 98,
+	484, 485, 99, 0, 100, 0, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 110, 111,
+	478, 113, 0, 114, 0, 115, 116, 117, 0, 118,
+	0, 120, 0, 121, 122, 123, 124, 415, 125, 126,
+	127, 460, 432, 128, 0, 129, 130, 486, 131, 0,
+	132, 0, 133, 506, 0, 507, 134, 135, 136, 0,
+	137, 468, 0, 329, 138, 0, 139, 140, 141, 142,
+	143, 0, 144, 145, 146, 147, 0, 148, 149, 150,
+	151, 152, 153, 0, 154, 509, 330, 155, 156, 157,
+	158, 487, 488, 0, 446, 0, 159, 0, 0, 160,
+	512, 161, 162, 163, 164, 165, 0, 0, 166, 469,
+	513, 167, 0, 0, 168, 169, 170, 451, 452, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 489, 515, 490, 186, 187, 337,
+	404, 188, 189, 0, 190, 433, 466, 191, 491, 192,
+	193, 194, 0, 195, 0, 0, 420, 197, 198, 0,
+	0, 199, 340, 517, 200, 518, 461, 201, 202, 203,
+	204, 205, 206, 207, 0, 208, 209, 462, 210, 343,
+	213, 211, 212, 0, 214, 215, 216, 217, 218, 219,
+	220, 221, 492, 222, 223, 224, 225, 0, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 0,
+	237, 238, 519, 239, 240, 241, 421, 242, 243, 244,
+	245, 246, 247, 248, 249, 0, 250, 251, 252, 253,
+	254, 455, 255, 256, 346, 257, 258, 0, 259, 260,
+	493, 261, 0, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 463, 0, 273, 274, 0, 275,
+	521, 276, 277, 278, 279, 280, 0, 494, 495, 0,
+	0, 281, 282, 464, 283, 465, 431, 284, 285, 286,
+	287, 288, 289, 290, 0, 0, 291, 292, 293, 294,
+	295, 456, 0, 296, 297, 298, 299, 300, 353, 496,
+	0, 301, 522, 302, 303, 304, 305, 0, 0, 306,
+	0, 0, 307, 308, 309, 310, 311, 312, 355, 470,
+	471, 472, 473, 474, 475, 476, 477, 313, 314, 315,
+	0, 0, 0, 437, 0, 0, 0, 0, 0, 401,
+	402, 0, 0, 0, 0, 0, 0, 0, 411, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 0, 0, 0, 57, 58,
+	0, 59, 60, 502, 61, 62, 63, 316, 317, 503,
+	318, 319, 0, 64, 65, 66, 67, 68, 69, 0,
+	459, 70, 71, 320, 321, 72, 0, 73, 74, 75,
+	76, 467, 0, 447, 0, 77, 78, 79, 80, 504,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 505, 90, 91, 92, 457, 448, 453, 458, 449,
+	450, 454, 93, 94, 95, 96, 97, 98, 323, 324,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 112, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 325, 125, 126, 127, 460,
+	0, 128, 0, 129, 130, 327, 131, 0, 132, 0,
+	133, 506, 0, 507, 134, 135, 136, 0, 137, 468,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 508,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 509, 330, 155, 156, 157, 158, 331,
+	332, 0, 333, 0, 159, 510, 511, 160, 512, 161,
+	162, 163, 164, 165, 1068, 0, 166, 469, 513, 167,
+	514, 0, 168, 169, 170, 451, 452, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 335, 515, 336, 186, 187, 337, 0, 188,
+	189, 516, 190, 0, 466, 191, 339, 192, 193, 194,
+	0, 195, 0, 41, 196, 197, 198, 0, 0, 199,
+	340, 517, 200, 518, 461, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 462, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	344, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	519, 239, 240, 241, 345, 1073, 243, 244, 245, 246,
+	247, 248, 249, 14, 250, 251, 252, 253, 254, 455,
+	255, 256, 346, 257, 258, 520, 259, 260, 347, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 463, 0, 273, 274, 16, 275, 521, 276,
+	277, 278, 279, 280, 0, 349, 350, 0, 0, 281,
+	282, 464, 283, 465, 0, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 456,
+	0, 296, 297, 298, 299, 300, 628, 354, 0, 301,
+	522, 302, 303, 304, 305, 0, 0, 306, 0, 17,
+	307, 308, 309, 310, 311, 312, 355, 470, 471, 472,
+	473, 474, 475, 476, 477, 313, 314, 315, 0, 0,
+	18, 437, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1071, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 316, 317, 503, 318, 319,
+	0, 64, 65, 66, 67, 68, 69, 0, 459, 70,
+	71, 320, 321, 72, 0, 73, 74, 75, 76, 467,
+	0, 447, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 92, 457, 448, 453, 458, 449, 450, 454,
+	93, 94, 95, 96, 97, 98, 323, 324, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 112, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 325, 125, 126, 127, 460, 0, 128,
+	0, 129, 130, 327, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 468, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 330, 155, 156, 157, 158, 331, 332, 0,
+	333, 0, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 1068, 0, 166, 469, 513, 167, 514, 0,
+	168, 169, 170, 451, 452, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	335, 515, 336, 186, 187, 337, 0, 188, 189, 516,
+	190, 0, 466, 191, 339, 192, 193, 194, 0, 195,
+	0, 41, 196, 197, 198, 0, 0, 199, 340, 517,
+	200, 518, 461, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 462, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 344, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 345, 1073, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 455, 255, 256,
+	346, 257, 258, 520, 259, 260, 347, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	463, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 349, 350, 0, 0, 281, 282, 464,
+	283, 465, 0, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 456, 0, 296,
+	297, 298, 299, 300, 353, 354, 0, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 470, 471, 472, 473, 474,
+	475, 476, 477, 313, 314, 315, 0, 0, 0, 437,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1071, 46, 47, 48, 49, 50,
+	51, 52, 53, 0, 54, 55, 56, 0, 0, 0,
+	0, 0, 0, 0, 57, 58, 0, 59, 60, 502,
+	61, 62, 63, 316, 317, 503, 318, 319, 0, 64,
+	65, 66, 67, 68, 69, 0, 459, 70, 71, 320,
+	321, 72, 0, 73, 74, 75, 76, 467, 0, 447,
+	0, 77, 78, 79, 80, 504, 81, 82, 83, 0,
+	84, 85, 86, 87, 88, 89, 0, 505, 90, 91,
+	92, 457, 448, 453, 458, 449, 450, 454, 93, 94,
+	95, 96, 97, 98, 323, 324, 99, 0, 100, 0,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 110, 111, 112, 113, 0, 114, 0, 115,
+	116, 117, 0, 118, 119, 120, 0, 121, 122, 123,
+	124, 325, 125, 126, 127, 460, 0, 128, 0, 129,
+	130, 327, 131, 0, 132, 0, 133, 506, 0, 507,
+	134, 135, 136, 0, 137, 468, 0, 329, 138, 0,
+	139, 140, 141, 142, 143, 508, 144, 145, 146, 147,
+	0, 148, 149, 150, 151, 152, 153, 0, 154, 509,
+	330, 155, 156, 157, 158, 331, 332, 0, 333, 0,
+	159, 510, 511, 160, 512, 161, 162, 163, 164, 165,
+	0, 0, 166, 469, 513, 167, 514, 0, 168, 169,
+	170, 451, 452, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 335, 515,
+	336, 186, 187, 337, 0, 188, 189, 516, 190, 0,
+	466, 191, 339, 192, 193, 194, 0, 195, 0, 0,
+	196, 197, 198, 0, 0, 199, 340, 517, 200, 518,
+	461, 201, 202, 203, 204, 205, 206, 207, 0, 208,
+	209, 462, 210, 343, 213, 211, 212, 0, 214, 215,
+	216, 217, 218, 219, 220, 221, 344, 222, 223, 224,
+	225, 0, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 0, 237, 238, 519, 239, 240, 241,
+	345, 1073, 243, 244, 245, 246, 247, 248, 249, 0,
+	250, 251, 252, 253, 254, 455, 255, 256, 346, 257,
+	258, 520, 259, 260, 347, 261, 0, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 463, 0,
+	273, 274, 0, 275, 521, 276, 277, 278, 279, 280,
+	0, 349, 350, 0, 0, 281, 282, 464, 283, 465,
+	0, 284, 285, 286, 287, 288, 289, 290, 0, 0,
+	291, 292, 293, 294, 295, 456, 0, 296, 297, 298,
+	299, 300, 353, 354, 0, 301, 522, 302, 303, 304,
+	305, 0, 0, 306, 0, 0, 307, 308, 309, 310,
+	311, 312, 355, 470, 471, 472, 473, 474, 475, 476,
+	477, 313, 314, 315, 0, 0, 0, 437, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 13, 46, 47, 48, 49, 50, 51, 52,
+	53, 0, 54, 55, 56, 0, 0, 0, 0, 0,
+	0, 0, 57, 58, 0, 59, 60, 502, 61, 62,
+	63, 316, 317, 503, 318, 319, 0, 64, 65, 66,
+	67, 68, 69, 0, 459, 70, 71, 320, 321, 72,
+	0, 73, 74, 75, 76, 467, 0, 447, 0, 77,
+	78, 79, 80, 504, 81, 82, 83, 0, 84, 85,
+	86, 87, 88, 89, 0, 505, 90, 91, 92, 457,
+	448, 453, 458, 449, 450, 454, 93, 94, 95, 96,
+	97, 98, 323, 324, 99, 0, 100, 0, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	110, 111, 112, 113, 0, 114, 0, 115, 116, 117,
+	0, 118, 119, 120, 0, 121, 122, 123, 124, 325,
+	125, 126, 127, 460, 0, 128, 0, 129, 130, 327,
+	131, 0, 132, 0, 133, 506, 0, 507, 134, 135,
+	136, 0, 137, 468, 0, 329, 138, 0, 139, 140,
+	141, 142, 143, 508, 144, 145, 146, 147, 0, 148,
+	149, 150, 151, 152, 153, 0, 154, 509, 330, 155,
+	156, 157, 158, 331, 332, 0, 333, 0, 159, 510,
+	511, 160, 512, 161, 162, 163, 164, 165, 0, 0,
+	166, 469, 513, 167, 514, 0, 168, 169, 170, 451,
+	452, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 335, 515, 336, 186,
+	187, 337, 0, 188, 189, 516, 190, 0, 466, 191,
+	339, 192, 193, 194, 0, 195, 0, 0, 196, 197,
+	198, 0, 0, 199, 340, 517, 200, 518, 461, 201,
+	202, 203, 204, 205, 206, 207, 0, 208, 209, 462,
+	210, 343, 213, 211, 212, 0, 214, 215, 216, 217,
+	218, 219, 220, 221, 344, 222, 223, 224, 225, 0,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 0, 237, 238, 519, 239, 240, 241, 345, 242,
+	243, 244, 245, 246, 247, 248, 249, 0, 250, 251,
+	252, 253, 254, 455, 255, 256, 346, 257, 258, 520,
+	259, 260, 347, 261, 0, 262, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 463, 0, 273, 274,
+	0, 275, 521, 276, 277, 278, 279, 280, 0, 349,
+	350, 0, 0, 281, 282, 464, 283, 465, 0, 284,
+	285, 286, 287, 288, 289, 290, 0, 0, 291, 292,
+	293, 294, 295, 456, 0, 296, 297, 298, 299, 300,
+	353, 354, 0, 301, 522, 302, 303, 304, 305, 0,
+	0, 306, 0, 0, 307, 308, 309, 310, 311, 312,
+	355, 470, 471, 472, 473, 474, 475, 476, 477, 313,
+	314, 315, 437, 424, 440, 426, 427, 0, 439, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 46, 47,
+	48, 49, 50, 51, 52, 53, 0, 54, 55, 56,
+	0, 0, 0, 0, 0, 0, 0, 57, 58, 0,
+	59, 60, 502, 61, 62, 63, 316, 479, 503, 480,
+	481, 0, 64, 65, 66, 67, 68, 69, 0, 0,
+	70, 71, 482, 483, 72, 0, 73, 74, 75, 76,
+	322, 0, 705, 0, 77, 78, 79, 80, 504, 81,
+	82, 83, 0, 84, 85, 86, 87, 88, 89, 0,
+	505, 90, 91, 92, 0, 0, 0, 706, 0, 0,
+	0, 93, 94, 95, 96, 97, 98, 484, 485, 99,
+	0, 100, 0, 101, 102, 103, 104, 105, 106, 0,
+	107, 108, 109, 0, 0, 110, 111, 478, 113, 0,
+	114, 0, 115, 116, 117, 0, 118, 119, 120, 0,
+	121, 122, 123, 124, 325, 125, 126, 127, 326, 432,
+	128, 0, 129, 130, 486, 131, 0, 132, 0, 133,
+	506, 0, 507, 134, 135, 136, 0, 137, 328, 0,
+	329, 138, 0, 139, 140, 141, 142, 143, 508, 144,
+	145, 146, 147, 0, 148, 149, 150, 151, 152, 153,
+	0, 154, 509, 330, 155, 156, 157, 158, 487, 488,
+	0, 446, 0, 159, 510, 511, 160, 512, 161, 162,
+	163, 164, 165, 0, 0, 166, 334, 513, 167, 514,
+	0, 168, 169, 170, 0, 0, 171, 172, 173, 174,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 489, 515, 490, 186, 187, 337, 0, 188, 189,
+	516, 190, 433, 338, 191, 491, 192, 193, 194, 0,
+	195, 0, 0, 196, 197, 198, 0, 0, 199, 340,
+	517, 200, 518, 341, 201, 202, 203, 204, 205, 206,
+	207, 0, 208, 209, 342, 210, 343, 213, 211, 212,
+	0, 214, 215, 216, 217, 218, 219, 220, 221, 492,
+	222, 223, 224, 225, 0, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 0, 237, 238, 519,
+	239, 240, 241, 345, 242, 243, 244, 245, 246, 247,
+	248, 249, 0, 250, 251, 252, 253, 254, 0, 255,
+	256, 346, 257, 258, 520, 259, 260, 493, 261, 0,
+	262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	272, 348, 0, 273, 274, 0, 275, 521, 276, 277,
+	278, 279, 280, 0, 494, 495, 0, 0, 281, 282,
+	351, 283, 352, 431, 284, 285, 286, 287, 288, 289,
+	290, 0, 0, 291, 292, 293, 294, 295, 0, 0,
+	296, 297, 298, 299, 300, 353, 496, 0, 301, 522,
+	302, 303, 304, 305, 0, 0, 306, 0, 0, 307,
+	308, 309, 310, 311, 312, 355, 356, 357, 358, 359,
+	360, 361, 362, 363, 313, 314, 315, 43, 0, 0,
+	0, 0, 929, 0, 0, 0, 0, 0, 0, 0,
+	939, 940, 941, 46, 47, 48, 49, 50, 51, 52,
+	53, 0, 54, 55, 56, 0, 0, 0, 0, 0,
+	0, 0, 57, 58, 0, 59, 60, 0, 61, 62,
+	63, 316, 317, 0, 318, 319, 0, 64, 65, 66,
+	67, 68, 69, 0, 0, 70, 71, 320, 321, 72,
+	0, 73, 74, 75, 76, 322, 0, 0, 0, 77,
+	78, 79, 80, 0, 81, 82, 83, 0, 84, 85,
+	86, 87, 88, 89, 0, 0, 90, 91, 92, 0,
+	0, 0, 0, 0, 0, 0, 93, 94, 95, 96,
+	97, 98, 323, 324, 99, 0, 100, 0, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	110, 111, 112, 113, 0, 114, 0, 115, 116, 117,
+	0, 118, 119, 120, 0, 121, 122, 123, 124, 325,
+	125, 126, 127, 326, 0, 128, 0, 129, 130, 327,
+	131, 0, 132, 0, 133, 0, 0, 0, 134, 135,
+	136, 0, 137, 328, 0, 329, 138, 0, 139, 140,
+	141, 142, 143, 0, 144, 145, 146, 147, 0, 148,
+	149, 150, 151, 152, 153, 0, 154, 0, 330, 155,
+	156, 157, 158, 331, 332, 0, 333, 0, 159, 0,
+	0, 160, 0, 161, 162, 163, 164, 165, 0, 0,
+	166, 334, 0, 167, 0, 0, 168, 169, 170, 0,
+	0, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 335, 0, 336, 186,
+	187, 337, 0, 188, 189, 0, 190, 0, 338, 191,
+	339, 192, 193, 194, 0, 195, 0, 0, 196, 197,
+	198, 0, 0, 199, 340, 0, 200, 0, 341, 201,
+	202, 203, 204, 205, 206, 207, 0, 208, 209, 342,
+	210, 343, 213, 211, 212, 0, 214, 215, 216, 217,
+	218, 219, 220, 221, 344, 222, 223, 224, 225, 0,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 0, 237, 238, 0, 239, 240, 241, 345, 242,
+	243, 244, 245, 246, 247, 248, 249, 0, 250, 251,
+	252, 253, 254, 0, 255, 256, 346, 257, 258, 0,
+	259, 260, 347, 261, 0, 262, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 348, 0, 273, 274,
+	0, 275, 0, 276, 277, 278, 279, 280, 0, 349,
+	350, 0, 0, 281, 282, 351, 283, 352, 0, 284,
+	285, 286, 287, 288, 289, 290, 0, 0, 291, 292,
+	293, 294, 295, 0, 0, 296, 297, 298, 299, 300,
+	353, 354, 0, 301, 0, 302, 303, 304, 305, 0,
+	0, 306, 0, 0, 307, 308, 309, 310, 311, 312,
+	355, 356, 357, 358, 359, 360, 361, 362, 363, 313,
+	314, 315, 43, 0, 0, 0, 0, 936, 937, 938,
+	0, 930, 931, 932, 933, 934, 935, 0, 46, 47,
+	48, 49, 50, 51, 52, 53, 0, 54, 55, 56,
+	0, 0, 0, 0, 0, 0, 0, 57, 58, 0,
+	59, 60, 0, 61, 62, 63, 316, 317, 0, 318,
+	319, 0, 64, 65, 66, 67, 68, 69, 0, 0,
+	70, 71, 320, 321, 72, 0, 73, 74, 75, 76,
+	322, 0, 0, 0, 77, 78, 79, 80, 0, 81,
+	82, 83, 0, 84, 85, 86, 87, 88, 89, 0,
+	0, 90, 91, 92, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 95, 96, 97, 98, 323, 324, 99,
+	0, 100, 0, 101, 102, 103, 104, 105, 106, 0,
+	107, 108, 109, 0, 0, 110, 111, 112, 113, 0,
+	114, 0, 115, 116, 117, 0, 118, 119, 120, 0,
+	121, 122, 123, 124, 325, 125, 126, 127, 326, 0,
+	128, 0, 129, 130, 327, 131, 0, 132, 0, 133,
+	0, 0, 0, 134, 135, 136, 0, 137, 328, 0,
+	329, 138, 0, 139, 140, 141, 142, 143, 0, 144,
+	145, 146, 147, 0, 148, 149, 150, 151, 152, 153,
+	0, 154, 0, 330, 155, 156, 157, 158, 331, 332,
+	0, 333, 0, 159, 0, 0, 160, 0, 161, 162,
+	163, 164, 165, 0, 0, 166, 334, 0, 167, 0,
+	0, 168, 169, 170, 0, 0, 171, 172, 173, 174,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 335, 0, 336, 186, 187, 337, 0, 188, 189,
+	0, 190, 0, 338, 191, 339, 192, 193, 194, 0,
+	195, 0, 0, 196, 197, 198, 0, 0, 199, 340,
+	0, 200, 0, 341, 201, 202, 203, 204, 205, 206,
+	207, 0, 208, 209, 342, 210, 343, 213, 211, 212,
+	0, 214, 215, 216, 217, 218, 219, 220, 221, 344,
+	222, 223, 224, 225, 0, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 0, 237, 238, 0,
+	239, 240, 241, 345, 242, 243, 244, 245, 246, 247,
+	248, 249, 14, 250, 251, 252, 253, 254, 0, 255,
+	256, 346, 257, 258, 0, 259, 260, 347, 261, 0,
+	262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	272, 348, 0, 273, 274, 16, 275, 0, 276, 277,
+	278, 279, 280, 0, 349, 350, 0, 0, 281, 282,
+	351, 283, 352, 0, 284, 285, 286, 287, 288, 289,
+	290, 0, 0, 291, 292, 293, 294, 295, 0, 0,
+	296, 297, 298, 299, 300, 628, 354, 0, 301, 0,
+	302, 303, 304, 305, 0, 0, 306, 0, 17, 307,
+	308, 309, 310, 311, 312, 355, 356, 357, 358, 359,
+	360, 361, 362, 363, 313, 314, 315, 0, 0, 18,
+	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 13, 46, 47, 48, 49,
+	50, 51, 52, 53, 0, 54, 55, 56, 0, 0,
+	0, 0, 0, 0, 0, 57, 58, 0, 59, 60,
+	502, 61, 62, 63, 316, 317, 503, 318, 319, 0,
+	64, 65, 66, 67, 68, 69, 0, 0, 70, 71,
+	320, 321, 72, 0, 73, 74, 75, 76, 322, 0,
+	705, 0, 77, 78, 79, 80, 504, 81, 82, 83,
+	0, 84, 85, 86, 87, 88, 89, 0, 505, 90,
+	91, 92, 0, 0, 0, 706, 0, 0, 0, 93,
+	94, 95, 96, 97, 98, 323, 324, 99, 0, 100,
+	0, 101, 102, 103, 104, 105, 106, 0, 107, 108,
+	109, 0, 0, 110, 111, 112, 113, 0, 114, 0,
+	115, 116, 117, 0, 118, 119, 120, 0, 121, 122,
+	123, 124, 325, 125, 126, 127, 326, 0, 128, 0,
+	129, 130, 327, 131, 0, 132, 0, 133, 506, 0,
+	507, 134, 135, 136, 0, 137, 328, 0, 329, 138,
+	0, 139, 140, 141, 142, 143, 508, 144, 145, 146,
+	147, 0, 148, 149, 150, 151, 152, 153, 0, 154,
+	509, 330, 155, 156, 157, 158, 331, 332, 0, 333,
+	0, 159, 510, 511, 160, 512, 161, 162, 163, 164,
+	165, 0, 0, 166, 334, 513, 167, 514, 0, 168,
+	169, 170, 0, 0, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 335,
+	515, 336, 186, 187, 337, 0, 188, 189, 516, 190,
+	0, 338, 191, 339, 192, 193, 194, 0, 195, 0,
+	0, 196, 197, 198, 0, 0, 199, 340, 517, 200,
+	518, 341, 201, 202, 203, 204, 205, 206, 207, 0,
+	208, 209, 342, 210, 343, 213, 211, 212, 0, 214,
+	215, 216, 217, 218, 219, 220, 221, 344, 222, 223,
+	224, 225, 0, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 0, 237, 238, 519, 239, 240,
+	241, 345, 242, 243, 244, 245, 246, 247, 248, 249,
+	0, 250, 251, 252, 253, 254, 0, 255, 256, 346,
+	257, 258, 520, 259, 260, 347, 261, 0, 262, 263,
+	264, 265, 266, 267, 268, 269, 270, 271, 272, 348,
+	0, 273, 274, 0, 275, 521, 276, 277, 278, 279,
+	280, 0, 349, 350, 0, 0, 281, 282, 351, 283,
+	352, 0, 284, 285, 286, 287, 288, 289, 290, 0,
+	0, 291, 292, 293, 294, 295, 0, 0, 296, 297,
+	298, 299, 300, 353, 354, 0, 301, 522, 302, 303,
+	304, 305, 0, 0, 306, 0, 0, 307, 308, 309,
+	310, 311, 312, 355, 356, 357, 358, 359, 360, 361,
+	362, 363, 313, 314, 315, 43, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 563, 0, 0, 0, 0, 548, 0, 0, 549,
-	0, 567, 0, 0, 0, 572, 573, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 567,
-	0, 0, 0, 0, 573, 0, 0, 569, 570, 548,
-	0, 0, 0, 0, 0, 0, 0, 0, 567, 0,
-	0, 0, 565, 573, 0, 569, 570, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 567, 0,
-	565, 0, 0, 573, 569, 570, 0, 0, 0, 0,
-	0, 0, 0, 571, 0, 0, 0, 0, 0, 565,
-	0, 0, 0, 0, 569, 570, 578, 0, 0, 564,
-	0, 571, 0, 0, 0, 0, 0, 0, 0, 565,
-	0, 567, 0, 0, 578, 0, 573, 564, 0, 0,
-	571, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 578, 0, 0, 564, 569, 570, 0,
-	571, 0, 0, 0, 567, 0, 0, 0, 0, 573,
-	0, 0, 565, 578, 0, 0, 564, 0, 0, 0,
+	0, 46, 47, 48, 49, 50, 51, 52, 53, 0,
+	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
+	57, 58, 0, 59, 60, 0, 61, 62, 63, 316,
+	317, 0, 318, 319, 0, 64, 65, 66, 67, 68,
+	69, 0, 0, 70, 71, 320, 321, 72, 0, 73,
+	74, 75, 76, 322, 0, 0, 0, 77, 78, 79,
+	80, 0, 81, 82, 83, 0, 84, 85, 86, 87,
+	88, 89, 0, 0, 90, 91, 92, 0, 0, 0,
+	0, 0, 0, 0, 93, 94, 95, 96, 97, 98,
+	323, 324, 99, 0, 100, 0, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 110, 111,
+	112, 113, 0, 114, 0, 115, 116, 117, 0, 118,
+	119, 120, 0, 121, 122, 123, 124, 325, 125, 126,
+	127, 326, 0, 128, 0, 129, 130, 327, 131, 0,
+	132, 0, 133, 0, 0, 0, 134, 135, 136, 0,
+	137, 328, 0, 329, 138, 0, 139, 140, 141, 142,
+	143, 0, 144, 145, 146, 147, 0, 148, 149, 150,
+	151, 152, 153, 0, 154, 0, 330, 155, 156, 157,
+	158, 331, 332, 0, 333, 0, 159, 0, 0, 160,
+	0, 161, 162, 163, 164, 165, 0, 0, 166, 334,
+	0, 167, 0, 0, 168, 169, 170, 0, 0, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 335, 0, 336, 186, 187, 337,
+	0, 188, 189, 0, 190, 0, 338, 191, 339, 192,
+	193, 194, 0, 195, 0, 0, 196, 197, 198, 0,
+	0, 199, 340, 0, 200, 0, 341, 201, 202, 203,
+	204, 205, 206, 207, 0, 208, 209, 342, 210, 343,
+	213, 211, 212, 0, 214, 215, 216, 217, 218, 219,
+	220, 221, 344, 222, 223, 224, 225, 0, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 0,
+	237, 238, 0, 239, 240, 241, 345, 242, 243, 244,
+	245, 246, 247, 248, 249, 0, 250, 251, 252, 253,
+	254, 0, 255, 256, 346, 257, 258, 0, 259, 260,
+	347, 261, 0, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 348, 0, 273, 274, 0, 275,
+	0, 276, 277, 278, 279, 280, 0, 349, 350, 0,
+	0, 281, 282, 351, 283, 352, 0, 284, 285, 286,
+	287, 288, 289, 290, 0, 0, 291, 292, 293, 294,
+	295, 0, 0, 296, 297, 298, 299, 300, 353, 354,
+	0, 301, 0, 302, 303, 304, 305, 0, 0, 306,
+	0, 0, 307, 308, 309, 310, 311, 312, 355, 356,
+	357, 358, 359, 360, 361, 362, 363, 313, 314, 315,
+	0, 0, 0, 43, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1333, 46,
+	47, 48, 49, 50, 51, 52, 53, 0, 54, 55,
+	56, 0, 0, 0, 0, 0, 0, 0, 57, 58,
+	0, 59, 60, 0, 61, 62, 63, 316, 317, 0,
+	318, 319, 0, 64, 65, 66, 67, 68, 69, 0,
+	0, 70, 71, 320, 321, 72, 0, 73, 74, 75,
+	76, 322, 0, 0, 0, 77, 78, 79, 80, 0,
+	81, 82, 83, 0, 84, 85, 86, 87, 88, 89,
+	0, 0, 90, 91, 92, 0, 0, 0, 0, 0,
+	0, 0, 93, 94, 95, 96, 97, 98, 323, 324,
+	99, 0, 100, 0, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 110, 111, 112, 113,
+	0, 114, 0, 115, 116, 117, 0, 118, 119, 120,
+	0, 121, 122, 123, 124, 325, 125, 126, 127, 326,
+	0, 128, 0, 129, 130, 327, 131, 0, 132, 0,
+	133, 0, 0, 0, 134, 135, 136, 0, 137, 328,
+	0, 329, 138, 0, 139, 140, 141, 142, 143, 0,
+	144, 145, 146, 147, 0, 148, 149, 150, 151, 152,
+	153, 0, 154, 0, 330, 155, 156, 157, 158, 331,
+	332, 0, 333, 0, 159, 0, 0, 160, 0, 161,
+	162, 163, 164, 165, 0, 0, 166, 334, 0, 167,
+	0, 0, 168, 169, 170, 0, 0, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 335, 0, 336, 186, 187, 337, 0, 188,
+	189, 0, 190, 0, 338, 191, 339, 192, 193, 194,
+	0, 195, 0, 0, 196, 197, 198, 0, 0, 199,
+	340, 0, 200, 0, 341, 201, 202, 203, 204, 205,
+	206, 207, 0, 208, 209, 342, 210, 343, 213, 211,
+	212, 0, 214, 215, 216, 217, 218, 219, 220, 221,
+	344, 222, 223, 224, 225, 0, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 0, 237, 238,
+	0, 239, 240, 241, 345, 242, 243, 244, 245, 246,
+	247, 248, 249, 0, 250, 251, 252, 253, 254, 0,
+	255, 256, 346, 257, 258, 0, 259, 260, 347, 261,
+	0, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 348, 0, 273, 274, 0, 275, 0, 276,
+	277, 278, 279, 280, 0, 349, 350, 0, 0, 281,
+	282, 351, 283, 352, 0, 284, 285, 286, 287, 288,
+	289, 290, 0, 0, 291, 292, 293, 294, 295, 0,
+	0, 296, 297, 298, 299, 300, 353, 354, 0, 301,
+	0, 302, 303, 304, 305, 0, 0, 306, 0, 0,
+	307, 308, 309, 310, 311, 312, 355, 356, 357, 358,
+	359, 360, 361, 362, 363, 313, 314, 315, 0, 0,
+	0, 43, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 529, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
+	60, 0, 61, 62, 63, 316, 317, 0, 318, 319,
+	0, 64, 65, 66, 67, 68, 69, 0, 0, 70,
+	71, 320, 321, 72, 0, 73, 74, 75, 76, 322,
+	0, 0, 0, 77, 78, 79, 80, 0, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 0,
+	90, 91, 92, 0, 0, 0, 0, 0, 0, 0,
+	93, 94, 95, 96, 97, 98, 323, 324, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 112, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 325, 125, 126, 127, 326, 0, 128,
+	0, 129, 130, 327, 131, 0, 132, 0, 133, 0,
+	0, 0, 134, 135, 829, 0, 137, 328, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 0, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 0, 330, 155, 156, 157, 158, 331, 332, 0,
+	333, 0, 159, 0, 0, 160, 0, 161, 162, 163,
+	164, 165, 0, 0, 166, 334, 0, 167, 0, 0,
+	168, 169, 828, 0, 0, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	335, 0, 336, 186, 187, 337, 0, 188, 189, 0,
+	190, 0, 338, 191, 339, 192, 193, 194, 0, 195,
+	0, 0, 196, 197, 198, 0, 0, 199, 340, 0,
+	200, 0, 341, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 342, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 344, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 0, 239,
+	240, 241, 345, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 0, 255, 256,
+	346, 257, 258, 0, 259, 260, 347, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	348, 0, 273, 274, 831, 275, 0, 276, 827, 278,
+	826, 280, 0, 349, 350, 0, 0, 281, 282, 351,
+	283, 352, 0, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 830, 294, 295, 0, 0, 296,
+	297, 298, 299, 300, 353, 354, 0, 301, 0, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 356, 357, 358, 359, 360,
+	361, 362, 363, 313, 314, 315, 43, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
+	0, 54, 55, 56, 0, 0, 0, 0, 0, 0,
+	0, 57, 58, 0, 59, 60, 0, 61, 62, 63,
+	316, 317, 0, 318, 319, 0, 64, 65, 66, 67,
+	68, 69, 0, 0, 70, 71, 320, 321, 72, 0,
+	73, 74, 75, 76, 322, 0, 0, 0, 77, 78,
+	79, 80, 0, 81, 82, 83, 0, 84, 85, 86,
+	87, 88, 89, 0, 0, 90, 91, 92, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 95, 96, 97,
+	98, 323, 324, 99, 0, 100, 0, 101, 102, 103,
+	104, 105, 106, 0, 107, 108, 109, 0, 0, 110,
+	111, 112, 113, 0, 114, 0, 115, 116, 117, 0,
+	118, 119, 120, 0, 121, 122, 123, 124, 325, 125,
+	126, 127, 326, 0, 128, 0, 129, 130, 327, 131,
+	0, 132, 0, 133, 0, 0, 0, 134, 135, 136,
+	0, 137, 328, 0, 329, 138, 0, 139, 140, 141,
+	142, 143, 0, 144, 145, 146, 147, 0, 148, 149,
+	150, 151, 152, 153, 0, 154, 0, 330, 155, 156,
+	157, 158, 331, 332, 0, 333, 0, 159, 0, 0,
+	160, 0, 161, 162, 163, 164, 165, 0, 0, 166,
+	334, 0, 167, 0, 0, 168, 169, 170, 0, 0,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 335, 0, 336, 186, 187,
+	337, 0, 188, 189, 0, 190, 0, 338, 191, 339,
+	192, 193, 194, 0, 195, 0, 41, 196, 197, 198,
+	0, 0, 199, 340, 0, 200, 0, 341, 201, 202,
+	203, 204, 205, 206, 207, 0, 208, 209, 342, 210,
+	343, 213, 211, 212, 0, 214, 215, 216, 217, 218,
+	219, 220, 221, 344, 222, 223, 224, 225, 0, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	0, 237, 238, 0, 239, 240, 241, 345, 242, 243,
+	244, 245, 246, 247, 248, 249, 0, 250, 251, 252,
+	253, 254, 0, 255, 256, 346, 257, 258, 0, 259,
+	260, 347, 261, 0, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 348, 0, 273, 274, 0,
+	275, 0, 276, 277, 278, 279, 280, 0, 349, 350,
+	0, 0, 281, 282, 351, 283, 352, 0, 284, 285,
+	286, 287, 288, 289, 290, 0, 0, 291, 292, 293,
+	294, 295, 0, 0, 296, 297, 298, 299, 300, 353,
+	354, 0, 301, 0, 302, 303, 304, 305, 0, 0,
+	306, 0, 0, 307, 308, 309, 310, 311, 312, 355,
+	356, 357, 358, 359, 360, 361, 362, 363, 313, 314,
+	315, 43, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
+	60, 0, 61, 62, 63, 316, 317, 0, 318, 319,
+	0, 64, 65, 66, 67, 68, 69, 0, 0, 70,
+	71, 320, 321, 72, 0, 73, 74, 75, 76, 322,
+	0, 0, 0, 77, 78, 79, 80, 0, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 0,
+	90, 91, 92, 0, 0, 0, 0, 0, 0, 0,
+	93, 94, 95, 96, 97, 98, 323, 324, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 112, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 325, 125, 126, 127, 326, 0, 128,
+	0, 129, 130, 327, 131, 0, 132, 0, 133, 0,
+	0, 0, 134, 135, 136, 0, 137, 328, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 0, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 0, 330, 155, 156, 157, 158, 331, 332, 0,
+	333, 0, 159, 0, 0, 160, 0, 161, 162, 163,
+	164, 165, 0, 0, 166, 334, 0, 167, 0, 0,
+	168, 169, 170, 0, 0, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	335, 0, 336, 186, 187, 337, 0, 188, 189, 0,
+	190, 0, 338, 191, 339, 192, 193, 194, 0, 195,
+	0, 0, 196, 197, 198, 0, 0, 199, 340, 0,
+	200, 0, 341, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 342, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 344, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 0, 239,
+	240, 241, 345, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 0, 255, 256,
+	346, 257, 258, 0, 259, 260, 347, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	348, 0, 273, 274, 0, 275, 0, 276, 277, 278,
+	279, 280, 0, 349, 350, 0, 0, 281, 282, 351,
+	283, 352, 0, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 0, 0, 296,
+	297, 298, 299, 300, 353, 354, 0, 301, 0, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 356, 357, 358, 359, 360,
+	361, 362, 363, 313, 314, 315, 1515, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	569, 570, 0, 0, 0, 0, 0, 568, 0, 577,
-	0, 0, 547, 571, 0, 565, 559, 560, 561, 0,
-	0, 0, 0, 0, 0, 568, 578, 0, 0, 564,
-	0, 0, 0, 563, 0, 0, 0, 0, 0, 0,
-	0, 549, 0, 0, 568, 0, 571, 572, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 578,
-	0, 0, 564, 0, 568, 0, 0, 0, 0, 0,
-	0, 548, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
+	0, 54, 55, 56, 0, 0, 0, 0, 0, 0,
+	0, 57, 58, 0, 59, 60, 0, 61, 62, 63,
+	316, 317, 0, 318, 319, 0, 64, 65, 66, 67,
+	68, 69, 0, 0, 70, 71, 320, 321, 72, 0,
+	73, 74, 75, 76, 322, 0, 0, 0, 77, 78,
+	79, 80, 0, 81, 82, 83, 0, 84, 85, 86,
+	87, 88, 89, 0, 0, 90, 91, 92, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 95, 96, 97,
+	98, 323, 324, 99, 0, 100, 0, 101, 102, 103,
+	104, 105, 106, 0, 107, 108, 109, 0, 0, 110,
+	111, 112, 113, 0, 114, 0, 115, 116, 117, 0,
+	118, 119, 120, 0, 121, 122, 123, 124, 325, 125,
+	126, 127, 326, 0, 128, 0, 129, 130, 327, 131,
+	0, 132, 0, 133, 0, 0, 0, 134, 135, 136,
+	0, 137, 328, 0, 329, 138, 0, 139, 140, 141,
+	142, 143, 0, 144, 145, 146, 147, 0, 148, 149,
+	150, 151, 152, 153, 0, 154, 0, 330, 155, 156,
+	157, 158, 331, 332, 0, 333, 0, 159, 0, 0,
+	160, 0, 161, 162, 163, 164, 165, 0, 0, 166,
+	334, 0, 167, 0, 0, 168, 169, 170, 0, 0,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 335, 0, 336, 186, 187,
+	337, 0, 188, 189, 0, 190, 0, 338, 191, 339,
+	192, 193, 194, 0, 195, 0, 0, 196, 197, 198,
+	0, 0, 199, 340, 0, 200, 0, 341, 201, 202,
+	203, 204, 205, 206, 207, 0, 208, 209, 342, 210,
+	343, 213, 211, 212, 0, 214, 215, 216, 217, 218,
+	219, 220, 221, 344, 222, 223, 224, 225, 0, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	0, 237, 238, 0, 239, 240, 241, 345, 242, 243,
+	244, 245, 246, 247, 248, 249, 0, 250, 251, 252,
+	253, 254, 0, 255, 256, 346, 257, 258, 0, 259,
+	260, 347, 261, 0, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 348, 0, 273, 274, 0,
+	275, 0, 276, 277, 278, 279, 280, 0, 349, 350,
+	0, 0, 281, 282, 351, 283, 352, 0, 284, 285,
+	286, 287, 288, 289, 290, 0, 0, 291, 292, 293,
+	294, 295, 0, 0, 296, 297, 298, 299, 300, 353,
+	354, 0, 301, 0, 302, 303, 304, 305, 0, 0,
+	306, 0, 0, 307, 308, 309, 310, 311, 312, 355,
+	356, 357, 358, 359, 360, 361, 362, 363, 313, 314,
+	315, 43, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
+	60, 0, 61, 62, 63, 316, 317, 0, 318, 319,
+	0, 64, 65, 66, 67, 68, 69, 0, 0, 70,
+	71, 320, 321, 72, 0, 73, 74, 75, 76, 322,
+	0, 0, 0, 77, 78, 79, 80, 0, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 0,
+	90, 91, 92, 0, 0, 0, 0, 0, 0, 0,
+	93, 94, 95, 96, 97, 98, 323, 324, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 112, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 325, 125, 126, 127, 326, 0, 128,
+	0, 129, 130, 327, 131, 0, 132, 0, 133, 0,
+	0, 0, 134, 135, 136, 0, 137, 328, 0, 329,
+	138, 0, 139, 140, 141, 142, 143, 0, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 0, 330, 155, 156, 157, 158, 331, 332, 0,
+	333, 0, 159, 0, 0, 160, 0, 161, 162, 163,
+	164, 165, 0, 0, 166, 334, 0, 167, 0, 0,
+	168, 169, 170, 0, 0, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	335, 0, 336, 186, 187, 337, 0, 188, 189, 0,
+	190, 0, 338, 191, 339, 192, 193, 194, 0, 195,
+	0, 0, 196, 197, 198, 0, 0, 199, 340, 0,
+	200, 0, 341, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 342, 210, 343, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 344, 222,
+	223, 365, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 0, 239,
+	240, 241, 345, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 0, 255, 256,
+	346, 257, 258, 0, 259, 260, 347, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	348, 0, 273, 274, 0, 275, 0, 276, 277, 278,
+	279, 280, 0, 349, 350, 0, 0, 281, 282, 351,
+	283, 352, 0, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 0, 0, 296,
+	297, 298, 299, 300, 353, 354, 0, 301, 0, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 355, 356, 357, 358, 359, 360,
+	361, 362, 363, 313, 314, 315, 43, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
+	0, 54, 55, 56, 0, 0, 0, 0, 0, 0,
+	0, 57, 58, 0, 59, 60, 0, 61, 62, 63,
+	316, 317, 0, 318, 319, 0, 64, 65, 66, 67,
+	68, 69, 0, 0, 70, 71, 320, 321, 72, 0,
+	73, 74, 75, 76, 322, 0, 0, 0, 77, 78,
+	79, 80, 0, 81, 82, 83, 0, 84, 85, 86,
+	87, 88, 89, 0, 0, 90, 91, 92, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 95, 96, 97,
+	98, 323, 324, 99, 0, 100, 0, 101, 102, 103,
+	104, 105, 106, 0, 107, 108, 109, 0, 0, 110,
+	111, 112, 113, 0, 114, 0, 115, 116, 117, 0,
+	118, 119, 120, 0, 121, 122, 123, 124, 325, 125,
+	126, 127, 326, 0, 128, 0, 129, 130, 327, 131,
+	0, 132, 0, 133, 0, 0, 0, 134, 135, 136,
+	0, 137, 328, 0, 329, 138, 0, 139, 140, 141,
+	142, 143, 0, 144, 145, 146, 147, 0, 148, 149,
+	150, 151, 152, 153, 0, 154, 0, 330, 155, 156,
+	157, 158, 331, 332, 0, 333, 0, 159, 0, 0,
+	160, 0, 161, 162, 163, 164, 165, 0, 0, 166,
+	334, 0, 167, 0, 0, 168, 169, 170, 0, 0,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 335, 0, 336, 186, 187,
+	337, 0, 188, 189, 0, 190, 0, 338, 191, 339,
+	192, 193, 194, 0, 195, 0, 0, 196, 197, 198,
+	0, 0, 199, 340, 0, 200, 0, 341, 201, 202,
+	203, 204, 0, 206, 207, 0, 208, 209, 342, 210,
+	343, 213, 211, 212, 0, 214, 215, 216, 217, 218,
+	219, 0, 221, 344, 222, 223, 224, 225, 0, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	0, 237, 238, 0, 239, 240, 241, 345, 0, 243,
+	244, 245, 246, 247, 248, 249, 0, 250, 251, 252,
+	253, 254, 0, 255, 256, 346, 257, 258, 0, 259,
+	260, 347, 261, 0, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 348, 0, 273, 274, 0,
+	275, 0, 276, 277, 278, 279, 280, 0, 349, 350,
+	0, 0, 281, 282, 351, 283, 352, 0, 284, 285,
+	286, 287, 288, 289, 290, 0, 0, 291, 292, 293,
+	294, 295, 0, 0, 296, 297, 298, 299, 300, 353,
+	354, 0, 301, 0, 302, 303, 304, 305, 0, 0,
+	306, 0, 0, 307, 308, 309, 310, 311, 312, 355,
+	356, 357, 358, 359, 360, 361, 362, 363, 313, 314,
+	315, 861, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 46, 47, 48,
+	49, 50, 51, 52, 53, 0, 54, 55, 56, 0,
+	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
+	60, 502, 61, 62, 63, 0, 847, 503, 863, 853,
+	0, 64, 65, 66, 67, 68, 69, 0, 0, 70,
+	71, 865, 864, 72, 0, 73, 74, 75, 76, 0,
+	0, 705, 0, 77, 78, 79, 80, 504, 81, 82,
+	83, 0, 84, 85, 86, 87, 88, 89, 0, 505,
+	90, 91, 92, 0, 0, 0, 706, 0, 0, 0,
+	93, 94, 95, 96, 97, 98, 851, 850, 99, 0,
+	100, 0, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 110, 111, 478, 113, 0, 114,
+	0, 115, 116, 117, 0, 118, 119, 120, 0, 121,
+	122, 123, 124, 0, 125, 126, 127, 0, 0, 128,
+	0, 129, 130, 849, 131, 0, 132, 0, 133, 506,
+	0, 507, 134, 135, 136, 0, 137, 0, 0, 0,
+	138, 0, 139, 140, 141, 142, 143, 508, 144, 145,
+	146, 147, 0, 148, 149, 150, 151, 152, 153, 0,
+	154, 509, 0, 155, 156, 157, 158, 844, 845, 0,
+	860, 0, 159, 510, 511, 160, 512, 161, 162, 163,
+	164, 165, 0, 0, 166, 0, 513, 167, 514, 0,
+	168, 169, 170, 0, 0, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	867, 515, 868, 186, 187, 0, 0, 188, 189, 516,
+	190, 0, 0, 191, 852, 192, 193, 194, 0, 195,
+	0, 0, 196, 197, 198, 0, 0, 199, 0, 517,
+	200, 518, 0, 201, 202, 203, 204, 205, 206, 207,
+	0, 208, 209, 0, 210, 0, 213, 211, 212, 0,
+	214, 215, 216, 217, 218, 219, 220, 221, 848, 222,
+	223, 224, 225, 0, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 0, 237, 238, 519, 239,
+	240, 241, 0, 242, 243, 244, 245, 246, 247, 248,
+	249, 0, 250, 251, 252, 253, 254, 0, 255, 256,
+	836, 257, 258, 520, 259, 260, 846, 261, 0, 262,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	0, 0, 273, 274, 0, 275, 521, 276, 277, 278,
+	279, 280, 0, 859, 858, 0, 0, 281, 282, 0,
+	283, 0, 0, 284, 285, 286, 287, 288, 289, 290,
+	0, 0, 291, 292, 293, 294, 295, 0, 0, 296,
+	297, 298, 299, 300, 0, 866, 0, 301, 522, 302,
+	303, 304, 305, 0, 0, 306, 0, 0, 307, 308,
+	309, 310, 311, 312, 861, 0, 0, 0, 0, 0,
+	0, 0, 0, 313, 314, 315, 0, 0, 0, 0,
+	46, 47, 48, 49, 50, 51, 52, 53, 0, 54,
+	55, 56, 0, 0, 0, 0, 0, 0, 0, 57,
+	58, 0, 59, 60, 502, 61, 62, 63, 0, 847,
+	503, 863, 853, 0, 64, 65, 66, 67, 68, 69,
+	0, 0, 70, 71, 865, 864, 72, 0, 73, 74,
+	75, 76, 0, 0, 705, 0, 77, 78, 79, 80,
+	504, 81, 82, 83, 0, 84, 85, 86, 87, 88,
+	89, 0, 505, 90, 91, 92, 0, 0, 0, 706,
+	0, 0, 0, 93, 94, 95, 96, 97, 98, 851,
+	850, 99, 0, 100, 0, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 110, 111, 478,
+	113, 0, 114, 0, 115, 116, 117, 0, 118, 119,
+	120, 0, 121, 122, 123, 124, 0, 125, 126, 127,
+	0, 0, 128, 0, 129, 130, 849, 131, 0, 132,
+	0, 133, 506, 0, 507, 134, 135, 136, 0, 137,
+	0, 0, 0, 138, 0, 139, 140, 141, 142, 143,
+	508, 144, 145, 146, 147, 0, 148, 149, 150, 151,
+	152, 153, 0, 154, 509, 0, 155, 156, 157, 158,
+	844, 845, 0, 860, 0, 159, 510, 511, 160, 512,
+	161, 162, 163, 164, 165, 0, 0, 166, 0, 513,
+	167, 514, 0, 168, 169, 170, 0, 0, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 867, 515, 868, 186, 187, 0, 0,
+	188, 189, 516, 190, 0, 0, 191, 852, 192, 193,
+	194, 0, 195, 0, 0, 196, 197, 198, 0, 0,
+	199, 0, 517, 200, 518, 0, 201, 202, 203, 204,
+	205, 206, 207, 0, 208, 209, 0, 210, 0, 213,
+	211, 212, 0, 214, 215, 216, 217, 218, 219, 220,
+	221, 848, 222, 223, 224, 225, 0, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 0, 237,
+	238, 519, 239, 240, 241, 0, 242, 243, 244, 245,
+	246, 247, 248, 249, 0, 250, 251, 252, 253, 254,
+	0, 255, 256, 0, 257, 258, 520, 259, 260, 846,
+	261, 0, 262, 263, 264, 265, 266, 267, 268, 269,
+	270, 271, 272, 0, 0, 273, 274, 0, 275, 521,
+	276, 277, 278, 279, 280, 0, 859, 858, 0, 0,
+	281, 282, 0, 283, 0, 0, 284, 285, 286, 287,
+	288, 289, 290, 0, 0, 291, 292, 293, 294, 295,
+	0, 0, 296, 297, 298, 299, 300, 0, 866, 0,
+	301, 522, 302, 303, 304, 305, 0, 0, 306, 0,
+	0, 307, 308, 309, 310, 311, 312, 0, 0, 0,
+	0, 614, 0, 0, 0, 584, 313, 314, 315, 596,
+	597, 598, 0, 0, 0, 0, 0, 0, 0, 614,
+	0, 0, 0, 584, 0, 0, 600, 596, 597, 598,
+	0, 0, 0, 0, 586, 0, 0, 0, 0, 614,
+	609, 0, 0, 584, 600, 0, 0, 596, 597, 598,
+	0, 0, 586, 0, 0, 0, 0, 0, 609, 0,
+	0, 0, 0, 0, 600, 585, 0, 614, 0, 0,
+	0, 584, 586, 0, 0, 596, 597, 598, 609, 0,
+	0, 0, 0, 585, 0, 0, 0, 0, 0, 0,
+	0, 0, 600, 0, 0, 0, 0, 0, 0, 0,
+	586, 0, 0, 585, 0, 0, 609, 0, 0, 614,
+	0, 0, 0, 584, 0, 0, 0, 596, 597, 598,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	566, 0, 0, 0, 556, 557, 558, 568, 550, 551,
-	552, 553, 554, 555, 0, 0, 0, 0, 566, 0,
-	0, 1127, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 0, 0, 0, 0, 1402, 566, 0, 0,
-	568, 556, 557, 558, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 0, 0, 1395, 0, 566, 0, 0,
-	0, 556, 557, 558, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 0, 0, 1390, 567, 0, 0, 0,
-	0, 573, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 585, 0, 0, 600, 0, 0, 0, 819, 0,
+	0, 0, 586, 0, 0, 0, 0, 0, 609, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	566, 0, 569, 570, 556, 557, 558, 0, 550, 551,
-	552, 553, 554, 555, 0, 0, 0, 565, 1386, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 566, 0, 0, 0, 556, 557, 558,
-	0, 550, 551, 552, 553, 554, 555, 0, 571, 0,
-	0, 1351, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 578, 577, 0, 564, 547, 0, 0, 0, 559,
-	560, 561, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 577, 0, 0, 547, 563, 0, 0, 559,
-	560, 561, 0, 0, 549, 0, 0, 0, 0, 0,
-	572, 577, 0, 0, 547, 0, 563, 0, 559, 560,
-	561, 0, 0, 0, 549, 0, 0, 0, 0, 0,
-	572, 0, 0, 0, 548, 563, 0, 0, 0, 0,
-	0, 0, 0, 549, 0, 0, 0, 0, 577, 572,
-	0, 547, 568, 0, 548, 559, 560, 561, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 577, 0, 0,
-	547, 0, 563, 548, 559, 560, 561, 0, 0, 0,
-	549, 0, 0, 0, 0, 0, 572, 0, 0, 0,
-	0, 563, 0, 0, 0, 0, 0, 0, 0, 549,
-	577, 0, 0, 547, 0, 572, 0, 559, 560, 561,
-	548, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 563, 0, 0, 0, 769, 548,
-	0, 0, 549, 0, 0, 0, 0, 0, 572, 567,
-	0, 0, 0, 0, 573, 566, 0, 0, 0, 556,
-	557, 558, 0, 550, 551, 552, 553, 554, 555, 567,
-	0, 0, 548, 1327, 573, 569, 570, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 567, 0,
-	565, 0, 0, 573, 0, 569, 570, 0, 0, 0,
+	0, 0, 604, 585, 0, 0, 0, 610, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 0, 0, 569, 570, 0, 0, 0, 770,
-	0, 571, 0, 0, 0, 567, 0, 0, 0, 565,
-	573, 0, 0, 0, 578, 0, 0, 564, 0, 0,
-	0, 571, 0, 0, 567, 0, 0, 0, 0, 573,
-	0, 569, 570, 0, 578, 0, 0, 564, 0, 0,
-	571, 0, 0, 0, 0, 0, 565, 0, 0, 0,
-	569, 570, 0, 578, 0, 0, 564, 567, 0, 0,
-	0, 0, 573, 0, 0, 565, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 571, 0, 0,
-	0, 0, 0, 569, 570, 0, 0, 0, 0, 0,
-	578, 0, 0, 564, 0, 568, 571, 0, 565, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 578,
-	0, 0, 564, 0, 0, 568, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 571,
-	0, 0, 0, 0, 568, 0, 0, 0, 0, 0,
-	577, 0, 578, 547, 0, 564, 0, 559, 560, 561,
+	604, 0, 0, 0, 0, 610, 0, 0, 606, 607,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 563, 0, 0, 0, 0, 0,
-	0, 568, 549, 0, 0, 0, 0, 0, 572, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 566, 0,
-	568, 0, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 548, 0, 0, 0, 1238, 0, 566, 0,
-	0, 0, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 0, 568, 0, 0, 1208, 566, 0, 0,
-	0, 556, 557, 558, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 0, 0, 1155, 0, 0, 0, 0,
+	604, 0, 0, 602, 0, 610, 606, 607, 0, 0,
+	0, 820, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 602, 0, 0, 0, 0, 606, 607, 604, 0,
+	0, 0, 0, 610, 608, 0, 0, 0, 0, 0,
+	0, 602, 0, 0, 0, 0, 0, 615, 0, 0,
+	601, 0, 608, 0, 606, 607, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 615, 0, 0, 601, 602,
+	604, 0, 608, 0, 0, 610, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 615, 0, 0, 601, 0,
+	0, 0, 0, 0, 0, 0, 606, 607, 0, 0,
+	608, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 602, 0, 615, 614, 0, 601, 0, 584, 0,
+	0, 0, 596, 597, 598, 0, 0, 0, 605, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 600,
+	0, 0, 608, 0, 0, 0, 605, 586, 0, 0,
+	0, 0, 614, 609, 0, 615, 584, 0, 601, 0,
+	596, 597, 598, 0, 0, 0, 605, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 600, 585, 0,
+	0, 0, 0, 0, 0, 586, 0, 0, 0, 0,
+	0, 609, 0, 0, 605, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 566, 0, 0, 0, 556, 557,
-	558, 0, 550, 551, 552, 553, 554, 555, 767, 0,
-	0, 0, 866, 566, 1458, 0, 0, 556, 557, 558,
-	0, 550, 551, 552, 553, 554, 555, 0, 0, 1295,
-	0, 0, 0, 0, 0, 0, 0, 567, 0, 0,
-	0, 0, 573, 0, 0, 0, 566, 0, 0, 0,
-	556, 557, 558, 0, 550, 551, 552, 553, 554, 555,
-	0, 0, 0, 569, 570, 0, 0, 0, 0, 0,
-	0, 0, 577, 0, 0, 547, 0, 0, 565, 559,
-	560, 561, 0, 0, 0, 0, 1169, 0, 0, 0,
-	0, 577, 0, 0, 547, 0, 563, 0, 559, 560,
-	561, 0, 0, 0, 549, 0, 0, 0, 0, 571,
-	572, 0, 0, 0, 0, 563, 0, 0, 1168, 0,
-	0, 0, 578, 549, 0, 564, 0, 0, 0, 572,
-	0, 0, 0, 0, 548, 0, 0, 0, 0, 0,
-	0, 0, 0, 577, 1457, 0, 547, 0, 0, 0,
-	559, 560, 561, 548, 0, 0, 576, 0, 0, 0,
-	0, 577, 0, 0, 547, 0, 0, 563, 559, 560,
-	561, 0, 0, 0, 0, 549, 0, 0, 0, 0,
-	0, 572, 0, 0, 0, 563, 0, 0, 575, 0,
-	0, 0, 0, 549, 0, 0, 0, 0, 0, 572,
-	0, 0, 0, 568, 0, 548, 0, 0, 0, 577,
-	0, 0, 547, 0, 0, 0, 559, 560, 561, 0,
-	0, 0, 0, 548, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 563, 0, 0, 1289, 0, 0, 567,
-	0, 549, 0, 0, 573, 0, 0, 572, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 567, 0,
-	0, 0, 0, 573, 0, 569, 570, 0, 0, 0,
-	0, 548, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 0, 0, 569, 570, 0, 0, 1119, 0,
-	0, 0, 0, 1118, 0, 0, 566, 0, 0, 565,
-	556, 557, 558, 0, 550, 551, 552, 553, 554, 555,
-	567, 571, 0, 0, 0, 573, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 0, 0, 564, 567, 0,
-	571, 0, 0, 573, 0, 0, 569, 570, 0, 0,
-	0, 0, 0, 578, 0, 0, 564, 0, 1283, 0,
-	0, 565, 0, 0, 569, 570, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 565,
-	0, 0, 0, 0, 0, 0, 567, 577, 0, 0,
-	547, 573, 571, 0, 559, 560, 561, 0, 0, 0,
-	0, 0, 0, 0, 0, 578, 0, 0, 564, 0,
-	571, 563, 569, 570, 0, 568, 0, 0, 0, 549,
-	0, 0, 0, 578, 0, 572, 564, 565, 0, 0,
-	0, 1284, 0, 0, 568, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 548,
-	0, 0, 0, 577, 0, 0, 547, 0, 571, 0,
-	559, 560, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 578, 0, 0, 564, 0, 0, 563, 0, 0,
-	1137, 0, 0, 0, 0, 549, 568, 0, 0, 0,
-	0, 572, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 568, 0, 0, 0, 566, 0,
-	0, 0, 556, 557, 558, 548, 550, 551, 552, 553,
-	554, 555, 0, 0, 0, 0, 0, 566, 0, 0,
-	0, 556, 557, 558, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 568, 0, 567, 0, 0, 0, 0, 573,
+	0, 0, 0, 0, 0, 0, 585, 0, 0, 0,
+	0, 603, 0, 0, 0, 0, 593, 594, 595, 0,
+	587, 588, 589, 590, 591, 592, 605, 0, 0, 603,
+	1336, 0, 0, 0, 593, 594, 595, 0, 587, 588,
+	589, 590, 591, 592, 0, 0, 0, 0, 1307, 603,
+	0, 0, 1650, 0, 593, 594, 595, 0, 587, 588,
+	589, 590, 591, 592, 0, 0, 0, 0, 1233, 0,
+	0, 0, 0, 0, 0, 604, 0, 603, 0, 0,
+	610, 817, 593, 594, 595, 0, 587, 588, 589, 590,
+	591, 592, 0, 0, 0, 0, 919, 0, 0, 0,
+	0, 606, 607, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 604, 0, 0, 602, 0, 610, 603,
+	0, 0, 0, 0, 593, 594, 595, 0, 587, 588,
+	589, 590, 591, 592, 0, 0, 0, 0, 0, 606,
+	607, 0, 0, 0, 0, 0, 0, 608, 0, 0,
+	0, 0, 0, 0, 602, 0, 0, 0, 0, 0,
+	615, 614, 0, 601, 0, 584, 0, 0, 0, 596,
+	597, 598, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1649, 0, 0, 608, 600, 0, 0, 0,
+	613, 0, 0, 0, 586, 614, 0, 0, 615, 584,
+	609, 601, 0, 596, 597, 598, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 566,
-	569, 570, 0, 556, 557, 558, 0, 550, 551, 552,
-	553, 554, 555, 0, 0, 565, 0, 566, 0, 0,
-	0, 556, 557, 558, 0, 550, 551, 552, 553, 554,
-	555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	567, 0, 0, 0, 0, 573, 571, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 578,
-	0, 0, 564, 0, 0, 566, 569, 570, 0, 556,
-	557, 558, 0, 550, 551, 552, 553, 554, 555, 0,
-	0, 565, 577, 0, 0, 547, 0, 0, 0, 559,
-	560, 561, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 563, 0, 0, 0,
-	0, 0, 571, 0, 549, 0, 0, 0, 0, 0,
-	572, 0, 0, 0, 577, 578, 0, 547, 564, 0,
-	0, 559, 560, 561, 0, 0, 0, 0, 0, 0,
-	568, 0, 0, 0, 548, 0, 0, 0, 563, 0,
-	0, 1120, 577, 0, 0, 547, 549, 0, 0, 559,
-	560, 561, 572, 0, 0, 0, 0, 0, 0, 1232,
-	0, 0, 577, 0, 0, 547, 563, 0, 0, 559,
-	560, 561, 0, 0, 549, 0, 548, 0, 0, 0,
-	572, 0, 0, 0, 0, 0, 563, 0, 0, 1086,
-	0, 0, 0, 0, 549, 0, 568, 0, 0, 0,
-	572, 0, 0, 0, 548, 577, 0, 0, 547, 0,
-	0, 0, 559, 560, 561, 0, 0, 0, 0, 0,
-	0, 0, 1125, 566, 548, 0, 0, 556, 557, 558,
-	0, 550, 551, 552, 553, 554, 555, 549, 0, 567,
-	0, 0, 0, 572, 573, 0, 0, 0, 0, 0,
+	600, 0, 1381, 612, 0, 585, 0, 614, 586, 0,
+	0, 584, 0, 0, 609, 596, 597, 598, 0, 0,
+	0, 605, 0, 0, 0, 614, 0, 0, 0, 584,
+	0, 0, 600, 596, 597, 598, 0, 0, 0, 585,
+	586, 0, 0, 0, 0, 0, 609, 0, 0, 0,
+	600, 0, 0, 1387, 0, 0, 0, 0, 586, 605,
+	0, 0, 0, 0, 609, 0, 0, 0, 0, 0,
+	0, 585, 0, 0, 0, 1382, 614, 0, 0, 0,
+	584, 0, 0, 0, 596, 597, 598, 0, 0, 585,
+	1191, 0, 0, 0, 0, 1190, 0, 0, 0, 0,
+	0, 600, 0, 0, 1209, 0, 0, 0, 0, 586,
+	0, 0, 604, 0, 603, 609, 0, 610, 0, 593,
+	594, 595, 0, 587, 588, 589, 590, 591, 592, 0,
+	0, 0, 0, 0, 0, 1455, 0, 0, 606, 607,
+	585, 0, 0, 0, 0, 0, 604, 0, 0, 0,
+	0, 610, 603, 602, 0, 0, 0, 593, 594, 595,
+	0, 587, 588, 589, 590, 591, 592, 0, 0, 0,
+	0, 0, 606, 607, 0, 0, 0, 0, 604, 0,
+	0, 0, 0, 610, 608, 0, 0, 602, 0, 0,
+	0, 0, 0, 0, 0, 0, 604, 615, 0, 0,
+	601, 610, 0, 0, 606, 607, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 608, 602,
+	0, 0, 606, 607, 0, 0, 0, 0, 0, 0,
+	0, 615, 0, 0, 601, 0, 0, 602, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 604, 0, 0,
+	608, 0, 610, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 615, 0, 0, 601, 0, 608, 0,
+	0, 0, 0, 606, 607, 0, 0, 0, 605, 0,
+	0, 615, 614, 0, 601, 0, 584, 0, 602, 0,
+	596, 597, 598, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 600, 0, 0,
+	0, 0, 605, 0, 0, 586, 0, 0, 0, 608,
+	0, 609, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 615, 614, 0, 601, 0, 584, 0, 0,
+	0, 596, 597, 598, 605, 0, 585, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 600, 0,
+	0, 0, 605, 0, 0, 0, 586, 0, 0, 0,
+	0, 603, 609, 0, 0, 0, 593, 594, 595, 0,
+	587, 588, 589, 590, 591, 592, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 585, 0, 614,
+	0, 0, 0, 584, 0, 603, 0, 596, 597, 598,
+	593, 594, 595, 605, 587, 588, 589, 590, 591, 592,
+	0, 0, 0, 0, 600, 0, 0, 1192, 0, 0,
+	0, 0, 586, 0, 0, 0, 0, 603, 609, 0,
+	0, 0, 593, 594, 595, 0, 587, 588, 589, 590,
+	591, 592, 0, 604, 0, 603, 0, 0, 610, 0,
+	593, 594, 595, 585, 587, 588, 589, 590, 591, 592,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 606,
+	607, 0, 0, 0, 0, 0, 0, 1197, 0, 614,
+	0, 0, 0, 584, 602, 0, 0, 596, 597, 598,
+	0, 0, 0, 0, 604, 0, 603, 0, 0, 610,
+	0, 593, 594, 595, 600, 587, 588, 589, 590, 591,
+	592, 0, 586, 0, 0, 608, 0, 0, 609, 0,
+	606, 607, 0, 0, 0, 0, 0, 0, 615, 0,
+	614, 601, 0, 0, 584, 602, 0, 0, 596, 597,
+	598, 0, 0, 585, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 600, 0, 0, 0, 0,
+	604, 0, 0, 586, 0, 610, 608, 0, 0, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 615,
+	614, 0, 601, 0, 584, 0, 606, 607, 596, 597,
+	598, 0, 0, 0, 585, 0, 0, 0, 0, 0,
+	0, 602, 0, 0, 0, 600, 0, 0, 1158, 605,
+	0, 0, 0, 586, 0, 0, 0, 0, 0, 609,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 569, 570, 548, 0, 0,
+	0, 0, 608, 0, 0, 0, 0, 0, 1330, 0,
+	0, 0, 0, 0, 585, 615, 0, 0, 601, 0,
+	604, 0, 0, 0, 0, 610, 0, 0, 0, 0,
+	605, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 606, 607, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 567, 0, 0, 0, 0, 573, 0, 0, 566,
-	0, 0, 0, 556, 557, 558, 0, 550, 551, 552,
-	553, 554, 555, 0, 0, 0, 0, 569, 570, 567,
-	0, 571, 0, 0, 573, 0, 0, 0, 0, 0,
-	0, 0, 565, 0, 578, 0, 0, 564, 0, 567,
-	0, 0, 0, 0, 573, 569, 570, 0, 0, 0,
+	0, 602, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 604, 603, 0, 0, 0, 610, 593, 594, 595,
+	0, 587, 588, 589, 590, 591, 592, 0, 0, 0,
+	0, 0, 608, 0, 0, 0, 605, 606, 607, 0,
+	0, 0, 0, 0, 0, 615, 614, 0, 601, 0,
+	584, 0, 602, 0, 596, 597, 598, 0, 0, 0,
+	0, 604, 0, 603, 0, 0, 610, 0, 593, 594,
+	595, 600, 587, 588, 589, 590, 591, 592, 0, 586,
+	0, 0, 0, 608, 0, 609, 0, 606, 607, 0,
+	0, 0, 0, 0, 0, 0, 615, 0, 0, 601,
+	0, 0, 602, 0, 0, 0, 0, 0, 0, 0,
+	585, 0, 0, 0, 1163, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 605, 0, 0, 603,
+	0, 0, 0, 608, 593, 594, 595, 0, 587, 588,
+	589, 590, 591, 592, 0, 0, 615, 614, 0, 601,
+	0, 584, 0, 0, 0, 596, 597, 598, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 0, 571, 0, 569, 570, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 578, 0, 0, 564,
-	565, 0, 567, 0, 0, 0, 0, 573, 0, 0,
-	0, 571, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 0, 0, 564, 569, 570,
-	0, 571, 0, 0, 0, 568, 0, 0, 0, 0,
-	0, 0, 1091, 565, 578, 0, 0, 564, 577, 0,
-	0, 547, 0, 0, 0, 559, 560, 561, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 605, 0, 0,
+	586, 0, 0, 0, 0, 0, 609, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 563, 0, 571, 0, 0, 568, 0, 0,
-	549, 0, 0, 0, 0, 0, 572, 578, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 568, 0, 0, 0, 0,
-	548, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 568, 0, 0, 566, 0,
-	0, 0, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 585, 0, 0, 0, 0, 0, 604, 0, 603,
+	0, 0, 610, 0, 593, 594, 595, 605, 587, 588,
+	589, 590, 591, 592, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 606, 607, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 602, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 568, 0,
-	566, 0, 0, 0, 556, 557, 558, 0, 550, 551,
-	552, 553, 554, 555, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 566, 0,
-	0, 0, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 0, 0, 0, 567, 0, 0, 566, 0,
-	573, 0, 556, 557, 558, 0, 550, 551, 552, 553,
-	554, 555, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 569, 570, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 565, 0, 0, 0,
-	0, 566, 0, 0, 0, 556, 557, 558, 0, 550,
-	551, 552, 553, 554, 555, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 571, 0, 0,
+	603, 0, 0, 0, 0, 593, 594, 595, 0, 587,
+	588, 589, 590, 591, 592, 0, 0, 0, 0, 608,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	578, 0, 0, 564, 0, 0, 0, 0, 0, 0,
+	0, 0, 615, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 604, 0,
+	603, 0, 0, 610, 0, 593, 594, 595, 0, 587,
+	588, 589, 590, 591, 592, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 606, 607, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 602,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 605, 0, 0, 0, 0, 0, 0,
+	608, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 615, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 568, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 603, 0, 0, 0,
+	0, 593, 594, 595, 605, 587, 588, 589, 590, 591,
+	592, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3413,14 +3977,17 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 566, 0, 0, 0, 556, 557,
-	558, 0, 550, 551, 552, 553, 554, 555,
+	0, 0, 0, 0, 0, 0, 0, 603, 0, 0,
+	0, 0, 593, 594, 595, 0, 587, 588, 589, 590,
+	591, 592,
 }
-var yyPact = [...]int{
 
-	-188, -1000, -305, -1000, -1000, -1000, 220, -188, 532, -317,
-	15104, -196, -1000, -1000, 297, 513, 513, 513, 500, -229,
-	-230, 7651, 7651, -1000, 177, -196, -1000, -128, 14239, -310,
+var yyPact = [...]int16{
+	92, -1000, 231, -1000, -1000, -1000, -1000, -1000, -1000, 556,
+	-1000, 397, -105, -210, 919, -247, 19582, 20887, 20017, -61,
+	92, -1000, 20017, -1000, 642, 917, 917, 917, 926, 397,
+	-1000, -1000, -113, -118, 8931, 8931, -1000, 439, -61, -1000,
+	15, 18709, -225, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3452,295 +4019,336 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -257, 20017, -1000, 0, -257, -1000,
+	8477, -1000, -109, 961, 710, 739, 760, 602, 450, 645,
+	9385, -1000, 587, 9839, 455, -105, -1000, -1000, -105, -105,
+	9839, -1000, 642, -1000, -1000, 551, -255, -1000, 23216, -1000,
+	-1000, 9839, 9839, 9839, 9839, 9839, 359, -1000, -1000, -1000,
+	-1000, 4842, -1000, -1000, -225, -1, -106, -1000, -1000, -1000,
+	-88, -3, -225, -1000, -1000, -1000, -1000, -1000, 330, 1020,
+	270, -1000, -1000, -1000, 9839, 60, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 743, -1000, -5,
+	-6, -9, -10, -1000, -1000, -1000, -1000, -1000, -1000, -11,
+	-13, -14, -15, -17, -18, -20, -21, -24, -28, -29,
+	-30, -34, -35, -36, -38, -40, -41, -47, 328, -1000,
+	80, -1000, 80, 80, -66, -66, -65, -1000, -1000, 897,
+	80, -66, -1000, -1000, -173, -166, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	7210, 304, 369, 367, 196, 180, 300, -1000, 8100, 283,
-	9423, 183, -188, -1000, -1000, -188, -188, 9423, -1000, -1000,
-	269, -320, -1000, 18492, -1000, -1000, 9423, 9423, 9423, 9423,
-	9423, 155, -1000, -1000, 4997, -1000, -1000, -310, -150, -231,
-	-1000, -1000, -1000, -220, -152, -310, -1000, -1000, -1000, -1000,
-	-1000, 212, 657, 188, -1000, -1000, -1000, 9423, -87, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	359, -1000, -154, -157, -158, -160, -1000, -1000, -1000, -1000,
-	-1000, -1000, -161, -162, -166, -167, -173, -174, -176, -177,
-	-178, -179, -180, -181, -182, -185, -187, -190, -191, -192,
-	-193, 132, -1000, -56, -1000, -56, -56, -205, -205, -204,
-	-1000, -1000, 501, -56, -205, -1000, -1000, -269, -259, -1000,
+	-1000, -1000, -1000, 269, -115, -48, -1000, -1000, -1000, 20017,
+	-225, -1000, 3517, 9839, 20017, -257, 951, 20017, -235, -1000,
+	23730, -1000, 437, -1000, 17398, 20017, -1000, -1000, -1000, -1000,
+	-1000, 380, 432, -1000, 537, -1000, 211, -1000, -1000, 9385,
+	-259, -1000, 23730, -1000, 255, -1000, -1000, -1000, 345, 23730,
+	-1000, 311, 20017, 579, -1000, 579, -261, -1000, 22710, 710,
+	739, 703, 19147, 8931, 21757, 20017, 140, 9839, 9839, 9839,
+	9839, 9839, 9839, 9839, 9839, 9839, 9839, 9839, 9839, 14341,
+	9839, 9839, 9839, 1048, 9839, 133, 1579, -1000, -1000, 506,
+	-67, 617, 3952, -1000, -1000, -59, -1000, -1000, 1000, 1000,
+	313, 24088, 24088, 10, -225, 22668, -231, -276, -61, -225,
+	-1000, -1000, -1000, 7115, 16963, 6660, -225, 4387, -1000, -1000,
+	685, 1010, 71, 23730, 772, 613, -63, 1010, 1010, 1010,
+	1010, 9839, 1391, 9839, 12109, 9839, 9839, 5296, 9839, 9839,
+	9839, 9839, 9839, 480, 13011, 9839, 847, 475, 9839, 847,
+	-1000, -64, -1000, -1000, -1000, -1000, 9839, -1000, -1000, 1010,
+	80, 80, -1000, -1000, 1010, -1000, 139, 137, 1010, -1000,
+	1010, -1000, 218, 703, 9839, -128, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 163, -104, -194, -1000,
-	-1000, -1000, 15535, -310, -1000, 2362, 9423, -332, -1000, 19329,
-	-1000, -1000, -1000, -1000, -1000, -1000, 207, 173, -1000, 249,
-	-1000, 49, -1000, -1000, -1000, 19329, -1000, 156, -1000, -1000,
-	-1000, 83, 19329, -1000, 142, 15535, 273, -1000, -1000, -1000,
-	273, -321, -1000, 17951, 353, 14673, 7651, 16397, 15535, 1,
-	9423, 9423, 9423, 9423, 9423, 9423, 9423, 9423, 9423, 9423,
-	9423, 9423, 12937, 9423, 9423, 9423, 748, 9423, -4, 838,
-	-1000, -1000, 347, -206, 374, 2793, -1000, -1000, -195, -1000,
-	-1000, 619, 619, 133, 19116, 19116, -137, 17899, -316, -323,
-	-196, -310, -1000, -1000, -1000, 5879, 13374, 5438, -310, 3224,
-	-1000, -1000, 547, 645, -68, 19329, 377, 308, -197, 645,
-	645, 645, 645, 9423, 804, 9423, 10746, 9423, 9423, 3674,
-	9423, 9423, 9423, 9423, 9423, 206, 11628, 9423, 446, 204,
-	9423, 446, -1000, -199, -1000, -1000, -1000, -1000, 9423, -1000,
-	-1000, 645, -56, -56, -1000, -1000, 645, -1000, 0, -1,
-	645, -1000, 645, -1000, 56, 353, 9423, -236, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 132, -1000, 499, -239, -1000, 8477,
+	-1000, -1000, 798, -240, -1000, -1000, -1000, -1000, 99, -1000,
+	23730, 9839, 345, -1000, 9839, -1000, 1010, 1010, -1000, -1000,
+	-1000, -1000, -1000, 498, -286, -1000, 9839, 386, 33, -1000,
+	-1000, -1000, -1000, 69, 15217, -1000, 167, 167, 187, 183,
+	167, 20017, -1000, -1000, -1000, 966, 22180, -1000, -1000, -1000,
+	-1000, -1000, -23, -217, -1000, -1000, -1000, -1000, -1000, -65,
+	-66, -66, -66, -1000, -1000, -1000, -1000, -1000, -166, -173,
+	-1000, -1000, -1000, 80, 80, 80, -1000, 897, 80, -1000,
+	-216, 39, 376, 376, 466, 466, 466, 935, 372, 372,
+	372, 372, 372, 372, 313, 24088, 23987, 2599, 9839, 9839,
+	131, 496, -67, 2276, 9839, -1000, 1381, -1000, -1000, -1000,
+	702, -69, -1000, 12109, 12109, -1000, -1000, -1000, 4842, -70,
+	-1000, -1000, -1000, -1000, 16963, -1000, -74, 9839, -1000, -1000,
+	9839, -244, -260, -1000, 23730, -1000, -129, -1000, -220, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 287, 7210, -1000, -1000,
-	-39, -1000, 83, -1000, 9423, -1000, 645, 645, -1000, -1000,
-	-1000, -1000, -1000, 219, -324, -1000, 9423, 1835, -113, -1000,
-	-1000, -70, 9423, -1000, 16, 16, 13, 8, 16, 15535,
-	-1000, -1000, -1000, 525, 16816, -1000, -1000, -1000, -1000, -1000,
-	-46, -306, -1000, -1000, -1000, -1000, -1000, -204, -205, -205,
-	-205, -1000, -1000, -1000, -1000, -1000, -259, -269, -1000, -1000,
-	-1000, -56, -56, -56, -1000, 501, -56, -1000, -301, -105,
-	222, 222, 298, 298, 298, 777, 52, 52, 52, 52,
-	52, 52, 133, 19116, 1731, 1697, 9423, 9423, -8, 336,
-	-206, 1632, 9423, -1000, 1165, -1000, -1000, -1000, 323, -208,
-	-1000, 10746, 10746, -1000, -1000, -1000, 4997, -209, -1000, -1000,
-	-1000, -1000, 13374, -1000, -211, 9423, -1000, 9423, -336, -337,
-	-1000, 19329, -1000, -237, -1000, -302, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -340,
-	-1000, -1000, -155, 9423, 9423, 9423, -239, -1000, 19329, 647,
-	-1000, -1000, -9, -1000, -11, -13, -14, -1000, -212, -242,
-	230, -1000, 9423, 160, -213, -214, 9423, -244, -245, -247,
-	-248, 19073, -250, 320, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -251, 19053, -252, 1654, -1000, 10746, 10746, 10746,
-	4997, -215, -253, 18474, -334, 19025, 6769, 6769, 6769, -254,
-	18983, 9423, -334, 17235, -344, -345, -349, -350, 2793, 147,
-	-351, -1000, 18774, 9423, -1000, -1000, 2793, 1808, 9423, 9423,
-	-355, -255, -1000, -1000, -261, -107, -108, -266, -268, 15535,
-	-70, -356, -1000, -1000, 9423, -1000, -1000, 172, 17862, -1000,
-	-1000, -1000, 15535, -1000, -113, -1000, -216, -1000, 310, 306,
-	9423, -33, -1000, 18422, 15535, -1000, 15535, 16, 16, 16,
-	16, 15535, -1000, -153, -136, 523, -1000, 645, -1000, -311,
-	2793, -303, 9423, 9423, 1619, 313, 9423, 10746, 10746, -1000,
-	9423, 307, -1000, -1000, -1000, -1000, 319, -217, -1000, 9423,
-	16397, 448, 329, -361, -1000, 4997, -271, 4556, -325, -310,
-	17843, 9423, -1000, -1000, -139, -1000, 13374, -1000, -272, 6328,
-	-1000, 166, -175, -175, -1000, 9423, 9423, 209, 238, 175,
-	67, 645, 657, 395, -1000, 9423, 18708, -1000, 13805, -76,
-	166, 17823, -1000, -1000, -1000, -1000, 16397, -1000, 9423, -1000,
-	318, 9423, -1000, 16397, 10746, 10746, 10746, 10746, 10746, 10746,
-	10746, 10746, 10746, 10746, 10746, 10746, 11187, 663, 10746, -220,
-	553, 553, 240, -326, 4115, -1000, 337, 318, 9423, 9423,
-	16397, -273, -274, -276, -1000, 9423, -334, 9423, -1000, -1000,
-	-1000, -1000, -362, -277, 12062, -1000, 9423, 2793, 18403, -367,
-	-59, 18540, -368, -1000, -1000, -97, -1000, -1000, -97, 474,
-	-1000, 306, -1000, 17918, -1000, -1000, -1000, -1000, 13374, -1000,
-	-1000, 302, 492, 19329, 9423, 254, 253, 9423, 693, -1000,
-	-1000, -1000, 15535, 15535, 15535, 15535, -1000, 244, 645, -153,
-	-228, -280, 2793, -1000, -1000, 557, 355, 9423, 9423, 149,
-	233, 179, 355, 9423, 9423, 16397, 271, -369, -1000, 9423,
-	9423, -1000, 17570, -1000, -373, -1000, 9423, -1000, -1000, 19329,
-	-1000, -1000, 657, 9423, -1000, -282, -283, 9423, -284, 19329,
-	19329, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -286, -1000,
-	-1000, 19329, 9423, -1000, -1000, 15966, 9423, -288, -1000, -289,
-	19329, 337, 19329, -1000, 294, 294, 265, 265, 265, 553,
-	201, 201, 201, 201, 201, 201, 240, 317, 332, -221,
-	-1000, 1753, 9423, -374, -1000, -1000, -1000, 19329, 19329, -290,
-	-1000, -1000, -1000, -334, 17368, -1000, 10305, -1000, 490, 114,
-	-1000, -1000, -291, -79, -81, -1000, 9423, -327, 9864, 16816,
-	-1000, -1000, -1000, -1000, 302, -1000, -293, -83, 9423, 9423,
-	-1000, 9423, 9423, 96, -1000, -1000, -1000, -1000, -1000, -1000,
-	-142, -143, 645, -1000, -1000, 355, 355, 9423, 9423, 9423,
-	355, 271, -375, -1000, 16397, 355, 355, -1000, -1000, 17335,
-	-1000, 166, -1000, -1000, -1000, -1000, 19329, 143, -1000, 17292,
-	-1000, -1000, -1000, 10746, 315, -222, -1000, 16397, 17272, -1000,
-	-1000, -1000, -379, -380, -223, 113, 11628, -1000, -1000, -1000,
-	17253, -296, 35, 19329, -49, -297, -83, -1000, -1000, 15535,
-	19329, -328, -1000, 19329, -1000, -1000, -225, 9423, -1000, -1000,
-	-144, 355, 355, 355, -1000, -1000, -1000, -298, 166, 488,
-	-1000, 1822, 10746, 16397, -381, -1000, -1000, 9423, -1000, 9423,
-	-1000, 486, -1000, -1000, 81, -1000, -1000, -1000, -330, -1000,
-	520, 9423, 686, 19329, -1000, -1000, 108, 9423, 1822, -385,
-	-1000, -386, -390, 111, -1000, -51, 15535, -227, -1000, -391,
-	-1000, -299, 8982, 8982, -334, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 661, -1000, -1000, -1000, -1000, 12496, 274, 88,
-	18201, -1000, -1000, 540, -1000, -1000, -1000, -1000, -1000, 8541,
-	-1000,
+	-1000, -1000, -263, -1000, -1000, -182, 9839, 9839, 9839, -130,
+	-1000, 23730, 1022, -1000, -1000, 129, -1000, 127, 125, 124,
+	-1000, -75, -131, 364, -1000, 9839, 392, -77, -78, 9839,
+	-133, -134, -135, -136, 23831, -137, 696, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -139, 23781, -140, 1917, -1000,
+	12109, 12109, 12109, 4842, -80, -142, 23182, -368, 23640, 8023,
+	8023, 8023, -143, 23574, 9839, -368, 2087, -267, -269, -271,
+	-275, 3952, 347, -277, -1000, 23317, 9839, -1000, -1000, 3952,
+	79, 9839, 9839, -279, -144, -1000, -1000, -145, 38, 37,
+	-148, -150, 20017, 69, -291, -1000, -1000, 9839, -81, -1000,
+	489, -1000, 20017, -1000, 530, -210, 20017, -225, -1000, -1000,
+	414, 22640, -1000, -1000, -1000, 20017, -1000, 33, -1000, -84,
+	-1000, 594, 612, 9839, -288, 1336, 1006, 1021, 15655, 1006,
+	-1000, 14779, 31, 677, -225, -85, 20017, -1000, 20017, 167,
+	167, 167, 167, 20017, -1000, 8, 13, 964, -1000, 1010,
+	-1000, -222, 3952, -223, 9839, 9839, 2253, 2207, 9839, 12109,
+	12109, -1000, 9839, 2166, -1000, -1000, -1000, -1000, 646, -86,
+	-1000, 9839, 21757, 583, 286, -297, -1000, 4842, -151, 6205,
+	-290, -225, 22620, 9839, -1000, -1000, 9, -1000, 16963, -1000,
+	-152, 7569, -1000, 403, -184, -184, -1000, 9839, 9839, 483,
+	388, 337, 229, 1010, 1020, -1000, 9839, -1000, 23523, -1000,
+	18271, 68, 403, 22602, -1000, -1000, -1000, -1000, 21757, -1000,
+	9839, -1000, 639, 9839, -1000, 21757, 12109, 12109, 12109, 12109,
+	12109, 12109, 12109, 12109, 12109, 12109, 12109, 12109, 12563, 731,
+	12109, -88, 998, 998, 402, -296, 5750, -1000, 714, 639,
+	9839, 9839, 21757, -157, -161, -162, -1000, 9839, -368, 9839,
+	-1000, -1000, -1000, -1000, -298, -164, 13449, -1000, 9839, 3952,
+	22963, -299, 76, 23266, -303, -1000, -1000, 48, -1000, -1000,
+	48, 885, -1000, 612, -1000, 3079, -210, -1000, -1000, 97,
+	-1000, -1000, -1000, -1000, -1000, -1000, 16963, -1000, -1000, 608,
+	921, 23730, 15217, 545, 544, 15217, 577, 395, 395, 395,
+	-1000, 152, -1000, 1055, -89, -1000, -1000, 289, -90, 1021,
+	1006, -225, -1000, -169, 237, 1336, 407, -94, -225, 4387,
+	-1000, -1000, 20017, 20017, 20017, 20017, -1000, 354, 1010, 8,
+	7, -170, 3952, -1000, -1000, 936, 393, 9839, 9839, 365,
+	235, 159, 393, 9839, 9839, 21757, 190, -305, -1000, 9839,
+	9839, -1000, 3011, -1000, -314, -1000, 9839, -1000, 23730, -1000,
+	-1000, 1020, 9839, -1000, -171, -175, 9839, -176, 23730, 23730,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -177, -1000, 23248,
+	9839, -1000, -1000, 21322, 9839, -178, -1000, -185, 23730, 714,
+	23730, -1000, 353, 353, 467, 467, 467, 998, 257, 257,
+	257, 257, 257, 257, 402, 631, 588, -95, -1000, 2049,
+	9839, -318, -1000, -1000, -1000, 23730, 23730, -186, -1000, -1000,
+	-1000, -368, 2959, -1000, 11655, -1000, 915, 320, -1000, -1000,
+	-187, 66, 65, -1000, 9839, -313, 11201, 22180, -1000, -1000,
+	-1000, -1000, 608, -1000, -191, 20017, -197, 63, 9839, 9839,
+	1336, 15217, 15217, 830, 542, 15217, -1000, -1000, -1000, -1000,
+	-1000, 17836, -96, 1047, -97, 20017, 20452, -1000, -1000, -225,
+	978, -1000, 16093, -182, -1000, -1000, -1000, -1000, -1000, 5,
+	4, 1010, -1000, -1000, 393, 393, 9839, 9839, 9839, 393,
+	190, -322, -1000, 21757, 393, 393, -1000, -1000, 2919, -1000,
+	403, -1000, -1000, -1000, -1000, -1000, 23730, 373, -1000, 2789,
+	-1000, -1000, -1000, 12109, 628, -98, -1000, 21757, 2666, -1000,
+	-1000, -1000, -323, -324, -99, 293, 13011, -1000, -1000, -1000,
+	2536, -198, 196, 23730, 87, -201, 63, 244, -319, -1000,
+	23, -1000, -1000, -1000, 20017, 23730, -350, -1000, 23730, -1000,
+	830, -1000, -100, 9839, 15217, -1000, -101, 1047, -328, -1000,
+	20452, -329, -1000, 21757, -330, -1000, 1053, -1000, -335, -1000,
+	948, -203, -1000, -1000, 2, 393, 393, 393, -1000, -1000,
+	-1000, -207, 403, 914, -1000, 324, 12109, 21757, -336, -1000,
+	-1000, 9839, -1000, 9839, -1000, 912, -1000, -1000, 227, -1000,
+	-1000, -1000, 838, 876, 20017, 9839, -225, -356, -1000, 947,
+	9839, -1000, 1047, 23730, -1000, 9839, -341, -1000, 1026, -342,
+	-1000, 20017, 871, -1000, -1000, 30, 16093, -1000, -103, -1000,
+	-1000, -1000, 292, 9839, 324, -343, -1000, -349, -351, 290,
+	-1000, 85, -1000, 20017, 768, 713, -1000, 23730, 20017, -107,
+	-1000, -355, -357, -1000, -1000, -1000, -1000, 20017, -1000, 404,
+	-1000, 20017, -208, 10747, 10747, -368, -1000, -1000, -1000, -1000,
+	-1000, -241, 911, 898, -1000, -1000, -1000, 274, -1000, -1000,
+	-359, -1000, -1000, -1000, 13887, 491, 243, 22925, -1000, 20017,
+	20017, 20017, -1000, -165, -1000, 969, -1000, -1000, -1000, -1000,
+	-1000, 116, -246, -250, 9839, 10293, 16528, 20017, 20017, 20017,
+	2444, -1000, 824, 240, -1000, -1000, -1000, -1000, 16528, 9839,
+	94, -184, 20017, -213, -1000, 1020,
 }
-var yyPgo = [...]int{
 
-	0, 933, 931, 790, 117, 417, 400, 930, 928, 701,
-	927, 14, 925, 5, 51, 920, 467, 501, 49, 919,
-	917, 916, 52, 147, 33, 40, 9, 27, 915, 914,
-	22, 21, 43, 912, 41, 46, 911, 910, 907, 906,
-	905, 904, 903, 29, 16, 901, 900, 44, 397, 898,
-	388, 392, 897, 890, 886, 885, 883, 390, 882, 383,
-	876, 870, 868, 867, 42, 368, 866, 24, 6, 865,
-	26, 864, 69, 863, 0, 861, 20, 860, 4, 37,
-	15, 675, 60, 39, 859, 18, 858, 47, 856, 855,
-	854, 851, 850, 36, 45, 849, 630, 56, 844, 843,
-	841, 54, 19, 840, 820, 819, 11, 7, 3, 817,
-	815, 814, 813, 2, 1, 48, 53, 25, 812, 811,
-	809, 38, 58, 794, 792, 8, 30, 788, 50, 781,
-	778, 771, 31, 538, 28, 605, 13, 765, 762, 736,
-	614, 23, 12, 729, 611, 601, 725, 428, 336, 723,
-	708, 702, 699, 331, 10, 32, 268,
+var yyPgo = [...]int16{
+	0, 1264, 1019, 35, 1263, 1257, 1256, 1255, 59, 1253,
+	1251, 1249, 14, 1247, 613, 80, 1245, 1241, 1240, 5,
+	1237, 39, 2031, 574, 506, 1236, 1232, 1014, 1229, 278,
+	1224, 78, 1012, 682, 1209, 73, 13, 1218, 52, 45,
+	53, 24, 21, 36, 6, 1215, 11, 1207, 7, 1206,
+	1204, 1203, 30, 26, 17, 1201, 81, 82, 61, 72,
+	8, 46, 1200, 1197, 51, 37, 66, 1194, 67, 56,
+	1190, 1189, 1006, 1166, 1161, 1160, 1159, 49, 28, 1157,
+	1156, 65, 572, 83, 510, 520, 1152, 69, 1150, 1147,
+	79, 544, 1145, 514, 1141, 1139, 1138, 1136, 31, 27,
+	23, 32, 1358, 1135, 146, 1134, 90, 1133, 0, 1132,
+	43, 1131, 10, 63, 19, 1055, 62, 47, 1129, 1,
+	3, 108, 1128, 1119, 1118, 1116, 54, 71, 1113, 983,
+	77, 1112, 1110, 1108, 9, 48, 86, 1107, 1105, 18,
+	22, 16, 1103, 1099, 1094, 1091, 4, 2, 74, 50,
+	55, 1090, 1089, 12, 64, 57, 1087, 1086, 15, 41,
+	1084, 75, 1080, 1078, 1077, 44, 675, 34, 114, 33,
+	1076, 68, 1075, 807, 29, 20, 1073, 650, 649, 1072,
+	631, 535, 1071, 1070, 1069, 1068, 526, 25, 42, 1060,
+	401,
 }
-var yyR1 = [...]int{
 
-	0, 1, 1, 29, 29, 29, 30, 30, 30, 69,
-	13, 13, 13, 120, 120, 121, 121, 122, 122, 141,
-	141, 141, 141, 141, 141, 155, 155, 155, 142, 142,
-	142, 142, 142, 142, 142, 150, 150, 150, 150, 139,
-	139, 35, 35, 140, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 88, 88, 149, 149, 151, 151,
-	147, 148, 143, 143, 152, 152, 144, 145, 146, 146,
-	146, 146, 146, 146, 82, 82, 31, 31, 153, 153,
-	153, 153, 156, 83, 83, 83, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	116, 116, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 17,
-	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-	17, 100, 100, 100, 100, 100, 100, 100, 103, 103,
-	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
-	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
-	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
-	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
-	104, 104, 130, 130, 131, 131, 131, 131, 124, 125,
-	125, 126, 126, 128, 128, 129, 129, 129, 127, 127,
-	127, 127, 19, 19, 20, 20, 20, 20, 20, 18,
-	18, 18, 61, 61, 61, 123, 123, 123, 123, 123,
-	123, 11, 11, 101, 101, 102, 102, 102, 154, 154,
-	117, 117, 117, 118, 118, 39, 39, 40, 40, 40,
-	40, 40, 40, 40, 40, 41, 41, 42, 45, 45,
-	46, 46, 46, 46, 46, 46, 43, 44, 47, 47,
-	47, 2, 2, 4, 4, 3, 3, 3, 3, 5,
-	5, 6, 6, 6, 6, 6, 6, 6, 22, 22,
-	21, 21, 21, 21, 21, 21, 21, 21, 21, 23,
-	23, 81, 81, 81, 10, 10, 12, 12, 26, 26,
-	27, 28, 28, 25, 25, 76, 76, 77, 77, 78,
-	80, 80, 57, 57, 56, 56, 58, 58, 59, 60,
-	60, 60, 60, 63, 63, 106, 106, 105, 105, 107,
-	109, 109, 109, 108, 110, 110, 111, 111, 112, 112,
-	112, 113, 113, 114, 114, 114, 114, 114, 38, 38,
-	38, 38, 48, 48, 48, 48, 49, 49, 50, 50,
-	51, 51, 52, 52, 53, 54, 54, 54, 55, 32,
-	32, 33, 33, 7, 7, 24, 24, 36, 36, 37,
-	37, 97, 97, 97, 98, 98, 99, 73, 73, 73,
-	72, 72, 71, 71, 71, 71, 71, 71, 71, 71,
-	71, 71, 71, 71, 74, 74, 75, 75, 79, 79,
-	89, 92, 92, 93, 91, 91, 90, 90, 119, 119,
-	64, 64, 64, 64, 65, 65, 66, 66, 34, 34,
-	94, 94, 95, 95, 96, 8, 8, 9, 9, 14,
-	14, 14, 14, 62, 62, 70, 70, 67, 138, 138,
-	86, 86, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 86, 86, 87, 85, 84, 84, 84, 68, 68,
-	68, 135, 135, 135, 132, 132, 132, 132, 132, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+var yyR1 = [...]uint8{
+	0, 189, 1, 1, 1, 2, 2, 2, 2, 63,
+	63, 63, 64, 64, 64, 103, 41, 41, 41, 41,
+	42, 42, 43, 43, 43, 43, 43, 50, 51, 51,
+	40, 40, 40, 47, 47, 48, 45, 45, 49, 49,
+	44, 44, 46, 46, 153, 153, 154, 154, 155, 155,
+	174, 174, 174, 174, 174, 174, 188, 188, 188, 175,
+	175, 175, 175, 175, 175, 175, 183, 183, 183, 183,
+	172, 172, 69, 69, 173, 173, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 122, 122, 182, 182, 184,
+	184, 180, 181, 176, 176, 185, 185, 177, 178, 179,
+	179, 179, 179, 179, 179, 116, 116, 65, 65, 186,
+	186, 186, 186, 190, 117, 117, 117, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 149, 149, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 33, 33, 33, 33, 33,
+	33, 33, 33, 33, 33, 33, 33, 33, 33, 33,
+	33, 33, 33, 33, 33, 33, 33, 33, 33, 33,
+	34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
+	34, 34, 34, 34, 133, 133, 133, 133, 133, 133,
+	133, 136, 136, 137, 137, 137, 137, 137, 137, 137,
 	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
 	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
 	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 163, 163, 164, 164, 164,
+	164, 157, 158, 158, 159, 159, 161, 161, 162, 162,
+	162, 160, 160, 160, 160, 36, 36, 35, 35, 37,
+	37, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	39, 39, 39, 39, 39, 39, 52, 52, 52, 52,
+	53, 53, 54, 54, 95, 95, 95, 156, 156, 156,
+	156, 156, 156, 29, 29, 134, 134, 135, 135, 135,
+	187, 187, 150, 150, 150, 151, 151, 73, 73, 74,
+	74, 74, 74, 74, 74, 74, 74, 75, 75, 76,
+	79, 79, 80, 80, 80, 80, 80, 80, 77, 78,
+	81, 81, 81, 4, 5, 5, 6, 6, 7, 7,
+	8, 11, 11, 10, 10, 12, 9, 3, 3, 22,
+	22, 21, 21, 21, 21, 21, 21, 21, 21, 23,
+	23, 13, 13, 13, 14, 14, 15, 16, 16, 16,
+	17, 17, 17, 18, 18, 18, 20, 20, 19, 19,
+	24, 24, 24, 24, 24, 24, 24, 56, 56, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 57, 57,
+	115, 115, 115, 28, 28, 30, 30, 60, 60, 61,
+	62, 62, 59, 59, 110, 110, 111, 111, 112, 114,
+	114, 91, 91, 90, 90, 92, 92, 93, 94, 94,
+	94, 94, 97, 97, 139, 139, 138, 138, 140, 142,
+	142, 142, 141, 143, 143, 144, 144, 145, 145, 145,
+	146, 146, 147, 147, 147, 147, 147, 72, 72, 72,
+	72, 82, 82, 82, 82, 82, 83, 83, 84, 84,
+	85, 85, 86, 86, 87, 88, 88, 88, 89, 66,
+	66, 67, 67, 25, 25, 58, 58, 70, 70, 71,
+	71, 130, 130, 130, 131, 131, 132, 107, 107, 107,
+	106, 106, 105, 105, 105, 105, 105, 105, 105, 105,
+	105, 105, 105, 105, 108, 108, 109, 109, 113, 113,
+	123, 123, 125, 125, 126, 124, 124, 152, 152, 98,
+	98, 98, 98, 99, 99, 100, 100, 68, 68, 127,
+	127, 128, 128, 129, 26, 26, 27, 27, 31, 31,
+	31, 31, 96, 96, 104, 104, 101, 171, 171, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 120, 121, 119, 118, 118, 118, 102, 102, 102,
+	168, 168, 168, 165, 165, 165, 165, 165, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170,
 }
-var yyR2 = [...]int{
 
-	0, 1, 2, 1, 1, 0, 2, 2, 0, 1,
-	1, 3, 2, 1, 2, 2, 3, 1, 3, 2,
-	3, 5, 6, 2, 3, 3, 4, 0, 1, 1,
-	1, 1, 1, 2, 4, 1, 1, 1, 1, 2,
-	3, 3, 0, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 2, 1, 3, 0, 1, 1, 1, 1,
-	5, 2, 1, 1, 1, 1, 5, 2, 2, 2,
-	1, 3, 3, 2, 1, 0, 3, 0, 5, 2,
-	5, 2, 1, 3, 3, 0, 1, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
-	1, 4, 1, 3, 3, 5, 2, 2, 3, 3,
+var yyR2 = [...]int8{
+	0, 1, 3, 1, 2, 1, 1, 0, 1, 1,
+	1, 0, 2, 2, 0, 1, 2, 1, 5, 4,
+	1, 0, 1, 5, 4, 4, 0, 6, 4, 0,
+	1, 3, 6, 1, 3, 2, 4, 0, 2, 0,
+	1, 3, 2, 4, 1, 2, 2, 3, 1, 3,
+	2, 3, 5, 6, 2, 3, 3, 4, 0, 1,
+	1, 1, 1, 1, 2, 4, 1, 1, 1, 1,
+	2, 3, 3, 0, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 3, 0, 1, 1, 1,
+	1, 5, 2, 1, 1, 1, 1, 5, 2, 2,
+	2, 1, 3, 3, 2, 1, 0, 3, 0, 5,
+	2, 5, 2, 1, 3, 3, 0, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	0, 1, 4, 1, 3, 3, 5, 2, 2, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 3, 2, 2, 3, 5, 4,
-	6, 3, 5, 4, 6, 4, 6, 5, 7, 3,
-	2, 4, 2, 3, 3, 4, 3, 4, 3, 4,
-	5, 6, 6, 7, 6, 7, 6, 7, 3, 4,
-	4, 6, 3, 4, 1, 3, 2, 2, 3, 3,
+	3, 3, 2, 2, 3, 3, 2, 2, 3, 5,
+	4, 6, 3, 5, 4, 6, 4, 6, 5, 7,
+	3, 2, 4, 2, 3, 3, 4, 3, 4, 3,
+	4, 5, 6, 6, 7, 6, 7, 6, 7, 3,
+	4, 4, 6, 3, 4, 1, 3, 2, 2, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 5, 6, 6, 7, 3, 4, 1,
-	1, 4, 1, 1, 1, 2, 2, 2, 2, 1,
-	1, 3, 5, 6, 8, 6, 6, 4, 4, 1,
-	5, 1, 1, 4, 1, 4, 1, 4, 1, 4,
-	1, 1, 1, 1, 1, 1, 6, 4, 4, 4,
-	4, 6, 5, 5, 5, 4, 6, 4, 4, 4,
-	4, 5, 7, 7, 9, 5, 4, 6, 5, 7,
-	7, 7, 2, 3, 3, 3, 4, 0, 4, 1,
-	3, 3, 1, 1, 1, 2, 2, 0, 2, 4,
-	4, 6, 1, 3, 3, 4, 4, 7, 5, 2,
-	2, 0, 1, 2, 0, 1, 4, 1, 2, 1,
-	2, 1, 3, 1, 3, 1, 3, 3, 1, 3,
-	3, 3, 2, 1, 3, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 3, 2, 3, 0,
-	3, 3, 2, 2, 1, 0, 2, 2, 3, 2,
-	1, 1, 1, 3, 3, 1, 2, 4, 4, 1,
-	1, 9, 9, 1, 2, 4, 4, 4, 2, 0,
-	3, 3, 4, 4, 4, 4, 3, 2, 1, 1,
-	0, 1, 1, 0, 1, 5, 1, 0, 1, 0,
-	3, 1, 3, 4, 3, 3, 0, 1, 3, 1,
-	2, 0, 1, 3, 1, 0, 1, 2, 3, 2,
-	4, 2, 3, 2, 0, 2, 0, 1, 3, 3,
-	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
-	0, 1, 4, 2, 2, 2, 2, 2, 1, 2,
-	2, 4, 2, 2, 1, 1, 1, 0, 2, 5,
+	3, 3, 2, 2, 5, 6, 6, 7, 3, 4,
+	1, 1, 2, 1, 4, 1, 1, 1, 2, 2,
+	2, 2, 1, 1, 3, 5, 6, 8, 6, 6,
+	4, 4, 1, 5, 1, 1, 4, 1, 4, 1,
+	4, 1, 4, 1, 1, 1, 1, 1, 1, 6,
+	4, 4, 4, 4, 6, 5, 5, 5, 4, 6,
+	4, 4, 4, 4, 5, 7, 7, 9, 5, 4,
+	6, 5, 7, 7, 7, 2, 3, 3, 3, 4,
+	0, 4, 1, 3, 3, 1, 1, 1, 2, 2,
+	0, 2, 4, 4, 6, 1, 3, 2, 0, 1,
+	3, 2, 3, 2, 3, 2, 3, 1, 5, 4,
+	3, 4, 5, 4, 5, 4, 2, 2, 2, 1,
+	1, 0, 4, 2, 1, 2, 0, 1, 4, 1,
+	2, 1, 2, 1, 3, 1, 3, 1, 3, 3,
+	1, 3, 3, 3, 2, 1, 3, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 4, 3, 2,
+	3, 0, 3, 3, 2, 2, 1, 0, 2, 2,
+	3, 2, 1, 5, 1, 3, 1, 4, 1, 3,
+	2, 5, 0, 1, 3, 3, 2, 1, 1, 3,
+	3, 1, 2, 4, 4, 2, 3, 5, 5, 1,
+	1, 2, 2, 3, 1, 3, 9, 1, 2, 0,
+	7, 7, 0, 10, 6, 0, 3, 0, 1, 3,
+	9, 9, 1, 2, 4, 4, 4, 2, 0, 3,
+	3, 4, 4, 4, 4, 3, 2, 1, 1, 0,
+	1, 1, 0, 1, 5, 1, 0, 1, 0, 3,
+	1, 3, 4, 3, 3, 0, 1, 3, 1, 2,
+	0, 1, 3, 1, 0, 1, 2, 3, 2, 4,
+	2, 3, 2, 0, 2, 0, 1, 3, 3, 2,
+	2, 0, 6, 1, 0, 3, 0, 2, 2, 0,
+	1, 4, 2, 2, 2, 2, 2, 1, 2, 2,
+	4, 2, 2, 1, 1, 4, 1, 0, 2, 5,
 	2, 3, 1, 1, 1, 1, 3, 0, 1, 1,
 	1, 1, 1, 2, 3, 2, 0, 5, 0, 5,
 	0, 4, 3, 5, 4, 3, 5, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 4, 1, 4, 1, 3,
-	5, 1, 2, 4, 2, 0, 1, 0, 1, 2,
-	2, 2, 3, 5, 1, 2, 0, 2, 1, 0,
-	1, 1, 1, 3, 3, 1, 0, 1, 3, 3,
-	2, 1, 1, 1, 3, 1, 2, 1, 1, 2,
-	1, 1, 1, 1, 1, 2, 6, 2, 3, 5,
-	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	4, 6, 1, 2, 4, 1, 0, 1, 2, 2,
+	2, 3, 5, 1, 2, 0, 2, 1, 0, 1,
+	1, 1, 3, 3, 1, 0, 1, 3, 3, 2,
+	1, 1, 1, 3, 1, 2, 1, 1, 2, 1,
+	1, 1, 1, 1, 2, 6, 2, 3, 5, 1,
+	1, 1, 1, 1, 1, 2, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3783,323 +4391,365 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -6, -5, 452, 330, -7,
-	363, 403, 455, -27, -26, 389, 198, 137, 265, -3,
-	-4, -12, -10, 27, 122, 456, -38, -70, 260, -68,
-	4, -133, -134, 19, 20, 21, 22, 23, 24, 25,
-	26, 28, 29, 30, 38, 39, 41, 42, 44, 45,
-	46, 53, 54, 55, 56, 57, 60, 61, 64, 66,
-	67, 68, 69, 74, 75, 76, 77, 79, 80, 81,
-	83, 84, 85, 86, 87, 88, 91, 92, 93, 101,
-	102, 103, 104, 105, 106, 109, 111, 113, 114, 115,
-	116, 117, 119, 120, 121, 124, 125, 126, 127, 128,
-	130, 131, 132, 134, 135, 136, 138, 139, 140, 141,
-	143, 144, 145, 148, 150, 151, 153, 155, 157, 161,
-	162, 163, 165, 169, 171, 172, 173, 174, 175, 177,
-	178, 179, 180, 182, 183, 184, 185, 186, 187, 189,
-	192, 193, 194, 195, 201, 204, 206, 207, 208, 209,
-	210, 213, 216, 219, 220, 221, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
-	238, 242, 243, 246, 247, 249, 252, 254, 255, 256,
-	258, 261, 262, 263, 266, 269, 272, 273, 274, 275,
-	276, 277, 278, 280, 281, 283, 286, 287, 285, 289,
-	290, 291, 292, 293, 294, 295, 296, 298, 299, 300,
-	301, 303, 304, 305, 306, 307, 308, 309, 310, 311,
-	312, 313, 315, 316, 318, 319, 320, 322, 323, 324,
-	325, 326, 327, 328, 329, 331, 332, 333, 334, 335,
-	337, 338, 340, 341, 343, 344, 346, 348, 349, 350,
-	351, 352, 353, 354, 355, 356, 357, 358, 361, 362,
-	364, 366, 367, 368, 369, 370, 376, 377, 379, 382,
-	383, 384, 385, 386, 387, 388, 391, 392, 393, 394,
-	395, 398, 399, 400, 401, 402, 406, 408, 409, 410,
-	411, 414, 417, 418, 419, 420, 421, 422, 432, 433,
-	434, 47, 48, 50, 51, 62, 63, 70, 107, 108,
-	142, 146, 152, 166, 168, 191, 196, 197, 199, 214,
-	239, 241, 244, 251, 253, 267, 271, 282, 284, 297,
-	321, 339, 345, 359, 372, 373, 378, 380, 403, 404,
-	423, 424, 425, 426, 427, 428, 429, 430, 431, -96,
-	452, -57, -48, -58, 154, -50, -51, -59, 218, 149,
-	257, -60, -81, 27, 122, -81, -81, 53, 453, 453,
-	-8, -9, -14, -15, 445, -17, 443, 444, -74, 245,
-	435, -97, -119, -86, 452, -89, -103, -4, 142, 35,
-	-98, -99, 9, 261, 321, -68, -87, 5, -85, 7,
-	8, -138, -150, -156, 381, 147, 250, 58, -100, -104,
-	4, -133, 10, 6, -135, -140, -151, -152, -153, 199,
-	72, 95, 98, 99, 222, 223, 96, 100, 336, 396,
-	94, 97, 59, 146, 271, 282, 359, 378, 380, 251,
-	70, 166, 214, 424, 425, 426, 427, 428, 429, 430,
-	431, 126, 48, 50, 51, 62, 63, 107, 108, 152,
-	196, 197, 239, 241, 253, 297, 345, 372, 373, 404,
-	-136, -147, -148, -144, -145, 43, 49, 78, 90, 158,
-	160, 176, 190, 202, 203, 205, 215, 217, 240, 248,
-	268, 270, 317, 342, 365, 407, -146, -9, 259, -96,
-	445, -70, 452, -65, -64, 454, 450, -95, -94, -15,
-	110, -49, -48, -56, -57, -59, 154, 296, 395, 243,
-	340, 206, -51, -50, -52, -15, 27, -33, 151, 242,
-	-53, -55, -15, -17, -63, 255, -5, -6, -4, -5,
-	-5, -28, -25, -15, -22, 200, 456, 12, 71, 41,
-	443, 444, 445, 446, 447, 448, 439, 440, 441, 16,
-	17, 18, -74, 33, 264, 217, 435, 176, 342, 202,
-	203, 248, 47, 181, -123, 36, 4, 9, 261, -72,
-	-71, -15, -15, -15, -15, -15, 270, -15, -4, -11,
-	403, -65, -4, -4, -117, 450, 452, 452, -65, 452,
-	-85, -85, -85, 452, -90, -15, -36, 417, 154, 452,
-	452, 452, 452, 452, 452, 452, 452, 452, 452, 452,
-	452, 452, 452, 452, 452, 452, 452, 452, 452, 452,
-	452, 452, 284, -82, 406, -82, -82, -35, 452, -35,
-	-88, 452, 63, 62, -82, -35, 452, -83, 437, 418,
-	452, -83, 452, -31, 63, -22, 452, -70, -64, -67,
-	445, -132, 4, -133, -134, -136, -137, 72, 97, 27,
-	31, 32, 33, 34, 35, 36, 37, 40, 52, 58,
-	59, 65, 71, 73, 82, 89, 94, 95, 96, 98,
-	99, 100, 110, 112, 118, 122, 123, 129, 133, 137,
-	147, 149, 154, 156, 159, 164, 167, 170, 181, 188,
-	198, 200, 211, 212, 218, 222, 223, 245, 250, 257,
-	259, 260, 264, 265, 279, 288, 302, 314, 330, 336,
-	347, 360, 363, 371, 374, 375, 381, 389, 390, 396,
-	397, 405, 412, 413, 415, 416, -15, 456, 453, 260,
-	206, 340, -54, -84, 452, -87, 443, 444, -32, 321,
-	322, -61, 249, 344, -62, -70, 456, 397, -29, 37,
-	118, -18, 159, -21, 369, 367, 221, 163, 393, 363,
-	-70, -14, -141, -142, 339, -139, -140, -149, -143, -153,
-	-156, -135, 196, 197, 345, 48, 297, 152, 108, 107,
-	253, 51, -147, -148, -144, -145, 373, 372, 199, 4,
-	-133, 50, 63, 62, 404, 239, 241, -120, -68, 372,
-	-15, -15, -15, -15, -15, -15, -15, -15, -15, -15,
-	-15, -15, -15, -15, -15, -15, 217, 176, 342, 47,
-	181, -15, 374, 250, 245, 381, 147, 391, 122, 255,
-	124, -34, 360, 40, -79, -4, 452, -73, 34, 347,
-	27, -132, 452, -97, 321, 452, 453, 456, -11, -118,
-	451, -15, -117, -122, -72, -68, 9, 443, 444, 445,
-	446, 447, 448, 439, 440, 441, 16, 17, 18, -11,
-	453, 453, -101, 405, 27, 122, 445, -102, -15, -69,
-	-135, -115, 432, 235, 105, 173, 232, -116, 328, -87,
-	-92, -93, 412, -37, 150, 167, 452, -87, -87, -87,
-	-87, -15, -39, -40, 4, 432, 235, 105, 173, 232,
-	328, -85, -41, -15, -45, -16, -17, 443, 444, -74,
-	452, 321, -46, -15, -11, -15, 52, 212, 375, -47,
-	-15, 159, -11, -15, -11, -11, -11, -11, 237, -17,
-	-125, -126, -15, -128, 124, 84, 237, -15, -128, 452,
-	-11, -87, -82, -82, -87, 372, 372, -87, -87, 337,
-	-18, -11, 453, 451, 457, -94, 395, -32, -15, -87,
-	-87, 226, 456, -25, -75, -72, 261, -30, 436, -24,
-	413, -13, -20, -15, -23, 363, -23, 369, 367, 369,
-	367, -23, -70, -155, 35, -142, -115, 452, -35, -121,
-	454, -121, 434, 135, -15, -15, 374, -34, 360, -79,
-	135, -15, 250, 381, 147, 391, 122, 255, 124, 159,
-	452, -16, -16, -11, -4, 452, -122, 452, -11, -66,
-	-15, 456, 451, 451, 456, 453, 454, 453, -26, 456,
-	-27, -102, -101, -101, 453, 14, 15, 374, 374, 374,
-	374, 452, 453, -91, -93, 129, -15, -109, 269, 452,
-	452, -15, 453, 453, 453, 453, 36, 453, 159, 453,
-	-42, 279, 453, 12, 443, 444, 445, 446, 447, 448,
-	439, 440, 441, 16, 17, 18, -74, 202, 181, 261,
-	-16, -16, -16, -11, 452, 453, -43, -44, 159, 154,
-	36, -47, -47, -47, 453, 159, -11, 456, 453, 453,
-	453, 453, -132, -127, 277, 453, 456, 36, -15, -132,
-	456, -15, -11, 453, 453, 453, 434, 434, 453, 453,
-	-68, -24, 453, -15, 260, 453, -70, -30, 452, 151,
-	210, -76, 167, -15, 456, 90, 240, 205, 36, 4,
-	-70, -70, -23, -23, -23, -23, -70, 450, 450, -155,
-	35, -87, 454, -35, -67, -15, -15, 135, 135, -15,
-	-16, -16, -15, 135, 159, 452, -15, -154, -141, 33,
-	33, 453, -15, 453, -11, 453, 456, -64, 453, -15,
-	-117, -122, 453, 405, -102, -26, -26, 456, -26, -15,
-	-15, 235, 173, 232, -116, 232, -116, -116, -87, -85,
-	133, -15, 371, -108, -68, 452, 413, -27, 453, -141,
-	-15, -43, -15, -141, -16, -16, -16, -16, -16, -16,
-	-16, -16, -16, -16, -16, -16, -16, 122, 245, 255,
-	124, -16, 456, -11, 453, -44, -43, -15, -15, -141,
-	453, 453, 453, -11, -15, 453, 456, 453, -17, 53,
-	-126, -132, -129, 285, 358, 453, 456, -130, 408, 36,
-	453, -83, -83, -31, -76, 451, -122, -80, 170, 53,
-	-13, 205, 205, -13, 4, -70, -70, -70, -70, 451,
-	-87, -87, 450, 453, -67, -15, -15, 135, 33, 33,
-	-15, -15, -154, 453, 456, -15, -15, 453, 453, -15,
-	-85, -102, 453, 453, 453, 453, -15, -110, -68, -15,
-	453, 453, -44, 159, 122, 255, 124, 452, -15, 453,
-	453, 453, -124, -11, 423, 53, 301, 453, 414, 414,
-	-15, -131, 456, -15, 243, -142, -80, 453, -106, 415,
-	-15, -77, -78, -15, -13, -13, 397, 259, 451, 451,
-	-87, -15, -15, -15, 453, -141, 453, -26, -111, 276,
-	453, -16, 159, 452, -154, 453, 453, 456, 453, 452,
-	301, -17, 453, 453, 350, 402, 453, -106, -105, -107,
-	-68, 456, 452, -15, 451, 453, -26, 53, -16, -154,
-	453, -11, -125, 53, 433, 243, 456, 36, -78, -19,
-	4, -112, 295, 322, -11, 453, 453, 453, 301, 402,
-	-107, -108, 456, 453, 453, -113, -114, 47, 386, 93,
-	-15, -113, 4, -114, 283, 153, 321, 283, 153, 33,
-	-114,
+var yyChk = [...]int16{
+	-1000, -189, -1, -2, -3, -4, 2, -21, -22, 198,
+	-24, -23, -13, 457, 334, -25, 367, 420, 441, 407,
+	460, 2, 204, -61, -60, 393, 202, 141, 269, -23,
+	-24, -22, -21, -22, -30, -28, 28, 125, 461, -72,
+	-104, 264, -102, 4, -166, -167, 20, 21, 22, 23,
+	24, 25, 26, 27, 29, 30, 31, 39, 40, 42,
+	43, 45, 46, 47, 54, 55, 56, 57, 58, 59,
+	62, 63, 66, 68, 69, 70, 71, 76, 77, 78,
+	79, 81, 82, 83, 85, 86, 87, 88, 89, 90,
+	93, 94, 95, 103, 104, 105, 106, 107, 108, 111,
+	113, 115, 116, 117, 118, 119, 120, 122, 123, 124,
+	127, 128, 129, 130, 132, 134, 135, 136, 138, 139,
+	140, 142, 143, 144, 145, 147, 148, 149, 152, 154,
+	155, 157, 159, 161, 165, 166, 167, 169, 173, 175,
+	176, 177, 178, 179, 181, 182, 183, 184, 186, 187,
+	188, 189, 190, 191, 193, 196, 197, 198, 199, 205,
+	208, 210, 211, 212, 213, 214, 217, 220, 223, 224,
+	225, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 242, 246, 247, 250, 251,
+	253, 256, 258, 259, 260, 262, 265, 266, 267, 270,
+	273, 276, 277, 278, 279, 280, 281, 282, 284, 285,
+	287, 290, 291, 289, 293, 294, 295, 296, 297, 298,
+	299, 300, 302, 303, 304, 305, 307, 308, 309, 310,
+	311, 312, 313, 314, 315, 316, 317, 319, 320, 322,
+	323, 324, 326, 327, 328, 329, 330, 331, 332, 333,
+	335, 336, 337, 338, 339, 341, 342, 344, 345, 347,
+	348, 350, 352, 353, 354, 355, 356, 357, 358, 359,
+	360, 361, 362, 365, 366, 368, 370, 371, 372, 373,
+	374, 380, 381, 383, 386, 387, 388, 389, 390, 391,
+	392, 395, 396, 397, 398, 399, 402, 403, 404, 405,
+	406, 410, 412, 413, 414, 415, 418, 421, 422, 423,
+	424, 425, 426, 436, 437, 438, 48, 49, 51, 52,
+	64, 65, 72, 109, 110, 146, 150, 156, 170, 172,
+	195, 200, 201, 203, 218, 243, 245, 248, 255, 257,
+	271, 275, 286, 288, 301, 325, 343, 349, 363, 376,
+	377, 382, 384, 407, 408, 427, 428, 429, 430, 431,
+	432, 433, 434, 435, -14, 304, -15, -102, -14, -129,
+	457, -2, -5, -104, -91, -82, -92, 158, -84, -85,
+	222, -93, 153, 261, -94, -115, 28, 125, -115, -115,
+	55, -61, -60, 458, 458, -26, -27, -31, -32, 450,
+	-34, 448, 449, -108, 249, 439, -130, -152, -120, 11,
+	12, 457, -123, -136, -22, 146, 36, -131, -132, 9,
+	265, 325, -102, -121, 5, -119, 7, 8, -171, -183,
+	-190, 385, 151, 254, 60, -133, -137, 4, -166, 10,
+	6, -168, -173, -184, -185, -186, 203, 74, 97, 100,
+	101, 226, 227, 98, 102, 340, 400, 96, 99, 61,
+	150, 275, 286, 363, 382, 384, 255, 72, 170, 218,
+	428, 429, 430, 431, 432, 433, 434, 435, 129, 49,
+	51, 52, 64, 65, 109, 110, 156, 200, 201, 243,
+	245, 257, 301, 349, 376, 377, 408, -169, -180, -181,
+	-177, -178, 44, 50, 80, 92, 162, 164, 180, 194,
+	206, 207, 209, 219, 221, 244, 252, 272, 274, 321,
+	346, 369, 411, -179, -27, 263, -129, 450, -104, 457,
+	-99, -98, 459, 455, 461, -14, -20, 457, -128, -127,
+	-32, 112, -6, -3, 457, 37, -83, -82, -90, -91,
+	-93, 158, 300, 399, 247, 344, 210, -85, -84, 222,
+	-87, -86, -32, 28, -67, 155, 246, -87, -89, -32,
+	-34, -97, 259, -23, -23, -23, -62, -59, -32, -91,
+	-82, -56, 204, 461, 13, 73, 42, 448, 449, 450,
+	451, 452, 453, 444, 445, 446, 17, 18, 19, -108,
+	34, 268, 221, 439, 180, 346, 206, 207, 252, 48,
+	185, -156, 37, 4, 9, 265, -106, -105, -32, -32,
+	-32, -32, -32, 274, -100, -32, -22, -29, 407, -99,
+	-22, -22, -150, 455, 457, 457, -99, 457, -119, -119,
+	-119, 457, -124, -32, -70, 421, 158, 457, 457, 457,
+	457, 457, 457, 457, 457, 457, 457, 457, 457, 457,
+	457, 457, 457, 457, 457, 457, 457, 457, 457, 457,
+	288, -116, 410, -116, -116, -69, 457, -69, -122, 457,
+	65, 64, -116, -69, 457, -117, 441, 422, 457, -117,
+	457, -65, 65, -56, 457, -104, -98, -101, 450, -165,
+	4, -166, -167, -169, -170, 74, 99, 28, 32, 33,
+	34, 35, 36, 37, 38, 41, 53, 60, 61, 67,
+	73, 75, 84, 91, 96, 97, 98, 100, 101, 102,
+	112, 114, 121, 125, 126, 133, 137, 141, 151, 153,
+	158, 160, 163, 168, 171, 174, 185, 192, 202, 204,
+	215, 216, 222, 226, 227, 249, 254, 261, 263, 264,
+	268, 269, 283, 292, 306, 318, 334, 340, 351, 364,
+	367, 375, 378, 379, 385, 393, 394, 400, 401, 409,
+	416, 417, 419, 420, -32, -15, 37, -19, -102, 461,
+	458, -11, 263, -7, -8, -102, -102, 264, 210, 344,
+	-32, 461, -88, -118, 457, -121, 448, 449, -66, 325,
+	326, -95, 253, 348, -96, -104, 461, 401, -63, 38,
+	121, -83, -90, -35, 163, -55, 373, 371, 225, 167,
+	397, 367, -104, -31, -174, -175, 343, -172, -173, -182,
+	-176, -186, -190, -168, 200, 201, 349, 49, 301, 156,
+	110, 109, 257, 52, -180, -181, -177, -178, 377, 376,
+	203, 4, -166, 51, 65, 64, 408, 243, 245, -153,
+	-102, 376, -32, -32, -32, -32, -32, -32, -32, -32,
+	-32, -32, -32, -32, -32, -32, -32, -32, 221, 180,
+	346, 48, 185, -32, 378, 254, 249, 385, 151, 395,
+	125, 259, 127, -68, 364, 41, -113, -22, 457, -107,
+	35, 351, 28, -165, 457, -130, 325, 457, -98, 458,
+	461, -29, -151, 456, -32, -150, -155, -106, -102, 9,
+	448, 449, 450, 451, 452, 453, 444, 445, 446, 17,
+	18, 19, -29, 458, 458, -134, 409, 28, 125, 450,
+	-135, -32, -103, -168, -148, 436, 239, 107, 177, 236,
+	-149, 332, -121, -125, -126, 416, -71, 154, 171, 457,
+	-121, -121, -121, -121, -32, -73, -74, 4, 436, 239,
+	107, 177, 236, 332, -119, -75, -32, -79, -33, -34,
+	448, 449, -108, 457, 325, -80, -32, -29, -32, 53,
+	216, 379, -81, -32, 163, -29, -32, -29, -29, -29,
+	-29, 241, -34, -158, -159, -32, -161, 127, 86, 241,
+	-32, -161, 457, -29, -121, -116, -116, -121, 376, 376,
+	-121, -121, 341, -35, -29, 458, 456, 462, -16, 234,
+	249, 458, 461, -127, 131, 458, 461, -100, 399, -87,
+	-66, -32, -121, -121, 230, 461, -59, -109, -106, 265,
+	-64, 440, -58, 417, -37, -38, -72, -40, 215, -22,
+	-39, 457, -136, 326, -102, -171, -57, 367, -57, 373,
+	371, 373, 371, -57, -104, -188, 36, -175, -148, 457,
+	-69, -154, 459, -154, 438, 139, -32, -32, 378, -68,
+	364, -113, 139, -32, 254, 385, 151, 395, 125, 259,
+	127, 163, 457, -33, -33, -29, -22, 457, -155, 457,
+	-29, -100, -32, 461, 456, 456, 461, 458, 459, 458,
+	-60, 461, -61, -135, -134, -134, 458, 15, 16, 378,
+	378, 378, 378, 457, 458, 137, 133, -126, -32, -142,
+	273, 457, 457, -32, 458, 458, 458, 458, 37, 458,
+	163, 458, -76, 283, 458, 13, 448, 449, 450, 451,
+	452, 453, 444, 445, 446, 17, 18, 19, -108, 206,
+	185, 265, -33, -33, -33, -29, 457, 458, -77, -78,
+	163, 158, 37, -81, -81, -81, 458, 163, -29, 461,
+	458, 458, 458, 458, -165, -160, 281, 458, 461, 37,
+	-32, -165, 461, -32, -29, 458, 458, 458, 438, 438,
+	458, 458, -102, -58, 458, -32, 457, 234, -102, 210,
+	-3, -8, 264, 458, -104, -64, 457, 155, 214, -110,
+	171, -32, 461, 92, -52, 209, 244, 164, 219, 321,
+	194, -42, -41, 37, 4, -43, -41, 37, 4, -40,
+	-22, -102, -42, -39, -22, -38, 441, 163, -99, 457,
+	-104, -104, -57, -57, -57, -57, -104, 455, 455, -188,
+	36, -121, 459, -69, -101, -32, -32, 139, 139, -32,
+	-33, -33, -32, 139, 163, 457, -32, -187, -174, 34,
+	34, 458, -32, 458, -29, 458, 461, 458, -32, -150,
+	-155, 458, 409, -135, -60, -60, 461, -60, -32, -32,
+	239, 177, 236, -149, 236, -149, -149, -121, -119, -32,
+	375, -141, -102, 457, 417, -61, 458, -174, -32, -77,
+	-32, -174, -33, -33, -33, -33, -33, -33, -33, -33,
+	-33, -33, -33, -33, -33, 125, 249, 259, 127, -33,
+	461, -29, 458, -78, -77, -32, -32, -174, 458, 458,
+	458, -29, -32, 458, 461, 458, -34, 55, -159, -165,
+	-162, 289, 362, 458, 461, -163, 412, 37, 458, -117,
+	-117, -65, -110, 456, -3, 399, -155, -114, 174, 55,
+	-38, 209, 209, -38, -52, 209, -53, 272, -53, -53,
+	-50, 369, 4, 457, 4, 457, 457, -43, -42, -99,
+	458, 270, 457, -134, -104, -104, -104, -104, 456, -121,
+	-121, 455, 458, -101, -32, -32, 139, 34, 34, -32,
+	-32, -187, 458, 461, -32, -32, 458, 458, -32, -119,
+	-135, 458, 458, 458, 458, 137, -32, -143, -102, -32,
+	458, 458, -78, 163, 125, 259, 127, 457, -32, 458,
+	458, 458, -157, -29, 427, 55, 305, 458, 418, 418,
+	-32, -164, 461, -32, 247, -175, -114, 458, -10, -12,
+	-9, -102, 458, -139, 419, -32, -111, -112, -32, -38,
+	-38, -54, 401, 263, 209, -38, -171, 457, -36, 4,
+	457, -44, -46, -102, -44, 4, 37, 4, -47, -48,
+	-136, -60, 456, 456, -121, -32, -32, -32, 458, -174,
+	458, -60, -144, 280, 458, -33, 163, 457, -187, 458,
+	458, 461, 458, 457, 305, -34, 458, 458, 354, 406,
+	458, -139, -17, 331, 461, 446, -100, -138, -140, -102,
+	461, -54, 457, -32, -38, 457, -36, 458, 461, -44,
+	458, 461, -174, 458, 4, 458, 461, -45, 37, 458,
+	456, 458, -60, 55, -33, -187, 458, -29, -158, 55,
+	437, 247, -18, 104, 120, 54, -12, -32, 461, 37,
+	-112, -36, -29, 458, 4, 458, -46, 73, -49, 441,
+	-48, 457, -145, 299, 326, -29, 458, 458, 458, 305,
+	406, -19, 155, 155, -140, -141, 458, 458, -153, 270,
+	-44, 458, -146, -147, 48, 390, 95, -32, -146, 341,
+	55, 55, -51, 312, 458, -147, 287, 157, 325, 287,
+	157, -102, -19, -19, 457, 34, 378, 401, 341, 341,
+	-32, -147, -120, -171, -102, -102, -102, 458, 112, 457,
+	-120, -134, 401, -60, -102, 458,
 }
-var yyDef = [...]int{
 
-	0, -2, 1, 331, -2, -2, 369, 0, 367, 343,
-	0, 0, 2, -2, 0, 363, 363, 363, 0, 0,
-	340, 506, 0, 366, 364, 0, 344, 418, 0, 515,
-	538, 539, 540, 549, 550, 551, 552, 553, 554, 555,
-	556, 557, 558, 559, 560, 561, 562, 563, 564, 565,
-	566, 567, 568, 569, 570, 571, 572, 573, 574, 575,
-	576, 577, 578, 579, 580, 581, 582, 583, 584, 585,
-	586, 587, 588, 589, 590, 591, 592, 593, 594, 595,
-	596, 597, 598, 599, 600, 601, 602, 603, 604, 605,
-	606, 607, 608, 609, 610, 611, 612, 613, 614, 615,
-	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 677, 678, 679, 680, 681, 682, 683, 684, 685,
-	686, 687, 688, 689, 690, 691, 692, 693, 694, 695,
-	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
-	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
-	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
-	756, 757, 758, 759, 760, 761, 762, 763, 764, 765,
-	766, 767, 768, 769, 770, 771, 772, 773, 774, 775,
-	776, 777, 778, 779, 780, 781, 782, 783, 784, 785,
-	786, 787, 788, 789, 790, 791, 792, 793, 794, 795,
-	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
-	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
-	816, 817, 818, 819, 820, 821, 822, 823, 824, 825,
-	826, 827, 828, 829, 830, 831, 832, 833, 834, 835,
-	836, 837, 838, 839, 840, 841, 842, 843, 844, 845,
-	846, 847, 848, 849, 850, 851, 852, 853, 854, 855,
-	856, 857, 858, 859, 860, 861, 862, 863, 864, 443,
-	0, 427, 385, 382, 0, 424, 425, 386, 0, 0,
-	0, 394, 0, 361, 362, 0, 0, 0, 333, 334,
-	349, 505, 507, 511, 512, 102, 0, 0, 0, 0,
-	0, 0, 189, 190, 0, 192, 193, 194, 826, 0,
-	199, 200, 474, 697, 846, 488, 520, 521, 522, 523,
-	524, 0, 0, 0, 530, 531, 532, 487, 448, 209,
-	-2, -2, 533, 534, 518, 35, 36, 37, 38, -2,
-	867, 211, 212, 214, 216, 218, 220, 221, 222, 223,
-	224, -2, 0, 827, 842, 843, 849, 852, 853, 839,
-	823, 829, 835, 857, 858, 859, 860, 861, 862, 863,
-	864, 613, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, 836, -2, -2, -2, -2, -2, -2, -2,
-	543, 58, 59, 64, 65, 865, 866, 868, 869, 871,
+var yyDef = [...]int16{
+	-2, -2, -2, 3, 5, 6, 8, 397, -2, 0,
+	-2, 458, 0, 0, 456, 432, 0, 0, 0, 0,
+	-2, 4, 0, -2, 0, 452, 452, 452, 0, -2,
+	409, 410, 0, 410, 595, 0, 455, 453, 0, 433,
+	507, 0, 604, 627, 628, 629, 638, 639, 640, 641,
+	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 678, 679, 680, 681,
+	682, 683, 684, 685, 686, 687, 688, 689, 690, 691,
+	692, 693, 694, 695, 696, 697, 698, 699, 700, 701,
+	702, 703, 704, 705, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 734, 735, 736, 737, 738, 739, 740, 741,
+	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
+	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
+	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
+	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
+	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
+	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
+	822, 823, 824, 825, 826, 827, 828, 829, 830, 831,
+	832, 833, 834, 835, 836, 837, 838, 839, 840, 841,
+	842, 843, 844, 845, 846, 847, 848, 849, 850, 851,
+	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
+	862, 863, 864, 865, 866, 867, 868, 869, 870, 871,
 	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
-	882, 883, 884, 885, 886, 887, 77, 349, 0, 444,
-	419, 420, 0, 516, 494, 0, 0, 0, 502, 500,
-	501, 337, 426, 338, 384, 387, 0, 0, 389, 0,
-	391, 0, 422, 423, 428, 432, 433, 437, 441, 442,
-	430, 0, 434, -2, 284, 0, 345, 339, 340, 346,
-	347, 370, 371, 5, 281, 0, 0, 0, 0, 0,
-	462, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 122, 0, 0, 287, 0, 289, 0, 0,
-	140, 142, 499, 0, 0, 0, 510, -2, 0, 285,
-	461, 106, 107, 121, 125, 126, 0, 291, -2, 0,
-	854, 195, 196, 197, 198, 0, 0, 0, -2, 0,
-	525, 527, 99, 0, 0, 486, 450, 0, 0, 0,
-	0, 0, 0, 0, 306, 0, 319, 325, 0, 0,
+	882, 883, 884, 885, 886, 887, 888, 889, 890, 891,
+	892, 893, 894, 895, 896, 897, 898, 899, 900, 901,
+	902, 903, 904, 905, 906, 907, 908, 909, 910, 911,
+	912, 913, 914, 915, 916, 917, 918, 919, 920, 921,
+	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 934, 935, 936, 937, 938, 939, 940, 941,
+	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
+	952, 953, 954, 955, 411, 816, 414, 427, 412, 533,
+	0, 2, 0, 384, 517, 474, 471, 0, 513, 514,
+	0, 475, 0, 0, 483, 0, 450, 451, 0, 0,
+	0, -2, 0, 399, 400, 438, 594, 596, 600, 601,
+	133, 0, 0, 0, 0, 0, 0, 220, 221, 585,
+	223, 0, 225, 226, 227, 917, 0, 232, 233, 564,
+	788, 937, 577, 609, 610, 611, 612, 613, 0, 0,
+	0, 619, 620, 621, 576, 538, 242, -2, -2, 622,
+	623, 607, 66, 67, 68, 69, -2, 958, 244, 245,
+	247, 249, 251, 253, 254, 255, 256, 257, -2, 0,
+	918, 933, 934, 940, 943, 944, 930, 914, 920, 926,
+	948, 949, 950, 951, 952, 953, 954, 955, 704, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, 927,
+	-2, -2, -2, -2, -2, -2, -2, 632, 89, 90,
+	95, 96, 956, 957, 959, 960, 962, 963, 964, 965,
+	966, 967, 968, 969, 970, 971, 972, 973, 974, 975,
+	976, 977, 978, 108, 438, 0, 534, 508, 509, 0,
+	605, 583, 0, 0, 0, 413, 0, 0, 0, 591,
+	589, 590, 392, 386, 0, 0, 403, 516, 404, 473,
+	476, 0, 0, 478, 0, 480, 0, 511, 512, 0,
+	0, 518, -2, 523, 527, 531, 532, 520, 0, 524,
+	-2, 336, 0, 434, 435, 436, 459, 460, 11, 517,
+	474, 308, 0, 0, 0, 0, 0, 552, 553, 554,
+	555, 556, 557, 558, 559, 560, 561, 562, 563, 153,
+	0, 0, 339, 0, 341, 0, 0, 171, 173, 588,
+	0, 0, 0, 599, -2, 0, 337, 551, 137, 138,
+	152, 156, 157, 0, 222, 343, -2, 0, 945, 228,
+	229, 230, 231, 0, 0, 0, -2, 0, 614, 616,
+	130, 0, 0, 575, 540, 0, 0, 0, 0, 0,
+	0, 0, 358, 0, 371, 377, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 49, 61, 74, 69, 68, 51, 0, 50,
-	48, 0, 75, 75, 73, 52, 0, 81, 0, 0,
-	0, 79, 0, 67, 0, 281, 0, 0, 495, 490,
-	491, 517, 544, 545, 546, 547, 548, 867, 870, 888,
-	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
-	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
-	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
-	919, 920, 921, 922, 923, 924, 925, 926, 927, 928,
-	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
-	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
-	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
-	959, 960, 961, 962, 963, 964, 0, 0, 504, 383,
-	0, 392, 0, 435, 0, 535, 0, 0, 431, 439,
-	440, 388, 282, 0, 393, 513, 0, 0, 8, 3,
-	4, 446, 0, 348, -2, -2, 671, 637, -2, 0,
-	358, 508, 103, 27, 0, 28, 29, 30, 31, 32,
-	99, 42, 43, 44, 45, 46, 47, 55, 42, 42,
-	42, 53, 56, 57, 62, 63, 85, 85, 82, 541,
-	542, 75, 75, 75, 70, 0, 75, 104, 13, 0,
-	108, 109, 110, 111, 112, 113, -2, -2, -2, -2,
-	-2, -2, 120, 123, 124, -2, 288, 290, 0, 499,
-	0, -2, 0, 139, 0, 144, 146, 148, 0, 0,
-	162, 0, 0, 498, 158, 478, 0, 0, 457, 458,
-	459, 509, 0, 143, 0, 0, 496, 0, 0, 0,
-	302, 291, 303, 0, 17, 0, 460, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 0,
-	-2, 201, 369, 0, 0, 0, 0, 293, 295, 0,
-	-2, 528, 86, 87, 88, 89, 90, 91, 100, 0,
-	485, 481, 0, 402, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 0, 0, 0, 0, 164, 0, 0, 0,
-	0, 846, 0, 291, 324, 0, 0, 0, 0, 0,
-	291, 0, 330, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 262, 0, 263, 264, 0, 0, 0, 0,
-	0, 0, 71, 72, 0, 0, 0, 0, 0, 0,
-	446, 0, 421, 492, 0, 503, 390, 0, 0, 536,
-	537, 283, 0, 372, 8, 476, 0, 374, 0, 376,
-	0, 279, 280, 10, 0, 359, 0, 360, 360, 360,
-	360, 0, 357, 19, 23, 27, 33, 0, 39, 42,
-	0, 14, 0, 0, -2, -2, 0, 0, 0, 159,
-	0, -2, 141, 145, 147, 149, 0, 0, 163, 0,
-	0, 0, 0, 0, 160, 0, 0, 0, 0, 191,
-	292, 0, 300, 301, 0, 475, 0, -2, 0, 0,
-	368, 369, 369, 369, 207, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 482, 0, 0, 208, 0, 0,
-	0, 0, 213, 215, 217, 219, 0, 227, 0, 228,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 182, 0, 0, 0,
-	166, 167, 181, 0, 0, 230, 322, 323, 0, 0,
-	0, 0, 0, 0, 235, 0, 329, 0, 237, 238,
-	239, 240, 0, 0, 0, 246, 0, 0, 267, 0,
-	0, 0, 0, 41, 54, 85, 83, 84, 85, 77,
-	76, 376, 365, 0, 429, 436, 514, 373, 0, 6,
-	7, 381, 0, 445, 0, 0, 0, 0, 0, 12,
-	350, 351, 0, 0, 0, 0, 356, 0, 0, 20,
-	24, 0, 0, 40, 15, 105, -2, 0, 0, -2,
-	0, 0, -2, 0, 0, 0, -2, 0, 298, 0,
-	0, 479, 0, -2, 0, 452, 0, 497, -2, 292,
-	304, 18, 202, 0, 294, 0, 0, 0, 0, 296,
-	297, 92, 93, 94, 95, 96, 97, 98, 0, 529,
-	480, 484, 0, 400, 401, 405, 0, 0, 210, 0,
-	305, 316, 317, 165, 168, 169, 170, 171, 172, 173,
-	-2, -2, -2, -2, -2, -2, 180, 0, 0, 0,
-	187, 318, 0, 0, 455, 320, 321, 326, 327, 0,
-	232, 233, 234, 328, 0, 241, 0, 245, 268, 567,
-	260, 261, 0, 0, 0, 248, 0, 257, 0, 0,
-	60, 80, 78, 66, 381, 493, 0, 396, 0, 0,
-	274, 0, 0, 0, 11, 352, 353, 354, 355, 25,
-	0, 0, 0, 34, 16, -2, -2, 0, 0, 0,
-	-2, -2, 0, 152, 0, -2, -2, 161, 451, 292,
-	526, 369, 203, 205, 206, 101, 483, 407, 404, 0,
-	447, 226, 315, 0, 0, 0, 188, 0, 292, 454,
-	231, 236, 0, 0, 856, 0, 0, 247, 265, 266,
-	0, 0, 0, 252, 688, 0, 396, 477, 341, 0,
-	380, 375, 377, 379, 275, 276, 0, 0, 26, 21,
-	0, -2, -2, -2, 153, 299, 453, 0, 369, 0,
-	449, -2, 0, 0, 0, 456, 242, 0, 243, 0,
-	269, 270, 249, 250, 0, 253, 251, 342, 395, 397,
-	0, 0, 0, 278, 22, 204, 410, 0, -2, 0,
-	185, 0, 0, 0, 254, 255, 0, 0, 378, 0,
-	272, 0, 0, 0, 406, 186, 244, 258, 271, 256,
-	398, 399, 0, 277, 403, 408, 411, -2, 789, 594,
-	0, 409, 273, 0, 413, 414, 415, 416, 417, 0,
-	412,
+	80, 92, 105, 100, 99, 82, 0, 81, 79, 0,
+	106, 106, 104, 83, 0, 112, 0, 0, 0, 110,
+	0, 98, 0, 308, 0, 0, 584, 579, 580, 606,
+	633, 634, 635, 636, 637, 958, 961, 979, 980, 981,
+	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
+	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
+	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
+	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
+	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
+	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
+	1052, 1053, 1054, 1055, 0, 415, 419, 0, 428, 0,
+	593, 383, 0, 0, 388, 585, 385, 472, 0, 481,
+	522, 0, 0, 525, 0, 624, 0, 0, 521, 529,
+	530, 477, 334, 0, 482, 602, 0, 0, 14, 9,
+	10, 407, 408, 536, 0, 437, -2, -2, 762, 728,
+	-2, 0, 447, 597, 134, 58, 0, 59, 60, 61,
+	62, 63, 130, 73, 74, 75, 76, 77, 78, 86,
+	73, 73, 73, 84, 87, 88, 93, 94, 116, 116,
+	113, 630, 631, 106, 106, 106, 101, 0, 106, 135,
+	44, 0, 139, 140, 141, 142, 143, 144, -2, -2,
+	-2, -2, -2, -2, 151, 154, 155, -2, 340, 342,
+	0, 588, 0, -2, 0, 170, 0, 175, 177, 179,
+	0, 0, 193, 0, 0, 587, 189, 568, 0, 0,
+	547, 548, 549, 598, 0, 174, 0, 0, 586, 585,
+	0, 0, 0, 354, 343, 355, 0, 48, 0, 550,
+	552, 553, 554, 555, 556, 557, 558, 559, 560, 561,
+	562, 563, 0, -2, 234, 458, 0, 0, 0, 0,
+	345, 347, 0, -2, 617, 117, 118, 119, 120, 121,
+	122, 131, 0, 0, 572, 0, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 359, 360, 361,
+	362, 363, 364, 365, 366, 0, 0, 0, 0, 195,
+	0, 0, 0, 0, 937, 0, 343, 376, 0, 0,
+	0, 0, 0, 343, 0, 382, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 295, 0, 296, 297, 0,
+	0, 0, 0, 0, 0, 102, 103, 0, 0, 0,
+	0, 0, 0, 536, 0, 510, 581, 0, 0, 417,
+	0, 426, 0, 592, 0, 0, 0, 390, 479, 515,
+	0, 0, 625, 626, 335, 0, 461, 14, 566, 0,
+	463, 0, 465, 0, 307, 309, 21, 26, 0, 21,
+	317, 0, 30, 834, 604, 0, 0, 448, 0, 449,
+	449, 449, 449, 0, 446, 50, 54, 58, 64, 0,
+	70, 73, 0, 45, 0, 0, -2, -2, 0, 0,
+	0, 190, 0, -2, 172, 176, 178, 180, 0, 0,
+	194, 0, 0, 0, 0, 0, 191, 0, 0, 0,
+	0, 224, 344, 0, 352, 353, 0, 565, 0, -2,
+	0, 0, 457, 458, 458, 458, 240, 0, 0, 0,
+	0, 0, 0, 0, 0, 570, 0, 573, 0, 241,
+	0, 0, 0, 0, 246, 248, 250, 252, 0, 260,
+	0, 261, 0, 0, 262, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
+	0, 0, 197, 198, 212, 0, 0, 263, 374, 375,
+	0, 0, 0, 0, 0, 0, 268, 0, 381, 0,
+	270, 271, 272, 273, 0, 0, 0, 279, 0, 0,
+	300, 0, 0, 0, 0, 72, 85, 116, 114, 115,
+	116, 108, 107, 465, 454, 0, 0, 418, 429, 0,
+	387, 389, 519, 526, 603, 462, 0, 12, 13, 470,
+	0, 535, 0, 0, 0, 0, 0, 331, 331, 331,
+	329, 311, 20, 0, 17, 313, 22, 0, 17, 26,
+	21, 0, 315, 317, -2, 0, 0, 0, -2, 0,
+	439, 440, 0, 0, 0, 0, 445, 0, 0, 51,
+	55, 0, 0, 71, 46, 136, -2, 0, 0, -2,
+	0, 0, -2, 0, 0, 0, -2, 0, 350, 0,
+	0, 569, 0, -2, 0, 542, 0, -2, 344, 356,
+	49, 235, 0, 346, 0, 0, 0, 0, 348, 349,
+	123, 124, 125, 126, 127, 128, 129, 0, 618, 0,
+	0, 489, 490, 494, 0, 0, 243, 0, 357, 368,
+	369, 196, 199, 200, 201, 202, 203, 204, -2, -2,
+	-2, -2, -2, -2, 211, 0, 0, 0, 218, 370,
+	0, 0, 545, 372, 373, 378, 379, 0, 265, 266,
+	267, 380, 0, 274, 0, 278, 301, 657, 293, 294,
+	0, 0, 0, 281, 0, 290, 0, 0, 91, 111,
+	109, 97, 470, 582, 0, 0, 0, 485, 0, 0,
+	310, 0, 0, 0, 0, 0, 326, 330, 327, 328,
+	312, 0, 16, 0, 16, 0, 0, 314, 316, 608,
+	320, 31, 0, 458, 441, 442, 443, 444, 56, 0,
+	0, 0, 65, 47, -2, -2, 0, 0, 0, -2,
+	-2, 0, 183, 0, -2, -2, 192, 541, 344, 615,
+	458, 236, 238, 239, 132, 571, 574, 496, 493, 0,
+	537, 259, 367, 0, 0, 0, 219, 0, 344, 544,
+	264, 269, 0, 0, 947, 0, 0, 280, 298, 299,
+	0, 0, 0, 285, 779, 0, 485, 422, 391, 393,
+	0, 585, 567, 430, 0, 469, 464, 466, 468, 321,
+	0, 323, 0, 0, 0, 325, 0, 0, 0, 305,
+	0, 0, 40, 0, 0, -2, 0, 319, 0, 33,
+	37, 0, 57, 52, 0, -2, -2, -2, 184, 351,
+	543, 0, 458, 0, 539, -2, 0, 0, 0, 546,
+	275, 0, 276, 0, 302, 303, 282, 283, 0, 286,
+	284, 431, 425, 0, 0, 0, 396, 484, 486, 0,
+	0, 322, 0, 333, 324, 0, 0, 19, 0, 0,
+	25, 0, 42, 24, 318, 39, 0, 35, 0, 235,
+	53, 237, 499, 0, -2, 0, 216, 0, 0, 0,
+	287, 288, 416, 0, 0, 0, 394, 395, 0, 0,
+	467, 0, 0, 18, 306, 23, 41, 0, 32, 0,
+	34, 0, 0, 0, 0, 495, 217, 277, 291, 304,
+	289, 0, 0, 0, 487, 488, 332, 29, 43, 38,
+	0, 492, 497, 500, -2, 880, 684, 0, 498, 0,
+	0, 0, 27, 0, 36, 0, 502, 503, 504, 505,
+	506, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 501, 0, 0, 424, 420, 421, 28, 0, 0,
+	0, 458, 0, 0, 423, 0,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 447, 3, 3,
-	452, 453, 445, 443, 456, 444, 454, 446, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 457, 455,
-	439, 441, 440, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 452, 3, 3,
+	457, 458, 450, 448, 461, 449, 459, 451, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 462, 460,
+	444, 446, 445, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 450, 3, 451, 448,
+	3, 455, 3, 456, 453,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -4127,7 +4777,8 @@ var yyTok2 = [...]int{
 	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
 	252, 253, 254, 255, 256, 257,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]uint16{
 	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
 	57605, 263, 57606, 264, 57607, 265, 57608, 266, 57609, 267,
 	57610, 268, 57611, 269, 57612, 270, 57613, 271, 57614, 272,
@@ -4164,7 +4815,8 @@ var yyTok3 = [...]int{
 	57765, 423, 57766, 424, 57767, 425, 57768, 426, 57769, 427,
 	57770, 428, 57771, 429, 57772, 430, 57773, 431, 57774, 432,
 	57775, 433, 57776, 434, 57777, 435, 57778, 436, 57779, 437,
-	57780, 438, 57781, 442, 57782, 449, 0,
+	57780, 438, 57781, 439, 57782, 440, 57783, 441, 57784, 442,
+	57785, 443, 57786, 447, 57787, 454, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4193,18 +4845,17 @@ type yyParser interface {
 }
 
 type yyParserImpl struct {
-	lookahead func() int
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
 }
 
 func (p *yyParserImpl) Lookahead() int {
-	return p.lookahead()
+	return p.char
 }
 
 func yyNewParser() yyParser {
-	p := &yyParserImpl{
-		lookahead: func() int { return -1 },
-	}
-	return p
+	return &yyParserImpl{}
 }
 
 const yyFlag = -1000
@@ -4246,9 +4897,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -4258,13 +4909,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -4295,30 +4946,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -4332,22 +4983,20 @@ func yyParse(yylex yyLexer) int {
 
 func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
 	var yyn int
-	var yylval yySymType
 	var yyVAL yySymType
 	var yyDollar []yySymType
 	_ = yyDollar // silence set and not used
-	yyS := make([]yySymType, yyMaxDepth)
+	yyS := yyrcvr.stack[:]
 
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
 	yystate := 0
-	yychar := -1
-	yytoken := -1 // yychar translated into internal numbering
-	yyrcvr.lookahead = func() int { return yychar }
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
 	defer func() {
 		// Make sure we report no lookahead when not parsing.
 		yystate = -1
-		yychar = -1
+		yyrcvr.char = -1
 		yytoken = -1
 	}()
 	yyp := -1
@@ -4375,22 +5024,22 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
-	if yychar < 0 {
-		yychar, yytoken = yylex1(yylex, &yylval)
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
 	}
 	yyn += yytoken
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
-		yychar = -1
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
 		yytoken = -1
-		yyVAL = yylval
+		yyVAL = yyrcvr.lval
 		yystate = yyn
 		if Errflag > 0 {
 			Errflag--
@@ -4400,27 +5049,27 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
-		if yychar < 0 {
-			yychar, yytoken = yylex1(yylex, &yylval)
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
 		}
 
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -4442,10 +5091,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -4466,7 +5115,7 @@ yydefault:
 			if yytoken == yyEofCode {
 				goto ret1
 			}
-			yychar = -1
+			yyrcvr.char = -1
 			yytoken = -1
 			goto yynewstate /* try again in the same state */
 		}
@@ -4481,7 +5130,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -4492,16 +5141,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -4509,295 +5158,469 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:407
+//line sql.y:457
 		{
-			yyVAL.sqlSelect = yyDollar[1].sqlSelect
-			yylex.(*sqlLex).stmt = yyDollar[1].sqlSelect
+			yylex.(*sqlLex).stmts = yyDollar[1].stmts
 		}
 	case 2:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:412
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:467
 		{
-			yyVAL.sqlSelect = yyDollar[1].sqlSelect
-			yyVAL.sqlSelect.Semicolon = true
-			yylex.(*sqlLex).stmt = yyDollar[1].sqlSelect
+			x := yylex.(*sqlLex)
+			endStmt(yyDollar[1].stmts[len(yyDollar[1].stmts)-1], x.claimCommentsRange(yyDollar[2].pos, yyDollar[2].pos), x.token(yyDollar[2].pos).blankLineAfter)
+			x.dropTokens(yyDollar[2].pos)
+			yyVAL.stmts = x.flushStmts(append(yyDollar[1].stmts, yyDollar[3].stmt))
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:419
+//line sql.y:474
 		{
-			yyVAL.str = "asc"
+			yyVAL.stmts = []Stmt{yyDollar[1].stmt}
 		}
 	case 4:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:482
+		{
+			x := yylex.(*sqlLex)
+			yyVAL.stmts = append(yyDollar[1].stmts[:len(yyDollar[1].stmts)-1], x.recoverStmt())
+		}
+	case 5:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:489
+		{
+			x := yylex.(*sqlLex)
+			yyDollar[1].sqlSelect.Comments = x.claimComments(yyDollar[1].pos)
+			yyVAL.stmt = x.dialectChecked(yyDollar[1].sqlSelect)
+		}
+	case 6:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:495
+		{
+			x := yylex.(*sqlLex)
+			yyDollar[1].insertStmt.Comments = x.claimComments(yyDollar[1].pos)
+			yyVAL.stmt = x.dialectChecked(yyDollar[1].insertStmt)
+		}
+	case 7:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:509
+		{
+			yyVAL.stmt = &EmptyStmt{}
+		}
+	case 8:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:513
+		{
+			x := yylex.(*sqlLex)
+			yyVAL.stmt = x.recoverStmt()
+		}
+	case 9:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:519
+		{
+			yyVAL.str = "asc"
+		}
+	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:420
+//line sql.y:520
 		{
 			yyVAL.str = "desc"
 		}
-	case 5:
+	case 11:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:421
+//line sql.y:521
 		{
 			yyVAL.str = ""
 		}
-	case 6:
+	case 12:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:424
+//line sql.y:524
 		{
 			yyVAL.str = "first"
 		}
-	case 7:
+	case 13:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:425
+//line sql.y:525
 		{
 			yyVAL.str = "last"
 		}
-	case 8:
+	case 14:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:426
+//line sql.y:526
 		{
 			yyVAL.str = ""
 		}
-	case 10:
+	case 16:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:535
+		{
+			yyVAL.tableAlias = tableAlias{name: yyDollar[2].str}
+		}
+	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:435
+//line sql.y:539
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.tableAlias = tableAlias{name: yyDollar[1].str}
 		}
-	case 11:
+	case 18:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:543
+		{
+			yyVAL.tableAlias = tableAlias{name: yyDollar[2].str, columns: yyDollar[4].identifiers}
+		}
+	case 19:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:547
+		{
+			yyVAL.tableAlias = tableAlias{name: yyDollar[1].str, columns: yyDollar[3].identifiers}
+		}
+	case 21:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:554
+		{
+			yyVAL.tableAlias = tableAlias{}
+		}
+	case 23:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:565
+		{
+			yyVAL.tableAlias = tableAlias{name: yyDollar[2].str, columnDefs: yyDollar[4].columnDefs}
+		}
+	case 24:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:569
+		{
+			yyVAL.tableAlias = tableAlias{name: yyDollar[1].str, columnDefs: yyDollar[3].columnDefs}
+		}
+	case 25:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.tableAlias = tableAlias{columnDefs: yyDollar[3].columnDefs}
+		}
+	case 26:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:577
+		{
+			yyVAL.tableAlias = tableAlias{}
+		}
+	case 27:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:583
+		{
+			yyVAL.tableSample = TableSample{Method: yyDollar[2].anyName, Args: yyDollar[4].fields, Repeatable: yyDollar[6].expr}
+		}
+	case 28:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:588
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 29:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:589
+		{
+			yyVAL.expr = nil
+		}
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:439
+//line sql.y:598
 		{
-			yyVAL.expr = AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
+			yyVAL.expr = FuncTable{Func: yyDollar[1].expr, Ordinality: true}
 		}
-	case 12:
+	case 32:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:602
+		{
+			yyVAL.expr = FuncTable{RowsFrom: yyDollar[4].rowsFromItems, Ordinality: yyDollar[6].boolean}
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:608
+		{
+			yyVAL.rowsFromItems = []RowsFromItem{yyDollar[1].rowsFromItem}
+		}
+	case 34:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:612
+		{
+			yyVAL.rowsFromItems = append(yyDollar[1].rowsFromItems, yyDollar[3].rowsFromItem)
+		}
+	case 35:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:443
+//line sql.y:618
 		{
-			yyVAL.expr = AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[2].str}
+			yyVAL.rowsFromItem = RowsFromItem{Func: yyDollar[1].expr, ColumnDefs: yyDollar[2].columnDefs}
 		}
-	case 13:
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:623
+		{
+			yyVAL.columnDefs = yyDollar[3].columnDefs
+		}
+	case 37:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:624
+		{
+			yyVAL.columnDefs = nil
+		}
+	case 38:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:627
+		{
+			yyVAL.boolean = true
+		}
+	case 39:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:628
+		{
+			yyVAL.boolean = false
+		}
+	case 40:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:632
+		{
+			yyVAL.columnDefs = []ColumnDef{yyDollar[1].columnDef}
+		}
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:636
+		{
+			yyVAL.columnDefs = append(yyDollar[1].columnDefs, yyDollar[3].columnDef)
+		}
+	case 42:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:642
+		{
+			yyVAL.columnDef = ColumnDef{Name: yyDollar[1].str, Type: yyDollar[2].pgType}
+		}
+	case 43:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:646
+		{
+			yyVAL.columnDef = ColumnDef{Name: yyDollar[1].str, Type: yyDollar[2].pgType, Collation: yyDollar[4].anyName}
+		}
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:450
+//line sql.y:653
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 14:
+	case 45:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:454
+//line sql.y:657
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 			yyVAL.anyName = append(yyVAL.anyName, yyDollar[2].anyName...)
 		}
-	case 15:
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:461
+//line sql.y:664
 		{
 			yyVAL.anyName = AnyName{yyDollar[2].str}
 		}
-	case 16:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:465
+//line sql.y:668
 		{
 			yyVAL.anyName = append(yyDollar[1].anyName, yyDollar[3].str)
 		}
-	case 17:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:471
+//line sql.y:674
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 18:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:475
+//line sql.y:678
 		{
 			yyVAL.anyName = append(AnyName{yyDollar[1].str}, yyDollar[3].anyName...)
 		}
-	case 19:
+	case 50:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:491
+//line sql.y:694
 		{
 			yyVAL.pgType = yyDollar[1].pgType
 			yyVAL.pgType.ArrayBounds = yyDollar[2].optArrayBounds
 		}
-	case 20:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:496
+//line sql.y:699
 		{
 			yyVAL.pgType = yyDollar[2].pgType
 			yyVAL.pgType.Setof = true
 			yyVAL.pgType.ArrayBounds = yyDollar[3].optArrayBounds
 		}
-	case 21:
+	case 52:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:503
+//line sql.y:706
 		{
 			yyVAL.pgType = yyDollar[1].pgType
 			yyVAL.pgType.ArrayWord = true
 			yyVAL.pgType.ArrayBounds = []IntegerConst{yyDollar[4].iconst}
 		}
-	case 22:
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:509
+//line sql.y:712
 		{
 			yyVAL.pgType = yyDollar[2].pgType
 			yyVAL.pgType.Setof = true
 			yyVAL.pgType.ArrayWord = true
 			yyVAL.pgType.ArrayBounds = []IntegerConst{yyDollar[5].iconst}
 		}
-	case 23:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:516
+//line sql.y:719
 		{
 			yyVAL.pgType = yyDollar[1].pgType
 			yyVAL.pgType.ArrayWord = true
 		}
-	case 24:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:521
+//line sql.y:724
 		{
 			yyVAL.pgType = yyDollar[2].pgType
 			yyVAL.pgType.Setof = true
 			yyVAL.pgType.ArrayWord = true
 		}
-	case 25:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:529
+//line sql.y:732
 		{
 			yyVAL.optArrayBounds = append(yyDollar[1].optArrayBounds, "")
 		}
-	case 26:
+	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:533
+//line sql.y:736
 		{
 			yyVAL.optArrayBounds = append(yyDollar[1].optArrayBounds, yyDollar[3].iconst)
 		}
-	case 27:
+	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:537
+//line sql.y:740
 		{
 			yyVAL.optArrayBounds = nil
 		}
-	case 33:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:546
+//line sql.y:749
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"interval"}, OptInterval: yyDollar[2].optInterval}
 		}
-	case 34:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:550
+//line sql.y:753
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"interval"}, TypeMods: []Expr{yyDollar[3].iconst}}
 		}
-	case 39:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:581
+//line sql.y:784
 		{
 			yyVAL.pgType = PgType{Name: AnyName{yyDollar[1].str}, TypeMods: yyDollar[2].fields}
 		}
-	case 40:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:585
+//line sql.y:788
 		{
 			yyVAL.pgType = PgType{Name: append(AnyName{yyDollar[1].str}, yyDollar[2].anyName...), TypeMods: yyDollar[3].fields}
 		}
-	case 41:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:590
+//line sql.y:793
 		{
 			yyVAL.fields = yyDollar[2].fields
 		}
-	case 42:
+	case 73:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:591
+//line sql.y:794
 		{
 			yyVAL.fields = nil
 		}
-	case 43:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:598
+//line sql.y:801
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"int"}}
 		}
-	case 44:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:602
+//line sql.y:805
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"integer"}}
 		}
-	case 45:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:606
+//line sql.y:809
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"smallint"}}
 		}
-	case 46:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:610
+//line sql.y:813
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"bigint"}}
 		}
-	case 47:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:614
+//line sql.y:817
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"real"}}
 		}
-	case 48:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:618
+//line sql.y:821
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"float"}}
 			if yyDollar[2].iconst != IntegerConst("") {
 				yyVAL.pgType.TypeMods = []Expr{yyDollar[2].iconst}
 			}
 		}
-	case 49:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:625
+//line sql.y:828
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"double precision"}}
 		}
-	case 50:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:629
+//line sql.y:832
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"decimal"}, TypeMods: yyDollar[2].fields}
 		}
-	case 51:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:633
+//line sql.y:836
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"dec"}, TypeMods: yyDollar[2].fields}
 		}
-	case 52:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:637
+//line sql.y:840
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"numeric"}, TypeMods: yyDollar[2].fields}
 		}
-	case 53:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:641
+//line sql.y:844
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"bool"}}
 		}
-	case 54:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:647
+//line sql.y:850
 		{
 			yyVAL.iconst = yyDollar[2].iconst
 		}
-	case 55:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:651
+//line sql.y:854
 		{
 			yyVAL.iconst = IntegerConst("")
 		}
-	case 60:
+	case 91:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:665
+//line sql.y:868
 		{
 			yyVAL.pgType = PgType{}
 			if yyDollar[2].boolean {
@@ -4807,9 +5630,9 @@ yydefault:
 			}
 			yyVAL.pgType.TypeMods = yyDollar[4].fields
 		}
-	case 61:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:677
+//line sql.y:880
 		{
 			yyVAL.pgType = PgType{}
 			if yyDollar[2].boolean {
@@ -4818,24 +5641,24 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"bit"}}
 			}
 		}
-	case 66:
+	case 97:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:700
+//line sql.y:903
 		{
 			yyVAL.pgType = yyDollar[1].pgType
 			yyVAL.pgType.TypeMods = []Expr{yyDollar[3].iconst}
 			yyVAL.pgType.CharSet = yyDollar[5].str
 		}
-	case 67:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:708
+//line sql.y:911
 		{
 			yyVAL.pgType = yyDollar[1].pgType
 			yyVAL.pgType.CharSet = yyDollar[2].str
 		}
-	case 68:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:715
+//line sql.y:918
 		{
 			if yyDollar[2].boolean {
 				yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
@@ -4843,9 +5666,9 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"char"}}
 			}
 		}
-	case 69:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:723
+//line sql.y:926
 		{
 			if yyDollar[2].boolean {
 				yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
@@ -4853,15 +5676,15 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"char"}}
 			}
 		}
-	case 70:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:731
+//line sql.y:934
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
 		}
-	case 71:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:735
+//line sql.y:938
 		{
 			if yyDollar[3].boolean {
 				yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
@@ -4869,9 +5692,9 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"char"}}
 			}
 		}
-	case 72:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:743
+//line sql.y:946
 		{
 			if yyDollar[3].boolean {
 				yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
@@ -4879,9 +5702,9 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"char"}}
 			}
 		}
-	case 73:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:751
+//line sql.y:954
 		{
 			if yyDollar[2].boolean {
 				yyVAL.pgType = PgType{Name: AnyName{"varchar"}}
@@ -4889,936 +5712,1062 @@ yydefault:
 				yyVAL.pgType = PgType{Name: AnyName{"char"}}
 			}
 		}
-	case 74:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:761
+//line sql.y:964
 		{
 			yyVAL.boolean = true
 		}
-	case 75:
+	case 106:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:765
+//line sql.y:968
 		{
 			yyVAL.boolean = false
 		}
-	case 76:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:771
+//line sql.y:974
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 77:
+	case 108:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:775
+//line sql.y:978
 		{
 			yyVAL.str = ""
 		}
-	case 78:
+	case 109:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:784
+//line sql.y:987
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"timestamp"}, TypeMods: []Expr{yyDollar[3].iconst}, WithTimeZone: yyDollar[5].boolean}
 		}
-	case 79:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:788
+//line sql.y:991
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"timestamp"}, WithTimeZone: yyDollar[2].boolean}
 		}
-	case 80:
+	case 111:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:792
+//line sql.y:995
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"time"}, TypeMods: []Expr{yyDollar[3].iconst}, WithTimeZone: yyDollar[5].boolean}
 		}
-	case 81:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:796
+//line sql.y:999
 		{
 			yyVAL.pgType = PgType{Name: AnyName{"time"}, WithTimeZone: yyDollar[2].boolean}
 		}
-	case 83:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:805
+//line sql.y:1008
 		{
 			yyVAL.boolean = true
 		}
-	case 84:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:809
+//line sql.y:1012
 		{
 			yyVAL.boolean = false
 		}
-	case 85:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:813
+//line sql.y:1016
 		{
 			yyVAL.boolean = false
 		}
-	case 86:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:819
+//line sql.y:1022
 		{
 			yyVAL.optInterval = &OptInterval{Left: "year"}
 		}
-	case 87:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:823
+//line sql.y:1026
 		{
 			yyVAL.optInterval = &OptInterval{Left: "month"}
 		}
-	case 88:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:827
+//line sql.y:1030
 		{
 			yyVAL.optInterval = &OptInterval{Left: "day"}
 		}
-	case 89:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:831
+//line sql.y:1034
 		{
 			yyVAL.optInterval = &OptInterval{Left: "hour"}
 		}
-	case 90:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:835
+//line sql.y:1038
 		{
 			yyVAL.optInterval = &OptInterval{Left: "minute"}
 		}
-	case 91:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:839
+//line sql.y:1042
 		{
 			yyVAL.optInterval = &OptInterval{Second: yyDollar[1].intervalSecond}
 		}
-	case 92:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:843
+//line sql.y:1046
 		{
 			yyVAL.optInterval = &OptInterval{Left: "year", Right: "month"}
 		}
-	case 93:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:847
+//line sql.y:1050
 		{
 			yyVAL.optInterval = &OptInterval{Left: "day", Right: "hour"}
 		}
-	case 94:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:851
+//line sql.y:1054
 		{
 			yyVAL.optInterval = &OptInterval{Left: "day", Right: "minute"}
 		}
-	case 95:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:855
+//line sql.y:1058
 		{
 			yyVAL.optInterval = &OptInterval{Left: "day", Second: yyDollar[3].intervalSecond}
 		}
-	case 96:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:859
+//line sql.y:1062
 		{
 			yyVAL.optInterval = &OptInterval{Left: "hour", Right: "minute"}
 		}
-	case 97:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:863
+//line sql.y:1066
 		{
 			yyVAL.optInterval = &OptInterval{Left: "hour", Second: yyDollar[3].intervalSecond}
 		}
-	case 98:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:867
+//line sql.y:1070
 		{
 			yyVAL.optInterval = &OptInterval{Left: "minute", Second: yyDollar[3].intervalSecond}
 		}
-	case 99:
+	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:871
+//line sql.y:1074
 		{
 			yyVAL.optInterval = nil
 		}
-	case 100:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:877
+//line sql.y:1080
 		{
 			yyVAL.intervalSecond = &IntervalSecond{}
 		}
-	case 101:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:881
+//line sql.y:1084
 		{
 			yyVAL.intervalSecond = &IntervalSecond{Precision: yyDollar[3].iconst}
 		}
-	case 103:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:917
+//line sql.y:1120
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TypecastExpr{Expr: yyDollar[1].expr, Typename: yyDollar[3].pgType}
 		}
-	case 104:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:921
+//line sql.y:1125
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = CollateExpr{Expr: yyDollar[1].expr, Collation: yyDollar[3].anyName}
 		}
-	case 105:
+	case 136:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:925
+//line sql.y:1130
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = AtTimeZoneExpr{Expr: yyDollar[1].expr, TimeZone: yyDollar[5].expr}
 		}
-	case 106:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:938
+//line sql.y:1144
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: AnyName{"+"}, Expr: yyDollar[2].expr}
 		}
-	case 107:
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:942
+//line sql.y:1149
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: AnyName{"-"}, Expr: yyDollar[2].expr}
 		}
-	case 108:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:946
+//line sql.y:1154
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"+"}, Right: yyDollar[3].expr}
 		}
-	case 109:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:950
+//line sql.y:1159
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"-"}, Right: yyDollar[3].expr}
 		}
-	case 110:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:954
+//line sql.y:1164
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"*"}, Right: yyDollar[3].expr}
 		}
-	case 111:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:958
+//line sql.y:1169
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"/"}, Right: yyDollar[3].expr}
 		}
-	case 112:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:962
+//line sql.y:1174
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"%"}, Right: yyDollar[3].expr}
 		}
-	case 113:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:966
+//line sql.y:1179
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"^"}, Right: yyDollar[3].expr}
 		}
-	case 114:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:970
+//line sql.y:1184
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"<"}, Right: yyDollar[3].expr}
 		}
-	case 115:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:974
+//line sql.y:1189
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{">"}, Right: yyDollar[3].expr}
 		}
-	case 116:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:978
+//line sql.y:1194
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"="}, Right: yyDollar[3].expr}
 		}
-	case 117:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:982
+//line sql.y:1199
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"<="}, Right: yyDollar[3].expr}
 		}
-	case 118:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:986
+//line sql.y:1204
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{">="}, Right: yyDollar[3].expr}
 		}
-	case 119:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:990
+//line sql.y:1209
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"!="}, Right: yyDollar[3].expr}
 		}
-	case 120:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:994
+//line sql.y:1214
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].anyName, Right: yyDollar[3].expr}
 		}
-	case 121:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:998
+//line sql.y:1219
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: yyDollar[1].anyName, Expr: yyDollar[2].expr}
 		}
-	case 122:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1002
+//line sql.y:1224
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = PostfixExpr{Expr: yyDollar[1].expr, Operator: yyDollar[2].anyName}
 		}
-	case 123:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1006
+//line sql.y:1229
 		{
-			yyVAL.expr = BooleanExpr{Left: yyDollar[1].expr, Operator: "and", Right: yyDollar[3].expr}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			left := yylex.(*sqlLex).commentedRange(yyDollar[1].pos, yyDollar[2].pos-1, yyDollar[1].expr)
+			right := yylex.(*sqlLex).commented(yyDollar[3].pos, yyDollar[3].expr)
+			yyVAL.expr = BooleanExpr{Left: left, Operator: "and", Right: right}
 		}
-	case 124:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1010
+//line sql.y:1236
 		{
-			yyVAL.expr = BooleanExpr{Left: yyDollar[1].expr, Operator: "or", Right: yyDollar[3].expr}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			left := yylex.(*sqlLex).commentedRange(yyDollar[1].pos, yyDollar[2].pos-1, yyDollar[1].expr)
+			right := yylex.(*sqlLex).commented(yyDollar[3].pos, yyDollar[3].expr)
+			yyVAL.expr = BooleanExpr{Left: left, Operator: "or", Right: right}
 		}
-	case 125:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1014
+//line sql.y:1243
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 126:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1018
+//line sql.y:1248
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 127:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1022
+//line sql.y:1253
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "like", Right: yyDollar[3].expr}
 		}
-	case 128:
+	case 159:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1026
+//line sql.y:1258
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "like", Right: yyDollar[3].expr, Escape: yyDollar[5].expr}
 		}
-	case 129:
+	case 160:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1030
+//line sql.y:1263
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not like", Right: yyDollar[4].expr}
 		}
-	case 130:
+	case 161:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1034
+//line sql.y:1268
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not like", Right: yyDollar[4].expr, Escape: yyDollar[6].expr}
 		}
-	case 131:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1038
+//line sql.y:1273
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "ilike", Right: yyDollar[3].expr}
 		}
-	case 132:
+	case 163:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1042
+//line sql.y:1278
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "ilike", Right: yyDollar[3].expr, Escape: yyDollar[5].expr}
 		}
-	case 133:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1046
+//line sql.y:1283
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not ilike", Right: yyDollar[4].expr}
 		}
-	case 134:
+	case 165:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1050
+//line sql.y:1288
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not ilike", Right: yyDollar[4].expr, Escape: yyDollar[6].expr}
 		}
-	case 135:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1055
+//line sql.y:1294
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "similar to", Right: yyDollar[4].expr}
 		}
-	case 136:
+	case 167:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1059
+//line sql.y:1299
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "similar to", Right: yyDollar[4].expr, Escape: yyDollar[6].expr}
 		}
-	case 137:
+	case 168:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1063
+//line sql.y:1304
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not similar to", Right: yyDollar[5].expr}
 		}
-	case 138:
+	case 169:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1067
+//line sql.y:1309
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TextOpWithEscapeExpr{Left: yyDollar[1].expr, Operator: "not similar to", Right: yyDollar[5].expr, Escape: yyDollar[7].expr}
 		}
-	case 139:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1080
+//line sql.y:1323
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "null"}
 		}
-	case 140:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1084
+//line sql.y:1328
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "null"}
 		}
-	case 141:
+	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1088
+//line sql.y:1333
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "null"}
 		}
-	case 142:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1092
+//line sql.y:1338
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "null"}
 		}
-	case 143:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1096
+//line sql.y:1343
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].row, Operator: AnyName{"overlaps"}, Right: yyDollar[3].row}
 		}
-	case 144:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1100
+//line sql.y:1348
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "true"}
 		}
-	case 145:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1104
+//line sql.y:1353
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "true"}
 		}
-	case 146:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1108
+//line sql.y:1358
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "false"}
 		}
-	case 147:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1112
+//line sql.y:1363
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "false"}
 		}
-	case 148:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1116
+//line sql.y:1368
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "unknown"}
 		}
-	case 149:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1120
+//line sql.y:1373
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "unknown"}
 		}
-	case 150:
+	case 181:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1124
+//line sql.y:1378
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"is distinct from"}, Right: yyDollar[5].expr}
 		}
-	case 151:
+	case 182:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1128
+//line sql.y:1383
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"is not distinct from"}, Right: yyDollar[6].expr}
 		}
-	case 152:
+	case 183:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1132
+//line sql.y:1388
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsOfExpr{Expr: yyDollar[1].expr, Types: yyDollar[5].pgTypes}
 		}
-	case 153:
+	case 184:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1136
+//line sql.y:1393
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsOfExpr{Expr: yyDollar[1].expr, Not: true, Types: yyDollar[6].pgTypes}
 		}
-	case 154:
+	case 185:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1140
+//line sql.y:1398
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BetweenExpr{Expr: yyDollar[1].expr, Left: yyDollar[4].expr, Right: yyDollar[6].expr}
 		}
-	case 155:
+	case 186:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1144
+//line sql.y:1403
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BetweenExpr{Expr: yyDollar[1].expr, Not: true, Left: yyDollar[5].expr, Right: yyDollar[7].expr}
 		}
-	case 156:
+	case 187:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1148
+//line sql.y:1408
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BetweenExpr{Expr: yyDollar[1].expr, Symmetric: true, Left: yyDollar[4].expr, Right: yyDollar[6].expr}
 		}
-	case 157:
+	case 188:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1152
+//line sql.y:1413
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BetweenExpr{Expr: yyDollar[1].expr, Not: true, Symmetric: true, Left: yyDollar[5].expr, Right: yyDollar[7].expr}
 		}
-	case 158:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1156
+//line sql.y:1418
 		{
-			yyVAL.expr = InExpr{Value: yyDollar[1].expr, In: yyDollar[3].expr}
+			x := yylex.(*sqlLex)
+			x.nest(yyDollar[1].pos)
+			yyVAL.expr = InExpr{Value: yyDollar[1].expr, In: withParenComments(yyDollar[3].expr, x.claimTokenComments(yyDollar[2].pos))}
 		}
-	case 159:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1160
+//line sql.y:1424
 		{
-			yyVAL.expr = InExpr{Value: yyDollar[1].expr, Not: true, In: yyDollar[4].expr}
+			x := yylex.(*sqlLex)
+			x.nest(yyDollar[1].pos)
+			yyVAL.expr = InExpr{Value: yyDollar[1].expr, Not: true, In: withParenComments(yyDollar[4].expr, x.claimTokenComments(yyDollar[2].pos, yyDollar[3].pos))}
 		}
-	case 160:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1164
+//line sql.y:1430
 		{
-			yyVAL.expr = SubqueryOpExpr{Value: yyDollar[1].expr, Op: yyDollar[2].subqueryOp, Type: yyDollar[3].str, Query: yyDollar[4].sqlSelect}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			c := yylex.(*sqlLex).claimCommentsRange(yyDollar[2].pos, yyDollar[4].pos-1)
+			yyVAL.expr = SubqueryOpExpr{Value: yyDollar[1].expr, Op: yyDollar[2].subqueryOp, Type: yyDollar[3].str, Query: withParenComments(yyDollar[4].sqlSelect, c)}
 		}
-	case 161:
+	case 192:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1168
+//line sql.y:1436
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = SubqueryOpExpr{Value: yyDollar[1].expr, Op: yyDollar[2].subqueryOp, Type: yyDollar[3].str, Query: ParenExpr{Expr: yyDollar[5].expr}}
 		}
-	case 162:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1172
+//line sql.y:1441
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "document"}
 		}
-	case 163:
+	case 194:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1176
+//line sql.y:1446
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "document"}
 		}
-	case 164:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1191
+//line sql.y:1462
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 165:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1195
+//line sql.y:1467
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = TypecastExpr{Expr: yyDollar[1].expr, Typename: yyDollar[3].pgType}
 		}
-	case 166:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1199
+//line sql.y:1472
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: AnyName{"+"}, Expr: yyDollar[2].expr}
 		}
-	case 167:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1203
+//line sql.y:1477
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: AnyName{"-"}, Expr: yyDollar[2].expr}
 		}
-	case 168:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1207
+//line sql.y:1482
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"+"}, Right: yyDollar[3].expr}
 		}
-	case 169:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1211
+//line sql.y:1487
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"-"}, Right: yyDollar[3].expr}
 		}
-	case 170:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1215
+//line sql.y:1492
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"*"}, Right: yyDollar[3].expr}
 		}
-	case 171:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1219
+//line sql.y:1497
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"/"}, Right: yyDollar[3].expr}
 		}
-	case 172:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1223
+//line sql.y:1502
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"%"}, Right: yyDollar[3].expr}
 		}
-	case 173:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1227
+//line sql.y:1507
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"^"}, Right: yyDollar[3].expr}
 		}
-	case 174:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1231
+//line sql.y:1512
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"<"}, Right: yyDollar[3].expr}
 		}
-	case 175:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1235
+//line sql.y:1517
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{">"}, Right: yyDollar[3].expr}
 		}
-	case 176:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1239
+//line sql.y:1522
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"="}, Right: yyDollar[3].expr}
 		}
-	case 177:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1243
+//line sql.y:1527
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"<="}, Right: yyDollar[3].expr}
 		}
-	case 178:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1247
+//line sql.y:1532
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{">="}, Right: yyDollar[3].expr}
 		}
-	case 179:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1251
+//line sql.y:1537
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"!="}, Right: yyDollar[3].expr}
 		}
-	case 180:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1255
+//line sql.y:1542
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].anyName, Right: yyDollar[3].expr}
 		}
-	case 181:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1259
+//line sql.y:1547
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = UnaryExpr{Operator: yyDollar[1].anyName, Expr: yyDollar[2].expr}
 		}
-	case 182:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1263
+//line sql.y:1552
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = PostfixExpr{Expr: yyDollar[1].expr, Operator: yyDollar[2].anyName}
 		}
-	case 183:
+	case 214:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1267
+//line sql.y:1557
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"is distinct from"}, Right: yyDollar[5].expr}
 		}
-	case 184:
+	case 215:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1271
+//line sql.y:1562
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = BinaryExpr{Left: yyDollar[1].expr, Operator: AnyName{"is not distinct from"}, Right: yyDollar[6].expr}
 		}
-	case 185:
+	case 216:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1275
+//line sql.y:1567
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsOfExpr{Expr: yyDollar[1].expr, Types: yyDollar[5].pgTypes}
 		}
-	case 186:
+	case 217:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1279
+//line sql.y:1572
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsOfExpr{Expr: yyDollar[1].expr, Not: true, Types: yyDollar[6].pgTypes}
 		}
-	case 187:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1283
+//line sql.y:1577
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Op: "document"}
 		}
-	case 188:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1287
+//line sql.y:1582
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = IsExpr{Expr: yyDollar[1].expr, Not: true, Op: "document"}
 		}
-	case 189:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1300
+//line sql.y:1604
 		{
-			yyVAL.expr = yyDollar[1].columnRef
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].columnRef)
 		}
-	case 190:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1301
+//line sql.y:1609
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 191:
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1614
+		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, NewParamRef(yyDollar[1].str, yyDollar[2].indirection))
+		}
+	case 223:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1619
+		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, Placeholder{Style: yylex.(*sqlLex).placeholders, Text: yyDollar[1].str})
+		}
+	case 224:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1309
+//line sql.y:1625
 		{
-			yyVAL.expr = ParenExpr{Expr: yyDollar[2].expr, Indirection: yyDollar[4].indirection}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, ParenExpr{Expr: yyDollar[2].expr, Indirection: yyDollar[4].indirection})
 		}
-	case 192:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1312
+//line sql.y:1630
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 193:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1313
+//line sql.y:1635
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 194:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1315
+//line sql.y:1640
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyVAL.expr = yyDollar[1].sqlSelect
 		}
-	case 195:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1319
+//line sql.y:1645
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			yyDollar[1].sqlSelect.ParenWrapped = false
-			yyVAL.expr = ParenExpr{Expr: yyDollar[1].sqlSelect, Indirection: yyDollar[2].indirection}
+			e := withComments(ParenExpr{Expr: yyDollar[1].sqlSelect, Indirection: yyDollar[2].indirection}, yyDollar[1].sqlSelect.ParenComments)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, e)
 		}
-	case 196:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1324
+//line sql.y:1652
 		{
-			yyVAL.expr = ExistsExpr(*yyDollar[2].sqlSelect)
+			x := yylex.(*sqlLex)
+			x.nest(yyDollar[1].pos)
+			c := x.claimTokenComments(yyDollar[1].pos)
+			yyDollar[2].sqlSelect.ParenComments.Trailing = append(c.Trailing, yyDollar[2].sqlSelect.ParenComments.Trailing...)
+			yyVAL.expr = withComments(ExistsExpr(*yyDollar[2].sqlSelect), Comments{Leading: c.Leading})
 		}
-	case 197:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1328
+//line sql.y:1660
 		{
-			yyVAL.expr = ArraySubselect(*yyDollar[2].sqlSelect)
+			x := yylex.(*sqlLex)
+			x.nest(yyDollar[1].pos)
+			c := x.claimTokenComments(yyDollar[1].pos)
+			yyDollar[2].sqlSelect.ParenComments.Trailing = append(c.Trailing, yyDollar[2].sqlSelect.ParenComments.Trailing...)
+			yyVAL.expr = withComments(ArraySubselect(*yyDollar[2].sqlSelect), Comments{Leading: c.Leading})
 		}
-	case 198:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1331
+//line sql.y:1667
 		{
-			yyVAL.expr = ArrayConstructorExpr(yyDollar[2].arrayExpr)
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, ArrayConstructorExpr(yyDollar[2].arrayExpr))
 		}
-	case 199:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1335
+//line sql.y:1672
 		{
-			yyVAL.expr = yyDollar[1].row
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].row)
 		}
-	case 200:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1339
+//line sql.y:1677
 		{
-			yyVAL.expr = yyDollar[1].row
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].row)
 		}
-	case 201:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1350
+//line sql.y:1689
 		{
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName}
 		}
-	case 202:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1354
+//line sql.y:1693
 		{
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, Args: yyDollar[3].funcArgs, OrderClause: yyDollar[4].orderClause}
 		}
-	case 203:
+	case 236:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1358
+//line sql.y:1697
 		{
 			va := yyDollar[4].funcArg
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, VariadicArg: &va, OrderClause: yyDollar[5].orderClause}
 		}
-	case 204:
+	case 237:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1363
+//line sql.y:1702
 		{
 			va := yyDollar[6].funcArg
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, Args: yyDollar[3].funcArgs, VariadicArg: &va, OrderClause: yyDollar[7].orderClause}
 		}
-	case 205:
+	case 238:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1368
+//line sql.y:1707
 		{
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, Args: yyDollar[4].funcArgs, OrderClause: yyDollar[5].orderClause}
 		}
-	case 206:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1372
+//line sql.y:1711
 		{
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, Distinct: true, Args: yyDollar[4].funcArgs, OrderClause: yyDollar[5].orderClause}
 		}
-	case 207:
+	case 240:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1376
+//line sql.y:1715
 		{
 			yyVAL.funcApplication = FuncApplication{Name: yyDollar[1].anyName, Star: true}
 		}
-	case 208:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1392
+//line sql.y:1731
 		{
 			yyVAL.expr = &FuncExpr{FuncApplication: yyDollar[1].funcApplication, WithinGroupClause: yyDollar[2].withinGroupClause, FilterClause: yyDollar[3].filterClause, OverClause: yyDollar[4].overClause}
 		}
-	case 209:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1396
+//line sql.y:1735
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 210:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1409
+//line sql.y:1748
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"collation for"}, Args: []FuncArg{{Expr: yyDollar[4].expr}}}
 		}
-	case 211:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1413
+//line sql.y:1752
 		{
 			yyVAL.expr = FuncExprNoParens("current_date")
 		}
-	case 212:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1417
+//line sql.y:1756
 		{
 			yyVAL.expr = FuncExprNoParens("current_time")
 		}
-	case 213:
+	case 246:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1421
+//line sql.y:1760
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"current_time"}, Args: []FuncArg{{Expr: yyDollar[3].iconst}}}
 		}
-	case 214:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1425
+//line sql.y:1764
 		{
 			yyVAL.expr = FuncExprNoParens("current_timestamp")
 		}
-	case 215:
+	case 248:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1429
+//line sql.y:1768
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"current_timestamp"}, Args: []FuncArg{{Expr: yyDollar[3].iconst}}}
 		}
-	case 216:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1433
+//line sql.y:1772
 		{
 			yyVAL.expr = FuncExprNoParens("localtime")
 		}
-	case 217:
+	case 250:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1437
+//line sql.y:1776
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"localtime"}, Args: []FuncArg{{Expr: yyDollar[3].iconst}}}
 		}
-	case 218:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1441
+//line sql.y:1780
 		{
 			yyVAL.expr = FuncExprNoParens("localtimestamp")
 		}
-	case 219:
+	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1445
+//line sql.y:1784
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"localtimestamp"}, Args: []FuncArg{{Expr: yyDollar[3].iconst}}}
 		}
-	case 220:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1449
+//line sql.y:1788
 		{
 			yyVAL.expr = FuncExprNoParens("current_role")
 		}
-	case 221:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1453
+//line sql.y:1792
 		{
 			yyVAL.expr = FuncExprNoParens("current_user")
 		}
-	case 222:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1457
+//line sql.y:1796
 		{
 			yyVAL.expr = FuncExprNoParens("session_user")
 		}
-	case 223:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1461
+//line sql.y:1800
 		{
 			yyVAL.expr = FuncExprNoParens("user")
 		}
-	case 224:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1465
+//line sql.y:1804
 		{
 			yyVAL.expr = FuncExprNoParens("current_catalog")
 		}
-	case 225:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1469
+//line sql.y:1808
 		{
 			yyVAL.expr = FuncExprNoParens("current_schema")
 		}
-	case 226:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1473
+//line sql.y:1812
 		{
 			yyVAL.expr = CastFunc{Name: "cast", Expr: yyDollar[3].expr, Type: yyDollar[5].pgType}
 		}
-	case 227:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1477
+//line sql.y:1816
 		{
 			yyVAL.expr = ExtractExpr(*yyDollar[3].extractList)
 		}
-	case 228:
+	case 261:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1481
+//line sql.y:1820
 		{
 			yyVAL.expr = OverlayExpr(yyDollar[3].overlayList)
 		}
-	case 229:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1485
+//line sql.y:1824
 		{
 			yyVAL.expr = PositionExpr(*yyDollar[3].positionList)
 		}
-	case 230:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1489
+//line sql.y:1828
 		{
 			if yyDollar[3].placeholder == nil {
 				yyVAL.expr = FuncApplication{Name: AnyName{"substring"}}
@@ -5832,45 +6781,45 @@ yydefault:
 				yyVAL.expr = FuncApplication{Name: AnyName{"substring"}, Args: args}
 			}
 		}
-	case 231:
+	case 264:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1503
+//line sql.y:1842
 		{
 			yyVAL.expr = CastFunc{Name: "treat", Expr: yyDollar[3].expr, Type: yyDollar[5].pgType}
 		}
-	case 232:
+	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1507
+//line sql.y:1846
 		{
 			yyVAL.expr = TrimExpr{Direction: "both", TrimList: yyDollar[4].trimList}
 		}
-	case 233:
+	case 266:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1511
+//line sql.y:1850
 		{
 			yyVAL.expr = TrimExpr{Direction: "leading", TrimList: yyDollar[4].trimList}
 		}
-	case 234:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1515
+//line sql.y:1854
 		{
 			yyVAL.expr = TrimExpr{Direction: "trailing", TrimList: yyDollar[4].trimList}
 		}
-	case 235:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1519
+//line sql.y:1858
 		{
 			yyVAL.expr = TrimExpr{TrimList: yyDollar[3].trimList}
 		}
-	case 236:
+	case 269:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1523
+//line sql.y:1862
 		{
 			yyVAL.expr = FuncApplication{Name: AnyName{"nullif"}, Args: []FuncArg{{Expr: yyDollar[3].expr}, {Expr: yyDollar[5].expr}}}
 		}
-	case 237:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1527
+//line sql.y:1866
 		{
 			fa := FuncApplication{Name: AnyName{"coalesce"}}
 			for _, e := range yyDollar[3].fields {
@@ -5878,9 +6827,9 @@ yydefault:
 			}
 			yyVAL.expr = fa
 		}
-	case 238:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1535
+//line sql.y:1874
 		{
 			fa := FuncApplication{Name: AnyName{"greatest"}}
 			for _, e := range yyDollar[3].fields {
@@ -5888,9 +6837,9 @@ yydefault:
 			}
 			yyVAL.expr = fa
 		}
-	case 239:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1543
+//line sql.y:1882
 		{
 			fa := FuncApplication{Name: AnyName{"least"}}
 			for _, e := range yyDollar[3].fields {
@@ -5898,9 +6847,9 @@ yydefault:
 			}
 			yyVAL.expr = fa
 		}
-	case 240:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1551
+//line sql.y:1890
 		{
 			fa := FuncApplication{Name: AnyName{"xmlconcat"}}
 			for _, e := range yyDollar[3].fields {
@@ -5908,606 +6857,976 @@ yydefault:
 			}
 			yyVAL.expr = fa
 		}
-	case 241:
+	case 274:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1559
+//line sql.y:1898
 		{
 			yyVAL.expr = XmlElement{Name: yyDollar[4].str}
 		}
-	case 242:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1563
+//line sql.y:1902
 		{
 			yyVAL.expr = XmlElement{Name: yyDollar[4].str, Attributes: yyDollar[6].xmlAttributes}
 		}
-	case 243:
+	case 276:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1567
+//line sql.y:1906
 		{
 			yyVAL.expr = XmlElement{Name: yyDollar[4].str, Body: yyDollar[6].fields}
 		}
-	case 244:
+	case 277:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:1571
+//line sql.y:1910
 		{
 			yyVAL.expr = XmlElement{Name: yyDollar[4].str, Attributes: yyDollar[6].xmlAttributes, Body: yyDollar[8].fields}
 		}
-	case 245:
+	case 278:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1575
+//line sql.y:1914
 		{
 			yyVAL.expr = XmlExists{Path: yyDollar[3].expr, Body: yyDollar[4].xmlExistsArgument}
 		}
-	case 246:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1579
+//line sql.y:1918
 		{
 			yyVAL.expr = XmlForest(yyDollar[3].xmlAttributeEls)
 		}
-	case 247:
+	case 280:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1583
+//line sql.y:1922
 		{
 			yyVAL.expr = XmlParse{Type: yyDollar[3].str, Content: yyDollar[4].expr, WhitespaceOption: yyDollar[5].str}
 		}
-	case 248:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1587
+//line sql.y:1926
 		{
 			yyVAL.expr = XmlPi{Name: yyDollar[4].str}
 		}
-	case 249:
+	case 282:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1591
+//line sql.y:1930
 		{
 			yyVAL.expr = XmlPi{Name: yyDollar[4].str, Content: yyDollar[6].expr}
 		}
-	case 250:
+	case 283:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1595
+//line sql.y:1934
 		{
 			yyVAL.expr = XmlRoot{Xml: yyDollar[3].expr, Version: yyDollar[5].xmlRootVersion, Standalone: yyDollar[6].str}
 		}
-	case 251:
+	case 284:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1599
+//line sql.y:1938
 		{
 			yyVAL.expr = XmlSerialize{XmlType: yyDollar[3].str, Content: yyDollar[4].expr, Type: yyDollar[6].pgType}
 		}
-	case 252:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1608
+//line sql.y:1947
 		{
 			yyVAL.xmlRootVersion = XmlRootVersion{Expr: yyDollar[2].expr}
 		}
-	case 253:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1612
+//line sql.y:1951
 		{
 			yyVAL.xmlRootVersion = XmlRootVersion{}
 		}
-	case 254:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1617
+//line sql.y:1956
 		{
 			yyVAL.str = "yes"
 		}
-	case 255:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1618
+//line sql.y:1957
 		{
 			yyVAL.str = "no"
 		}
-	case 256:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1619
+//line sql.y:1958
 		{
 			yyVAL.str = "no value"
 		}
-	case 257:
+	case 290:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1620
+//line sql.y:1959
 		{
 			yyVAL.str = ""
 		}
-	case 258:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1624
+//line sql.y:1963
 		{
 			yyVAL.xmlAttributes = XmlAttributes(yyDollar[3].xmlAttributeEls)
 		}
-	case 259:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1630
+//line sql.y:1969
 		{
 			yyVAL.xmlAttributeEls = []XmlAttributeEl{yyDollar[1].xmlAttributeEl}
 		}
-	case 260:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1634
+//line sql.y:1973
 		{
 			yyVAL.xmlAttributeEls = append(yyDollar[1].xmlAttributeEls, yyDollar[3].xmlAttributeEl)
 		}
-	case 261:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1640
+//line sql.y:1979
 		{
 			yyVAL.xmlAttributeEl = XmlAttributeEl{Value: yyDollar[1].expr, Name: yyDollar[3].str}
 		}
-	case 262:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1644
+//line sql.y:1983
 		{
 			yyVAL.xmlAttributeEl = XmlAttributeEl{Value: yyDollar[1].expr}
 		}
-	case 263:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1649
+//line sql.y:1988
 		{
 			yyVAL.str = "document"
 		}
-	case 264:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1650
+//line sql.y:1989
 		{
 			yyVAL.str = "content"
 		}
-	case 265:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1653
+//line sql.y:1992
 		{
 			yyVAL.str = "preserve whitespace"
 		}
-	case 266:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1654
+//line sql.y:1993
 		{
 			yyVAL.str = "strip whitespace"
 		}
-	case 267:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1655
+//line sql.y:1994
 		{
 			yyVAL.str = ""
 		}
-	case 268:
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1660
+//line sql.y:1999
 		{
 			yyVAL.xmlExistsArgument = XmlExistsArgument{Arg: yyDollar[2].expr}
 		}
-	case 269:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1664
+//line sql.y:2003
 		{
 			yyVAL.xmlExistsArgument = XmlExistsArgument{Arg: yyDollar[2].expr, RightByRef: true}
 		}
-	case 270:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1668
+//line sql.y:2007
 		{
 			yyVAL.xmlExistsArgument = XmlExistsArgument{LeftByRef: true, Arg: yyDollar[4].expr}
 		}
-	case 271:
+	case 304:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1672
+//line sql.y:2011
 		{
 			yyVAL.xmlExistsArgument = XmlExistsArgument{LeftByRef: true, Arg: yyDollar[4].expr, RightByRef: true}
 		}
-	case 272:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1679
+//line sql.y:2018
 		{
 			yyVAL.identifiers = []string{yyDollar[1].str}
 		}
-	case 273:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1683
+//line sql.y:2022
 		{
 			yyVAL.identifiers = append(yyDollar[1].identifiers, yyDollar[3].str)
 		}
-	case 274:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1689
+	case 307:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2028
 		{
-			yyVAL.expr = JoinExpr{Left: yyDollar[1].expr, Join: ",", Right: yyDollar[3].expr}
+			yyVAL.fromClause = &FromClause{Expr: yyDollar[2].expr, Comments: yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[1].pos)}
 		}
-	case 275:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1693
+	case 308:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2031
 		{
-			yyVAL.expr = JoinExpr{Left: yyDollar[1].expr, Join: "cross join", Right: yyDollar[4].expr}
+			yyVAL.fromClause = nil
 		}
-	case 276:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1697
+	case 310:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2036
 		{
-			yyVAL.expr = JoinExpr{Left: yyDollar[1].expr, Join: "natural join", Right: yyDollar[4].expr}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, JoinExpr{Left: yyDollar[1].expr, Join: ",", Right: yyDollar[3].expr})
 		}
-	case 277:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1701
+	case 311:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2046
 		{
-			yyVAL.expr = JoinExpr{Left: yyDollar[1].expr, Join: "join", Right: yyDollar[3].expr, Using: yyDollar[6].identifiers}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, tableRef(yyDollar[1].relationExpr, yyDollar[2].tableAlias))
 		}
-	case 278:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1705
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2050
 		{
-			yyVAL.expr = JoinExpr{Left: yyDollar[1].expr, Join: "join", Right: yyDollar[3].expr, On: yyDollar[5].expr}
+			yyDollar[3].tableSample.Relation = tableRef(yyDollar[1].relationExpr, yyDollar[2].tableAlias)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[3].tableSample)
 		}
-	case 279:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1711
+//line sql.y:2055
 		{
-			yyVAL.fromClause = &FromClause{Expr: yyDollar[2].expr}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, tableRef(yyDollar[1].expr, yyDollar[2].tableAlias))
 		}
-	case 280:
+	case 314:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2059
+		{
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, LateralExpr{Expr: tableRef(yyDollar[2].expr, yyDollar[3].tableAlias)})
+		}
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1715
+//line sql.y:2063
 		{
-			yyVAL.fromClause = &FromClause{Expr: yyDollar[2].expr}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, tableRef(yyDollar[1].sqlSelect, yyDollar[2].tableAlias))
 		}
-	case 281:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1718
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2068
 		{
-			yyVAL.fromClause = nil
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, LateralExpr{Expr: tableRef(yyDollar[2].sqlSelect, yyDollar[3].tableAlias)})
 		}
-	case 282:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1722
+	case 318:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2074
 		{
-			yyVAL.str = "nowait"
+			yyVAL.expr = ParenJoinExpr{Join: yyDollar[2].expr, Alias: yyDollar[5].str}
 		}
-	case 283:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1723
+	case 319:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2078
 		{
-			yyVAL.str = "skip locked"
+			yyVAL.expr = ParenJoinExpr{Join: yyDollar[2].expr, Alias: yyDollar[4].str}
 		}
-	case 284:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1724
+	case 320:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2096
 		{
-			yyVAL.str = ""
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = ParenJoinExpr{Join: yyDollar[2].expr}
 		}
-	case 285:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1728
+	case 321:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2101
 		{
-			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{yyDollar[1].str}}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, JoinExpr{Left: yyDollar[1].expr, Join: "cross join", Right: yyDollar[4].expr})
 		}
-	case 286:
+	case 322:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2106
+		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyDollar[5].joinQual.Left, yyDollar[5].joinQual.Join, yyDollar[5].joinQual.Right = yyDollar[1].expr, yyDollar[2].str+" join", yyDollar[4].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[5].joinQual)
+		}
+	case 323:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1732
+//line sql.y:2112
 		{
-			yyVAL.subqueryOp = SubqueryOp{Operator: true, Name: yyDollar[3].anyName}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyDollar[4].joinQual.Left, yyDollar[4].joinQual.Join, yyDollar[4].joinQual.Right = yyDollar[1].expr, "join", yyDollar[3].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[4].joinQual)
 		}
-	case 287:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1736
+	case 324:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2118
 		{
-			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"like"}}
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, JoinExpr{Left: yyDollar[1].expr, Join: "natural " + yyDollar[3].str + " join", Right: yyDollar[5].expr})
 		}
-	case 288:
+	case 325:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2123
+		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, JoinExpr{Left: yyDollar[1].expr, Join: "natural join", Right: yyDollar[4].expr})
+		}
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1740
+//line sql.y:2129
 		{
-			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"not like"}}
+			yyVAL.str = "full" + yyDollar[2].str
 		}
-	case 289:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1744
+	case 327:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2130
 		{
-			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"ilike"}}
+			yyVAL.str = "left" + yyDollar[2].str
 		}
-	case 290:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1748
+//line sql.y:2131
 		{
-			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"not ilike"}}
+			yyVAL.str = "right" + yyDollar[2].str
 		}
-	case 291:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1762
+//line sql.y:2132
 		{
-			yyVAL.fields = []Expr{yyDollar[1].expr}
+			yyVAL.str = "inner"
 		}
-	case 292:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1766
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2136
 		{
-			yyVAL.fields = append(yyDollar[1].fields, yyDollar[3].expr)
+			yyVAL.str = " outer"
 		}
-	case 293:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1773
+	case 331:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2137
 		{
-			yyVAL.funcArgs = []FuncArg{yyDollar[1].funcArg}
+			yyVAL.str = ""
 		}
-	case 294:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1777
+	case 332:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2147
 		{
-			yyVAL.funcArgs = append(yyDollar[1].funcArgs, yyDollar[3].funcArg)
+			yyVAL.joinQual = JoinExpr{Using: yyDollar[3].identifiers}
 		}
-	case 295:
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2151
+		{
+			yyVAL.joinQual = JoinExpr{On: yyDollar[2].expr}
+		}
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1783
+//line sql.y:2157
 		{
-			yyVAL.funcArg = FuncArg{Expr: yyDollar[1].expr}
+			yyVAL.str = "nowait"
 		}
-	case 296:
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2158
+		{
+			yyVAL.str = "skip locked"
+		}
+	case 336:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2159
+		{
+			yyVAL.str = ""
+		}
+	case 337:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2163
+		{
+			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{yyDollar[1].str}}
+		}
+	case 338:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2167
+		{
+			yyVAL.subqueryOp = SubqueryOp{Operator: true, Name: yyDollar[3].anyName}
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2171
+		{
+			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"like"}}
+		}
+	case 340:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2175
+		{
+			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"not like"}}
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2179
+		{
+			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"ilike"}}
+		}
+	case 342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2183
+		{
+			yyVAL.subqueryOp = SubqueryOp{Name: AnyName{"not ilike"}}
+		}
+	case 343:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2197
+		{
+			yyVAL.fields = []Expr{yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)}
+		}
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1787
+//line sql.y:2201
 		{
-			yyVAL.funcArg = FuncArg{Name: yyDollar[1].str, NameOp: ":=", Expr: yyDollar[3].expr}
+			yyVAL.fields = append(yyDollar[1].fields, yylex.(*sqlLex).commented(yyDollar[3].pos, yyDollar[3].expr))
 		}
-	case 297:
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2208
+		{
+			yyVAL.funcArgs = []FuncArg{yyDollar[1].funcArg}
+		}
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1791
+//line sql.y:2212
 		{
-			yyVAL.funcArg = FuncArg{Name: yyDollar[1].str, NameOp: "=>", Expr: yyDollar[3].expr}
+			yyVAL.funcArgs = append(yyDollar[1].funcArgs, yyDollar[3].funcArg)
 		}
-	case 298:
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2218
+		{
+			yyVAL.funcArg = FuncArg{Expr: yyDollar[1].expr, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
+		}
+	case 348:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2222
+		{
+			yyVAL.funcArg = FuncArg{Name: yyDollar[1].str, NameOp: ":=", Expr: yyDollar[3].expr, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
+		}
+	case 349:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2226
+		{
+			yyVAL.funcArg = FuncArg{Name: yyDollar[1].str, NameOp: "=>", Expr: yyDollar[3].expr, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
+		}
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1797
+//line sql.y:2232
 		{
 			yyVAL.pgTypes = []PgType{yyDollar[1].pgType}
 		}
-	case 299:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1801
+//line sql.y:2236
 		{
 			yyVAL.pgTypes = append(yyDollar[1].pgTypes, yyDollar[3].pgType)
 		}
-	case 300:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1807
+//line sql.y:2242
 		{
 			yyVAL.arrayExpr = ArrayExpr(yyDollar[2].fields)
 		}
-	case 301:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1811
+//line sql.y:2246
 		{
 			yyVAL.arrayExpr = yyDollar[2].arrayExpr
 		}
-	case 302:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1815
+//line sql.y:2250
 		{
 			yyVAL.arrayExpr = ArrayExpr{}
 		}
-	case 303:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1821
+//line sql.y:2256
 		{
 			yyVAL.arrayExpr = ArrayExpr{yyDollar[1].arrayExpr}
 		}
-	case 304:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1825
+//line sql.y:2260
 		{
 			yyVAL.arrayExpr = append(yyDollar[1].arrayExpr, yyDollar[3].arrayExpr)
 		}
-	case 305:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1831
+//line sql.y:2266
 		{
 			yyVAL.extractList = &ExtractList{Extract: yyDollar[1].expr, Time: yyDollar[3].expr}
 		}
-	case 306:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1835
+//line sql.y:2270
 		{
 			yyVAL.extractList = nil
 		}
-	case 307:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1843
+//line sql.y:2278
 		{
 			yyVAL.expr = AnyName{yyDollar[1].str}
 		}
-	case 308:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1844
+//line sql.y:2279
 		{
 			yyVAL.expr = AnyName{"year"}
 		}
-	case 309:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1845
+//line sql.y:2280
 		{
 			yyVAL.expr = AnyName{"month"}
 		}
-	case 310:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1846
+//line sql.y:2281
 		{
 			yyVAL.expr = AnyName{"day"}
 		}
-	case 311:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1847
+//line sql.y:2282
 		{
 			yyVAL.expr = AnyName{"hour"}
 		}
-	case 312:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1848
+//line sql.y:2283
 		{
 			yyVAL.expr = AnyName{"minute"}
 		}
-	case 313:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1849
+//line sql.y:2284
 		{
 			yyVAL.expr = AnyName{"second"}
 		}
-	case 314:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1850
+//line sql.y:2285
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 315:
+	case 367:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1860
+//line sql.y:2295
 		{
 			yyVAL.overlayList = OverlayList{Dest: yyDollar[1].expr, Placing: yyDollar[2].expr, From: yyDollar[3].expr, For: yyDollar[4].expr}
 		}
-	case 316:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1864
+//line sql.y:2299
 		{
 			yyVAL.overlayList = OverlayList{Dest: yyDollar[1].expr, Placing: yyDollar[2].expr, From: yyDollar[3].expr}
 		}
-	case 317:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1870
+//line sql.y:2305
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 318:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1878
+//line sql.y:2313
 		{
 			yyVAL.positionList = &PositionList{Substring: yyDollar[1].expr, String: yyDollar[3].expr}
 		}
-	case 319:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1881
+//line sql.y:2316
 		{
 			yyVAL.positionList = nil
 		}
-	case 320:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1897
+//line sql.y:2332
 		{
 			yyVAL.placeholder = SubstrList{Source: yyDollar[1].expr, From: yyDollar[2].expr, For: yyDollar[3].expr}
 		}
-	case 321:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1901
+//line sql.y:2336
 		{
 			/* not legal per SQL99, but might as well allow it */
 			yyVAL.placeholder = SubstrList{Source: yyDollar[1].expr, From: yyDollar[3].expr, For: yyDollar[2].expr}
 		}
-	case 322:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1905
+//line sql.y:2341
 		{
 			yyVAL.placeholder = SubstrList{Source: yyDollar[1].expr, From: yyDollar[2].expr}
 		}
-	case 323:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1909
+//line sql.y:2345
 		{
 			yyVAL.placeholder = SubstrList{Source: yyDollar[1].expr, For: yyDollar[2].expr}
 		}
-	case 324:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1913
+//line sql.y:2349
 		{
 			yyVAL.placeholder = yyDollar[1].fields
 		}
-	case 325:
+	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1917
+//line sql.y:2353
 		{
 			yyVAL.placeholder = nil
 		}
-	case 326:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1923
+//line sql.y:2359
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 327:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1929
+//line sql.y:2365
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 328:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1935
+//line sql.y:2371
 		{
 			yyVAL.trimList = TrimList{Left: yyDollar[1].expr, From: true, Right: yyDollar[3].fields}
 		}
-	case 329:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1939
+//line sql.y:2375
 		{
 			yyVAL.trimList = TrimList{From: true, Right: yyDollar[2].fields}
 		}
-	case 330:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1943
+//line sql.y:2379
 		{
 			yyVAL.trimList = TrimList{Right: yyDollar[1].fields}
 		}
-	case 333:
+	case 383:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2392
+		{
+			x := yylex.(*sqlLex)
+			yyDollar[4].insertStmt.InsertComments = x.claimTokenComments(yyDollar[1].pos)
+			yyDollar[4].insertStmt.IntoComments = x.claimTokenComments(yyDollar[2].pos)
+			yyDollar[4].insertStmt.TableComments = x.claimCommentsRange(yyDollar[3].pos, yyDollar[4].pos-1)
+			yyDollar[4].insertStmt.Table = yyDollar[3].insertStmt.Table
+			yyDollar[4].insertStmt.Alias = yyDollar[3].insertStmt.Alias
+			yyDollar[4].insertStmt.OnDuplicateKeyUpdate = yyDollar[5].setClauses
+			yyVAL.insertStmt = yyDollar[4].insertStmt
+		}
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2405
+		{
+			yyVAL.insertStmt = &InsertStmt{Table: yyDollar[1].anyName}
+		}
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1999
+//line sql.y:2409
+		{
+			yyVAL.insertStmt = &InsertStmt{Table: yyDollar[1].anyName, Alias: yyDollar[3].str}
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2415
+		{
+			yyVAL.insertStmt = &InsertStmt{Select: yyDollar[1].sqlSelect}
+		}
+	case 387:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2419
+		{
+			yyVAL.insertStmt = &InsertStmt{Columns: yyDollar[2].columnRefs, Select: yyDollar[4].sqlSelect, ColumnComments: yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[4].pos-1)}
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2425
+		{
+			yyVAL.columnRefs = []ColumnRef{yyDollar[1].columnRef}
+		}
+	case 389:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2429
+		{
+			yyVAL.columnRefs = append(yyDollar[1].columnRefs, yyDollar[3].columnRef)
+		}
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2435
+		{
+			yyVAL.columnRef = ColumnRef{Name: yyDollar[1].str, Indirection: yyDollar[2].indirection}
+		}
+	case 391:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2442
+		{
+			yylex.(*sqlLex).requireFeature(DuplicateKeyUpdateFeature, "ON DUPLICATE KEY UPDATE", yyDollar[1].pos)
+			yyVAL.setClauses = yyDollar[5].setClauses
+		}
+	case 392:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2447
+		{
+			yyVAL.setClauses = nil
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2453
+		{
+			yyVAL.setClauses = []SetClause{yyDollar[1].setClause}
+		}
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2457
+		{
+			yyVAL.setClauses = append(yyDollar[1].setClauses, yyDollar[3].setClause)
+		}
+	case 395:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2463
+		{
+			yyVAL.setClause = SetClause{Target: yyDollar[1].columnRef, Value: yyDollar[3].expr}
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2469
+		{
+			yyVAL.columnRef = ColumnRef{Name: yyDollar[1].str, Indirection: yyDollar[2].indirection}
+		}
+	case 399:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2525
 		{
 			yyDollar[2].sqlSelect.ParenWrapped = true
+			yyDollar[2].sqlSelect.ParenComments = yylex.(*sqlLex).claimComments(yyDollar[1].pos)
 			yyVAL.sqlSelect = yyDollar[2].sqlSelect
 		}
-	case 334:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2003
+//line sql.y:2531
 		{
+			c := yylex.(*sqlLex).claimComments(yyDollar[1].pos)
+			yyDollar[2].sqlSelect.ParenComments.Leading = append(c.Leading, yyDollar[2].sqlSelect.ParenComments.Leading...)
+			yyDollar[2].sqlSelect.ParenComments.Trailing = append(yyDollar[2].sqlSelect.ParenComments.Trailing, c.Trailing...)
 			yyVAL.sqlSelect = yyDollar[2].sqlSelect
 		}
-	case 335:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2007
+//line sql.y:2540
 		{
 			ss := &SelectStmt{}
 			ss.SimpleSelect = *yyDollar[1].simpleSelect
 			yyVAL.sqlSelect = ss
 		}
-	case 336:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2013
+//line sql.y:2546
 		{
 			yyDollar[1].sqlSelect.OrderClause = yyDollar[2].orderClause
 			yyVAL.sqlSelect = yyDollar[1].sqlSelect
 		}
-	case 337:
+	case 403:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2018
+//line sql.y:2551
 		{
 			yyDollar[1].sqlSelect.OrderClause = yyDollar[2].orderClause
 			yyDollar[1].sqlSelect.LockingClause = yyDollar[3].lockingClause
 			yyDollar[1].sqlSelect.LimitClause = yyDollar[4].limitClause
 			yyVAL.sqlSelect = yyDollar[1].sqlSelect
 		}
-	case 338:
+	case 404:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2025
+//line sql.y:2558
 		{
 			yyDollar[1].sqlSelect.OrderClause = yyDollar[2].orderClause
 			yyDollar[1].sqlSelect.LimitClause = yyDollar[3].limitClause
 			yyDollar[1].sqlSelect.LockingClause = yyDollar[4].lockingClause
 			yyVAL.sqlSelect = yyDollar[1].sqlSelect
 		}
-	case 339:
+	case 405:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2565
+		{
+			yyDollar[2].sqlSelect.WithClause = yyDollar[1].withClause
+			yyVAL.sqlSelect = yyDollar[2].sqlSelect
+		}
+	case 406:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2570
+		{
+			yyDollar[2].sqlSelect.WithClause = yyDollar[1].withClause
+			yyDollar[2].sqlSelect.OrderClause = yyDollar[3].orderClause
+			yyVAL.sqlSelect = yyDollar[2].sqlSelect
+		}
+	case 407:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2576
+		{
+			yyDollar[2].sqlSelect.WithClause = yyDollar[1].withClause
+			yyDollar[2].sqlSelect.OrderClause = yyDollar[3].orderClause
+			yyDollar[2].sqlSelect.LockingClause = yyDollar[4].lockingClause
+			yyDollar[2].sqlSelect.LimitClause = yyDollar[5].limitClause
+			yyVAL.sqlSelect = yyDollar[2].sqlSelect
+		}
+	case 408:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2584
+		{
+			yyDollar[2].sqlSelect.WithClause = yyDollar[1].withClause
+			yyDollar[2].sqlSelect.OrderClause = yyDollar[3].orderClause
+			yyDollar[2].sqlSelect.LimitClause = yyDollar[4].limitClause
+			yyDollar[2].sqlSelect.LockingClause = yyDollar[5].lockingClause
+			yyVAL.sqlSelect = yyDollar[2].sqlSelect
+		}
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2034
+//line sql.y:2594
 		{
 			ss := &SelectStmt{}
 			ss.SimpleSelect = *yyDollar[1].simpleSelect
 			yyVAL.sqlSelect = ss
 		}
-	case 341:
+	case 411:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2611
+		{
+			yyVAL.withClause = &WithClause{CTEs: yyDollar[2].ctes}
+		}
+	case 412:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2615
+		{
+			yyVAL.withClause = &WithClause{CTEs: yyDollar[2].ctes}
+		}
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2619
+		{
+			yyVAL.withClause = &WithClause{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2625
+		{
+			yyVAL.ctes = []CommonTableExpr{yyDollar[1].cte}
+		}
+	case 415:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2629
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 416:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2635
+		{
+			x := yylex.(*sqlLex)
+			x.nest(yyDollar[1].pos)
+			yyVAL.cte = CommonTableExpr{
+				Name:         yyDollar[1].str,
+				Columns:      yyDollar[2].identifiers,
+				Materialized: yyDollar[4].str,
+				Query:        yyDollar[6].sqlSelect,
+				Search:       yyDollar[8].searchClause,
+				Cycle:        yyDollar[9].cycleClause,
+				Comments:     x.claimCommentsRange(yyDollar[1].pos, yyDollar[6].pos-1),
+				EndComments:  x.claimComments(yyDollar[7].pos),
+			}
+		}
+	case 417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2651
+		{
+			yyVAL.str = "materialized"
+		}
+	case 418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2652
+		{
+			yyVAL.str = "not materialized"
+		}
+	case 419:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2653
+		{
+			yyVAL.str = ""
+		}
+	case 420:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2657
+		{
+			yyVAL.searchClause = &SearchClause{Columns: yyDollar[5].identifiers, SeqColumn: yyDollar[7].str}
+		}
+	case 421:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2661
+		{
+			yyVAL.searchClause = &SearchClause{BreadthFirst: true, Columns: yyDollar[5].identifiers, SeqColumn: yyDollar[7].str}
+		}
+	case 422:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2665
+		{
+			yyVAL.searchClause = nil
+		}
+	case 423:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2671
+		{
+			yyVAL.cycleClause = &CycleClause{Columns: yyDollar[2].identifiers, MarkColumn: yyDollar[4].str, MarkValue: yyDollar[6].expr, MarkDefault: yyDollar[8].expr, PathColumn: yyDollar[10].str}
+		}
+	case 424:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2675
+		{
+			yyVAL.cycleClause = &CycleClause{Columns: yyDollar[2].identifiers, MarkColumn: yyDollar[4].str, PathColumn: yyDollar[6].str}
+		}
+	case 425:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.cycleClause = nil
+		}
+	case 426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2684
+		{
+			yyVAL.identifiers = yyDollar[2].identifiers
+		}
+	case 427:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2685
+		{
+			yyVAL.identifiers = nil
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2689
+		{
+			yyVAL.identifiers = []string{yyDollar[1].str}
+		}
+	case 429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2693
+		{
+			yyVAL.identifiers = append(yyDollar[1].identifiers, yyDollar[3].str)
+		}
+	case 430:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:2068
+//line sql.y:2724
 		{
 			ss := &SimpleSelect{}
+			ss.Comments = yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[1].pos)
 			ss.TargetList = yyDollar[3].fields
 			ss.IntoClause = yyDollar[4].intoClause
 			ss.FromClause = yyDollar[5].fromClause
@@ -6517,11 +7836,12 @@ yydefault:
 			ss.WindowClause = yyDollar[9].windowDefinitions
 			yyVAL.simpleSelect = ss
 		}
-	case 342:
+	case 431:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:2082
+//line sql.y:2739
 		{
 			ss := &SimpleSelect{}
+			ss.Comments = yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[3].pos-1)
 			ss.DistinctList = yyDollar[2].fields
 			ss.TargetList = yyDollar[3].fields
 			ss.IntoClause = yyDollar[4].intoClause
@@ -6532,26 +7852,27 @@ yydefault:
 			ss.WindowClause = yyDollar[9].windowDefinitions
 			yyVAL.simpleSelect = ss
 		}
-	case 343:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2095
+//line sql.y:2753
 		{
 			ss := &SimpleSelect{}
 			ss.ValuesClause = yyDollar[1].valuesClause
 			yyVAL.simpleSelect = ss
 		}
-	case 344:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2101
+//line sql.y:2759
 		{
 			ss := &SimpleSelect{}
 			ss.Table = yyDollar[2].relationExpr
 			yyVAL.simpleSelect = ss
 		}
-	case 345:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2107
+//line sql.y:2765
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			ss := &SimpleSelect{}
 			ss.LeftSelect = yyDollar[1].sqlSelect
 			ss.SetOp = "union"
@@ -6559,10 +7880,11 @@ yydefault:
 			ss.RightSelect = yyDollar[4].sqlSelect
 			yyVAL.simpleSelect = ss
 		}
-	case 346:
+	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2116
+//line sql.y:2775
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			ss := &SimpleSelect{}
 			ss.LeftSelect = yyDollar[1].sqlSelect
 			ss.SetOp = "intersect"
@@ -6570,10 +7892,11 @@ yydefault:
 			ss.RightSelect = yyDollar[4].sqlSelect
 			yyVAL.simpleSelect = ss
 		}
-	case 347:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2125
+//line sql.y:2785
 		{
+			yylex.(*sqlLex).nest(yyDollar[1].pos)
 			ss := &SimpleSelect{}
 			ss.LeftSelect = yyDollar[1].sqlSelect
 			ss.SetOp = "except"
@@ -6581,896 +7904,929 @@ yydefault:
 			ss.RightSelect = yyDollar[4].sqlSelect
 			yyVAL.simpleSelect = ss
 		}
-	case 348:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2137
+//line sql.y:2798
 		{
 			yyVAL.intoClause = yyDollar[2].intoClause
 		}
-	case 349:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2141
+//line sql.y:2802
 		{
 			yyVAL.intoClause = nil
 		}
-	case 350:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2151
+//line sql.y:2812
 		{
 			yyVAL.intoClause = &IntoClause{Options: "temporary", OptTable: yyDollar[2].boolean, Target: yyDollar[3].anyName}
 		}
-	case 351:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2155
+//line sql.y:2816
 		{
 			yyVAL.intoClause = &IntoClause{Options: "temp", OptTable: yyDollar[2].boolean, Target: yyDollar[3].anyName}
 		}
-	case 352:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2159
+//line sql.y:2820
 		{
 			yyVAL.intoClause = &IntoClause{Options: "local temporary", OptTable: yyDollar[3].boolean, Target: yyDollar[4].anyName}
 		}
-	case 353:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2163
+//line sql.y:2824
 		{
 			yyVAL.intoClause = &IntoClause{Options: "local temp", OptTable: yyDollar[3].boolean, Target: yyDollar[4].anyName}
 		}
-	case 354:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2167
+//line sql.y:2828
 		{
 			yyVAL.intoClause = &IntoClause{Options: "global temporary", OptTable: yyDollar[3].boolean, Target: yyDollar[4].anyName}
 		}
-	case 355:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2171
+//line sql.y:2832
 		{
 			yyVAL.intoClause = &IntoClause{Options: "global temp", OptTable: yyDollar[3].boolean, Target: yyDollar[4].anyName}
 		}
-	case 356:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2175
+//line sql.y:2836
 		{
 			yyVAL.intoClause = &IntoClause{Options: "unlogged", OptTable: yyDollar[2].boolean, Target: yyDollar[3].anyName}
 		}
-	case 357:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2179
+//line sql.y:2840
 		{
 			yyVAL.intoClause = &IntoClause{OptTable: true, Target: yyDollar[2].anyName}
 		}
-	case 358:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2183
+//line sql.y:2844
 		{
 			yyVAL.intoClause = &IntoClause{Target: yyDollar[1].anyName}
 		}
-	case 359:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2188
+//line sql.y:2849
 		{
 			yyVAL.boolean = true
 		}
-	case 360:
+	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2189
+//line sql.y:2850
 		{
 			yyVAL.boolean = false
 		}
-	case 361:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2192
+//line sql.y:2853
 		{
 			yyVAL.boolean = true
 		}
-	case 362:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2193
+//line sql.y:2854
 		{
 			yyVAL.boolean = false
 		}
-	case 363:
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2194
+//line sql.y:2855
 		{
 			yyVAL.boolean = false
 		}
-	case 364:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2199
+//line sql.y:2860
 		{
 			yyVAL.fields = make([]Expr, 0)
 		}
-	case 365:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2200
+//line sql.y:2861
 		{
 			yyVAL.fields = yyDollar[4].fields
 		}
-	case 366:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2203
+//line sql.y:2864
 		{
 			yyVAL.placeholder = nil
 		}
-	case 367:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2204
+//line sql.y:2865
 		{
 			yyVAL.placeholder = nil
 		}
-	case 368:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2207
+//line sql.y:2868
 		{
 			yyVAL.orderClause = yyDollar[1].orderClause
 		}
-	case 369:
+	case 458:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2208
+//line sql.y:2869
 		{
 			yyVAL.orderClause = nil
 		}
-	case 370:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2211
+//line sql.y:2873
 		{
+			yyDollar[3].orderClause.Comments = yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[2].pos)
 			yyVAL.orderClause = yyDollar[3].orderClause
 		}
-	case 371:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2215
+//line sql.y:2880
 		{
 			yyVAL.orderClause = &OrderClause{Exprs: []OrderExpr{yyDollar[1].orderExpr}}
 		}
-	case 372:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2219
+//line sql.y:2884
 		{
 			yyDollar[1].orderClause.Exprs = append(yyDollar[1].orderClause.Exprs, yyDollar[3].orderExpr)
 			yyVAL.orderClause = yyDollar[1].orderClause
 		}
-	case 373:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2227
+//line sql.y:2892
 		{
 			yyVAL.orderExpr = OrderExpr{Expr: yyDollar[1].expr, Using: yyDollar[3].anyName, Nulls: yyDollar[4].str}
+			yyVAL.orderExpr.Comments = yylex.(*sqlLex).claimComments(yyDollar[1].pos)
 		}
-	case 374:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2231
+//line sql.y:2897
 		{
 			yyVAL.orderExpr = OrderExpr{Expr: yyDollar[1].expr, Order: yyDollar[2].str, Nulls: yyDollar[3].str}
+			yyVAL.orderExpr.Comments = yylex.(*sqlLex).claimComments(yyDollar[1].pos)
 		}
-	case 375:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2256
+//line sql.y:2924
 		{
-			yyVAL.groupByClause = &GroupByClause{Exprs: yyDollar[3].fields}
+			yyVAL.groupByClause = &GroupByClause{Exprs: yyDollar[3].fields, Comments: yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[2].pos)}
 		}
-	case 376:
+	case 465:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2257
+//line sql.y:2927
 		{
 			yyVAL.groupByClause = nil
 		}
-	case 377:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2261
+//line sql.y:2931
 		{
 			yyVAL.fields = []Expr{yyDollar[1].expr}
 		}
-	case 378:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2265
+//line sql.y:2935
 		{
 			yyVAL.fields = append(yyDollar[1].fields, yyDollar[3].expr)
 		}
-	case 380:
+	case 468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2941
+		{
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
+		}
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2278
+//line sql.y:2952
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[2].pos, yyDollar[2].expr)
 		}
-	case 381:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2279
+//line sql.y:2955
 		{
 			yyVAL.expr = nil
 		}
-	case 382:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2282
+//line sql.y:2958
 		{
 			yyVAL.lockingClause = yyDollar[1].lockingClause
 		}
-	case 383:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2283
+//line sql.y:2959
 		{
 			yyVAL.lockingClause = nil
 		}
-	case 384:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2286
+//line sql.y:2962
 		{
 			yyVAL.lockingClause = yyDollar[1].lockingClause
 		}
-	case 385:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2287
+//line sql.y:2963
 		{
 			yyVAL.lockingClause = nil
 		}
-	case 386:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2291
+//line sql.y:2967
 		{
 			yyVAL.lockingClause = &LockingClause{Locks: []LockingItem{yyDollar[1].lockingItem}}
 		}
-	case 387:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2295
+//line sql.y:2971
 		{
 			yyDollar[1].lockingClause.Locks = append(yyDollar[1].lockingClause.Locks, yyDollar[2].lockingItem)
 			yyVAL.lockingClause = yyDollar[1].lockingClause
 		}
-	case 388:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2302
+//line sql.y:2978
 		{
 			yyVAL.lockingItem = LockingItem{Strength: yyDollar[1].str, LockedRels: yyDollar[2].anyNames, WaitPolicy: yyDollar[3].str}
 		}
-	case 389:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2307
+//line sql.y:2983
 		{
 			yyVAL.str = "update"
 		}
-	case 390:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2308
+//line sql.y:2984
 		{
 			yyVAL.str = "no key update"
 		}
-	case 391:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2309
+//line sql.y:2985
 		{
 			yyVAL.str = "share"
 		}
-	case 392:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2310
+//line sql.y:2986
 		{
 			yyVAL.str = "key share"
 		}
-	case 393:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2313
+//line sql.y:2989
 		{
 			yyVAL.anyNames = yyDollar[2].anyNames
 		}
-	case 394:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2314
+//line sql.y:2990
 		{
 			yyVAL.anyNames = nil
 		}
-	case 395:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2322
+//line sql.y:2998
 		{
 			yyVAL.windowDefinitions = yyDollar[2].windowDefinitions
 		}
-	case 396:
+	case 485:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2325
+//line sql.y:3001
 		{
 			yyVAL.windowDefinitions = nil
 		}
-	case 397:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2329
+//line sql.y:3005
 		{
 			yyVAL.windowDefinitions = []WindowDefinition{yyDollar[1].windowDefinition}
 		}
-	case 398:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2333
+//line sql.y:3009
 		{
 			yyVAL.windowDefinitions = append(yyDollar[1].windowDefinitions, yyDollar[3].windowDefinition)
 		}
-	case 399:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2339
+//line sql.y:3015
 		{
-			yyVAL.windowDefinition = WindowDefinition{Name: yyDollar[1].str, Specification: yyDollar[3].windowSpecification}
+			yyVAL.windowDefinition = WindowDefinition{Name: yyDollar[1].str, Specification: yyDollar[3].windowSpecification, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
 		}
-	case 400:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2345
+//line sql.y:3021
 		{
 			spec := yyDollar[2].windowSpecification
 			yyVAL.overClause = &OverClause{Specification: &spec}
 		}
-	case 401:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2350
+//line sql.y:3026
 		{
 			yyVAL.overClause = &OverClause{Name: yyDollar[2].str}
 		}
-	case 402:
+	case 491:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2353
+//line sql.y:3029
 		{
 			yyVAL.overClause = nil
 		}
-	case 403:
+	case 492:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2357
+//line sql.y:3033
 		{
 			yyVAL.windowSpecification = WindowSpecification{ExistingName: yyDollar[2].str, PartitionClause: yyDollar[3].partitionClause, OrderClause: yyDollar[4].orderClause, FrameClause: yyDollar[5].frameClause}
+			if yyDollar[2].str != "" {
+				yyVAL.windowSpecification.Comments = yylex.(*sqlLex).claimTokenComments(yyDollar[1].pos, yyDollar[2].pos)
+			} else {
+				yyVAL.windowSpecification.Comments = yylex.(*sqlLex).claimTokenComments(yyDollar[1].pos)
+			}
 		}
-	case 404:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2372
+//line sql.y:3053
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 405:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2373
+//line sql.y:3054
 		{
 			yyVAL.str = ""
 		}
-	case 406:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2376
+//line sql.y:3057
 		{
 			yyVAL.partitionClause = PartitionClause(yyDollar[3].fields)
 		}
-	case 407:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2377
+//line sql.y:3058
 		{
 			yyVAL.partitionClause = nil
 		}
-	case 408:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2388
+//line sql.y:3069
 		{
 			yyDollar[2].frameClause.Mode = "range"
 			yyVAL.frameClause = yyDollar[2].frameClause
 		}
-	case 409:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2393
+//line sql.y:3074
 		{
 			yyDollar[2].frameClause.Mode = "rows"
 			yyVAL.frameClause = yyDollar[2].frameClause
 		}
-	case 410:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2398
+//line sql.y:3079
 		{
 			yyVAL.frameClause = nil
 		}
-	case 411:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2404
+//line sql.y:3085
 		{
 			yyVAL.frameClause = &FrameClause{Start: yyDollar[1].frameBound}
 		}
-	case 412:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2408
+//line sql.y:3089
 		{
 			yyVAL.frameClause = &FrameClause{Start: yyDollar[2].frameBound, End: yyDollar[4].frameBound}
 		}
-	case 413:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2419
+//line sql.y:3100
 		{
 			yyVAL.frameBound = &FrameBound{Direction: "preceding"}
 		}
-	case 414:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2423
+//line sql.y:3104
 		{
 			yyVAL.frameBound = &FrameBound{Direction: "following"}
 		}
-	case 415:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2427
+//line sql.y:3108
 		{
 			yyVAL.frameBound = &FrameBound{CurrentRow: true}
 		}
-	case 416:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2431
+//line sql.y:3112
 		{
 			yyVAL.frameBound = &FrameBound{BoundExpr: yyDollar[1].expr, Direction: "preceding"}
 		}
-	case 417:
+	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2435
+//line sql.y:3116
 		{
 			yyVAL.frameBound = &FrameBound{BoundExpr: yyDollar[1].expr, Direction: "following"}
 		}
-	case 418:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2443
+//line sql.y:3124
 		{
 			yyVAL.relationExpr = &RelationExpr{Name: yyDollar[1].anyName}
 		}
-	case 419:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2447
+//line sql.y:3128
 		{
 			yyVAL.relationExpr = &RelationExpr{Name: yyDollar[1].anyName, Star: true}
 		}
-	case 420:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2451
+//line sql.y:3132
 		{
 			yyVAL.relationExpr = &RelationExpr{Name: yyDollar[2].anyName, Only: true}
 		}
-	case 421:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2455
+//line sql.y:3136
 		{
 			yyVAL.relationExpr = &RelationExpr{Name: yyDollar[3].anyName, Only: true}
 		}
-	case 422:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2463
+//line sql.y:3144
 		{
 			yyVAL.limitClause = &LimitClause{Limit: yyDollar[1].expr, Offset: yyDollar[2].expr}
 		}
-	case 423:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2467
+//line sql.y:3148
 		{
 			yyVAL.limitClause = &LimitClause{Limit: yyDollar[2].expr, Offset: yyDollar[1].expr}
 		}
-	case 424:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2471
+//line sql.y:3152
 		{
 			yyVAL.limitClause = &LimitClause{Limit: yyDollar[1].expr}
 		}
-	case 425:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2475
+//line sql.y:3156
 		{
 			yyVAL.limitClause = &LimitClause{Offset: yyDollar[1].expr}
 		}
-	case 427:
+	case 515:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3161
+		{
+			yylex.(*sqlLex).requireFeature(LimitCommaFeature, "LIMIT #,# syntax", yyDollar[3].pos)
+			yyVAL.limitClause = &LimitClause{Limit: yyDollar[4].expr, Offset: yyDollar[2].expr, Comma: true}
+		}
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2482
+//line sql.y:3169
 		{
 			yyVAL.limitClause = nil
 		}
-	case 428:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2486
+//line sql.y:3173
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 429:
+	case 519:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2491
+//line sql.y:3178
 		{
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 430:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2497
+//line sql.y:3184
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 431:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2502
+//line sql.y:3189
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 432:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2507
+//line sql.y:3195
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 433:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2509
+//line sql.y:3199
 		{
 			yyVAL.expr = nil
 		}
-	case 434:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2514
+//line sql.y:3205
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 435:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2523
+//line sql.y:3216
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 436:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2524
+//line sql.y:3217
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 437:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2525
+//line sql.y:3218
 		{
 			yyVAL.expr = IntegerConst("1")
 		}
-	case 438:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2532
+//line sql.y:3225
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 439:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2536
+//line sql.y:3229
 		{
 			yyVAL.placeholder = 0
 		}
-	case 440:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2537
+//line sql.y:3230
 		{
 			yyVAL.placeholder = 0
 		}
-	case 441:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2540
+//line sql.y:3233
 		{
 			yyVAL.placeholder = 0
 		}
-	case 442:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2541
+//line sql.y:3234
 		{
 			yyVAL.placeholder = 0
 		}
-	case 443:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2545
+//line sql.y:3238
 		{
-			yyVAL.valuesClause = ValuesClause{yyDollar[2].valuesRow}
+			yyVAL.valuesClause = &ValuesClause{Rows: []ValuesRow{yyDollar[2].valuesRow}, Comments: yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[1].pos)}
 		}
-	case 444:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2549
+//line sql.y:3242
 		{
-			yyVAL.valuesClause = append(yyDollar[1].valuesClause, yyDollar[3].valuesRow)
+			yyDollar[1].valuesClause.Rows = append(yyDollar[1].valuesClause.Rows, yyDollar[3].valuesRow)
+			yyVAL.valuesClause = yyDollar[1].valuesClause
 		}
-	case 445:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2566
+//line sql.y:3261
 		{
-			yyVAL.whereClause = &WhereClause{Expr: yyDollar[2].expr}
+			expr := yylex.(*sqlLex).commented(yyDollar[2].pos, yyDollar[2].expr)
+			yyVAL.whereClause = &WhereClause{Expr: expr, Comments: yylex.(*sqlLex).claimCommentsRange(yyDollar[1].pos, yyDollar[1].pos)}
 		}
-	case 446:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2567
+//line sql.y:3265
 		{
 			yyVAL.whereClause = nil
 		}
-	case 447:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2577
+//line sql.y:3275
 		{
 			yyVAL.withinGroupClause = (*WithinGroupClause)(yyDollar[4].orderClause)
 		}
-	case 448:
+	case 538:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2580
+//line sql.y:3278
 		{
 			yyVAL.withinGroupClause = nil
 		}
-	case 449:
+	case 539:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2584
+//line sql.y:3282
 		{
 			yyVAL.filterClause = &FilterClause{Expr: yyDollar[4].expr}
 		}
-	case 450:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2587
+//line sql.y:3285
 		{
 			yyVAL.filterClause = nil
 		}
-	case 451:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2599
+//line sql.y:3297
 		{
 			yyVAL.row = Row{RowWord: true, Exprs: yyDollar[3].fields}
 		}
-	case 452:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2603
+//line sql.y:3301
 		{
 			yyVAL.row = Row{RowWord: true, Exprs: nil}
 		}
-	case 453:
+	case 543:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2607
+//line sql.y:3305
 		{
 			yyVAL.row = Row{Exprs: append(yyDollar[2].fields, yyDollar[4].expr)}
 		}
-	case 454:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2613
+//line sql.y:3311
 		{
 			yyVAL.row = Row{RowWord: true, Exprs: yyDollar[3].fields}
 		}
-	case 455:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2617
+//line sql.y:3315
 		{
 			yyVAL.row = Row{RowWord: true, Exprs: nil}
 		}
-	case 456:
+	case 546:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2623
+//line sql.y:3321
 		{
 			yyVAL.row = Row{Exprs: append(yyDollar[2].fields, yyDollar[4].expr)}
 		}
-	case 457:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2628
+//line sql.y:3326
 		{
 			yyVAL.str = "any"
 		}
-	case 458:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2629
+//line sql.y:3327
 		{
 			yyVAL.str = "some"
 		}
-	case 459:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2630
+//line sql.y:3328
 		{
 			yyVAL.str = "all"
 		}
-	case 460:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2633
+//line sql.y:3331
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 461:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2634
+//line sql.y:3332
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 462:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2637
+//line sql.y:3335
 		{
 			yyVAL.str = "+"
 		}
-	case 463:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2638
+//line sql.y:3336
 		{
 			yyVAL.str = "-"
 		}
-	case 464:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2639
+//line sql.y:3337
 		{
 			yyVAL.str = "*"
 		}
-	case 465:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2640
+//line sql.y:3338
 		{
 			yyVAL.str = "/"
 		}
-	case 466:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2641
+//line sql.y:3339
 		{
 			yyVAL.str = "%"
 		}
-	case 467:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2642
+//line sql.y:3340
 		{
 			yyVAL.str = "^"
 		}
-	case 468:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2643
+//line sql.y:3341
 		{
 			yyVAL.str = "<"
 		}
-	case 469:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2644
+//line sql.y:3342
 		{
 			yyVAL.str = ">"
 		}
-	case 470:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2645
+//line sql.y:3343
 		{
 			yyVAL.str = "="
 		}
-	case 471:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2646
+//line sql.y:3344
 		{
 			yyVAL.str = "<="
 		}
-	case 472:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2647
+//line sql.y:3345
 		{
 			yyVAL.str = ">="
 		}
-	case 473:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2648
+//line sql.y:3346
 		{
 			yyVAL.str = "<>"
 		}
-	case 474:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2651
+//line sql.y:3349
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 475:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2652
+//line sql.y:3350
 		{
 			yyVAL.anyName = yyDollar[3].anyName
 		}
-	case 476:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2655
+//line sql.y:3353
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 477:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2656
+//line sql.y:3354
 		{
 			yyVAL.anyName = yyDollar[3].anyName
 		}
-	case 478:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2660
+//line sql.y:3358
 		{
 			yyVAL.expr = yyDollar[1].sqlSelect
 		}
-	case 479:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2664
+//line sql.y:3362
 		{
-			yyVAL.expr = ValuesRow(yyDollar[2].fields)
+			yyVAL.expr = ValuesRow{Exprs: yyDollar[2].fields, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
 		}
-	case 480:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2677
+	case 570:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3378
 		{
-			yyVAL.expr = CaseExpr{CaseArg: yyDollar[2].expr, WhenClauses: yyDollar[3].whenClauses, Default: yyDollar[4].expr}
+			x := yylex.(*sqlLex)
+			yyVAL.expr = CaseExpr{
+				CaseArg: yyDollar[2].expr, WhenClauses: yyDollar[3].whenClauses,
+				Comments:    x.claimTokenComments(yyDollar[1].pos),
+				EndComments: x.claimTokenComments(yyDollar[4].pos),
+			}
 		}
-	case 481:
+	case 571:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3387
+		{
+			x := yylex.(*sqlLex)
+			yyVAL.expr = CaseExpr{
+				CaseArg: yyDollar[2].expr, WhenClauses: yyDollar[3].whenClauses, Default: x.commentedRange(yyDollar[5].pos, yyDollar[6].pos-1, yyDollar[5].expr),
+				Comments:        x.claimTokenComments(yyDollar[1].pos),
+				DefaultComments: x.claimTokenComments(yyDollar[4].pos),
+				EndComments:     x.claimTokenComments(yyDollar[6].pos),
+			}
+		}
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2684
+//line sql.y:3400
 		{
 			yyVAL.whenClauses = []WhenClause{yyDollar[1].whenClause}
 		}
-	case 482:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2688
+//line sql.y:3404
 		{
 			yyVAL.whenClauses = append(yyDollar[1].whenClauses, yyDollar[2].whenClause)
 		}
-	case 483:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2694
-		{
-			yyVAL.whenClause = WhenClause{When: yyDollar[2].expr, Then: yyDollar[4].expr}
-		}
-	case 484:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2699
-		{
-			yyVAL.expr = yyDollar[2].expr
-		}
-	case 485:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2700
+//line sql.y:3410
 		{
-			yyVAL.expr = nil
+			x := yylex.(*sqlLex)
+			yyVAL.whenClause = WhenClause{
+				When:     x.commentedRange(yyDollar[2].pos, yyDollar[3].pos-1, yyDollar[2].expr),
+				Then:     x.commented(yyDollar[4].pos, yyDollar[4].expr),
+				Comments: x.claimTokenComments(yyDollar[1].pos, yyDollar[3].pos),
+			}
 		}
-	case 486:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2703
+//line sql.y:3420
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 487:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2704
+//line sql.y:3421
 		{
 			yyVAL.expr = nil
 		}
-	case 488:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2708
+//line sql.y:3425
 		{
 			yyVAL.columnRef = ColumnRef{Name: yyDollar[1].str}
 		}
-	case 489:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2712
+//line sql.y:3429
 		{
 			yyVAL.columnRef = ColumnRef{Name: yyDollar[1].str, Indirection: yyDollar[2].indirection}
 		}
-	case 490:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2720
+//line sql.y:3437
 		{
 			yyVAL.indirectionEl = IndirectionEl{Name: yyDollar[2].str}
 		}
-	case 491:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2724
+//line sql.y:3441
 		{
 			yyVAL.indirectionEl = IndirectionEl{Name: "*"}
 		}
-	case 492:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2728
+//line sql.y:3445
 		{
 			yyVAL.indirectionEl = IndirectionEl{LowerSubscript: yyDollar[2].expr}
 		}
-	case 493:
+	case 582:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2732
+//line sql.y:3449
 		{
 			yyVAL.indirectionEl = IndirectionEl{LowerSubscript: yyDollar[2].expr, UpperSubscript: yyDollar[4].expr}
 		}
-	case 494:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2737
+//line sql.y:3454
 		{
 			yyVAL.indirection = Indirection{yyDollar[1].indirectionEl}
 		}
-	case 495:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2738
+//line sql.y:3455
 		{
 			yyVAL.indirection = append(yyDollar[1].indirection, yyDollar[2].indirectionEl)
 		}
-	case 496:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2741
+//line sql.y:3458
 		{
 			yyVAL.indirection = nil
 		}
-	case 497:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2743
+//line sql.y:3460
 		{
 			if yyDollar[1].indirection != nil {
 				yyVAL.indirection = append(yyDollar[1].indirection, yyDollar[2].indirectionEl)
@@ -7478,177 +8834,186 @@ yydefault:
 				yyVAL.indirection = Indirection{yyDollar[2].indirectionEl}
 			}
 		}
-	case 498:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2753
+//line sql.y:3470
 		{
 			yyVAL.placeholder = nil
 		}
-	case 499:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2757
+//line sql.y:3474
 		{
 			yyVAL.placeholder = nil
 		}
-	case 500:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2769
+//line sql.y:3486
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 501:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2770
+//line sql.y:3487
 		{
 			yyVAL.expr = DefaultExpr(true)
 		}
-	case 502:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2774
+//line sql.y:3491
 		{
-			yyVAL.valuesRow = ValuesRow{yyDollar[1].expr}
+			yyVAL.fields = []Expr{yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)}
 		}
-	case 503:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2778
+//line sql.y:3495
 		{
-			yyVAL.valuesRow = append(yyDollar[1].valuesRow, yyDollar[3].expr)
+			yyVAL.fields = append(yyDollar[1].fields, yylex.(*sqlLex).commented(yyDollar[3].pos, yyDollar[3].expr))
 		}
-	case 504:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2789
+//line sql.y:3506
 		{
-			yyVAL.valuesRow = yyDollar[2].valuesRow
+			yyVAL.valuesRow = ValuesRow{Exprs: yyDollar[2].fields, Comments: yylex.(*sqlLex).claimComments(yyDollar[1].pos)}
 		}
-	case 505:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2800
+//line sql.y:3517
 		{
 			yyVAL.fields = yyDollar[1].fields
 		}
-	case 506:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2801
+//line sql.y:3518
 		{
 			yyVAL.fields = nil
 		}
-	case 507:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2804
+//line sql.y:3521
 		{
 			yyVAL.fields = []Expr{yyDollar[1].expr}
 		}
-	case 508:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2806
+//line sql.y:3523
 		{
 			yyVAL.fields = append(yyDollar[1].fields, yyDollar[3].expr)
 		}
-	case 509:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2812
+//line sql.y:3529
 		{
-			yyVAL.expr = AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[3].str})
 		}
-	case 510:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2816
+//line sql.y:3533
 		{
-			yyVAL.expr = AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[2].str}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, AliasedExpr{Expr: yyDollar[1].expr, Alias: yyDollar[2].str})
 		}
-	case 512:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2821
+//line sql.y:3537
 		{
-			yyVAL.expr = ColumnRef{Name: "*"}
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, yyDollar[1].expr)
 		}
-	case 513:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2835
+//line sql.y:3541
+		{
+			yyVAL.expr = yylex.(*sqlLex).commented(yyDollar[1].pos, ColumnRef{Name: "*"})
+		}
+	case 602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3555
 		{
 			yyVAL.anyNames = []AnyName{yyDollar[1].anyName}
 		}
-	case 514:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2839
+//line sql.y:3559
 		{
 			yyVAL.anyNames = append(yyDollar[1].anyNames, yyDollar[3].anyName)
 		}
-	case 515:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2852
+//line sql.y:3572
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 516:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2856
+//line sql.y:3576
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 			for _, s := range yyDollar[2].indirection {
+				if s.Name == "" || s.Name == "*" {
+					yylex.Error("improper qualified name")
+				}
 				yyVAL.anyName = append(yyVAL.anyName, s.Name)
 			}
 		}
-	case 517:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2864
+//line sql.y:3587
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 518:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2877
+//line sql.y:3600
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 		}
-	case 519:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2881
+//line sql.y:3604
 		{
 			yyVAL.anyName = AnyName{yyDollar[1].str}
 			for _, s := range yyDollar[2].indirection {
 				yyVAL.anyName = append(yyVAL.anyName, s.Name)
 			}
 		}
-	case 520:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2894
+//line sql.y:3617
 		{
 			yyVAL.expr = yyDollar[1].iconst
 		}
-	case 521:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2898
+//line sql.y:3621
 		{
 			yyVAL.expr = FloatConst(yyDollar[1].str)
 		}
-	case 522:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2902
+//line sql.y:3625
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 523:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2906
+//line sql.y:3629
 		{
 			yyVAL.expr = BitConst(yyDollar[1].str)
 		}
-	case 524:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2910
+//line sql.y:3633
 		{
 			yyVAL.expr = BitConst(yyDollar[1].str)
 		}
-	case 525:
+	case 614:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2914
+//line sql.y:3637
 		{
 			yyVAL.expr = ConstTypeExpr{Typename: PgType{Name: yyDollar[1].anyName}, Expr: yyDollar[2].expr}
 		}
-	case 526:
+	case 615:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2918
+//line sql.y:3641
 		{
 			pgType := PgType{Name: yyDollar[1].anyName}
 
@@ -7673,99 +9038,99 @@ yydefault:
 
 			yyVAL.expr = ConstTypeExpr{Typename: pgType, Expr: yyDollar[6].expr}
 		}
-	case 527:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2942
+//line sql.y:3666
 		{
 			yyVAL.expr = ConstTypeExpr{Typename: yyDollar[1].pgType, Expr: yyDollar[2].expr}
 		}
-	case 528:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2946
+//line sql.y:3670
 		{
 			yyVAL.expr = ConstIntervalExpr{Value: yyDollar[2].expr, OptInterval: yyDollar[3].optInterval}
 		}
-	case 529:
+	case 618:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2950
+//line sql.y:3674
 		{
 			yyVAL.expr = ConstIntervalExpr{Precision: yyDollar[3].iconst, Value: yyDollar[5].expr}
 		}
-	case 530:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2954
+//line sql.y:3678
 		{
 			yyVAL.expr = BoolConst(true)
 		}
-	case 531:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2958
+//line sql.y:3682
 		{
 			yyVAL.expr = BoolConst(false)
 		}
-	case 532:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2962
+//line sql.y:3686
 		{
 			yyVAL.expr = NullConst{}
 		}
-	case 533:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2966
+//line sql.y:3690
 		{
 			yyVAL.iconst = IntegerConst(yyDollar[1].str)
 		}
-	case 534:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2967
+//line sql.y:3691
 		{
-			yyVAL.expr = StringConst(yyDollar[1].str)
+			yyVAL.expr = NewStringConst(yyDollar[1].str)
 		}
-	case 535:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2970
+//line sql.y:3694
 		{
 			yyVAL.expr = yyDollar[1].iconst
 		}
-	case 536:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2971
+//line sql.y:3695
 		{
 			yyVAL.expr = "+" + yyDollar[2].iconst
 		}
-	case 537:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2972
+//line sql.y:3696
 		{
 			yyVAL.expr = "-" + yyDollar[2].iconst
 		}
-	case 544:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3003
+//line sql.y:3727
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 545:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3004
+//line sql.y:3728
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 546:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3005
+//line sql.y:3729
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 547:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3006
+//line sql.y:3730
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 548:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:3007
+//line sql.y:3731
 		{
 			yyVAL.str = yyDollar[1].str
 		}
@@ -2,13 +2,37 @@
 
 package sqlfmt
 
+func init() {
+	// report the offending and expected tokens to sqlLex.Error
+	yyErrorVerbose = true
+}
+
 %}
 
 %union {
+  pos int // index of the first token of the symbol; inherited from $1 by default
   sqlSelect *SelectStmt
+  insertStmt *InsertStmt
+  setClauses []SetClause
+  setClause SetClause
+  columnRefs []ColumnRef
+  joinQual JoinExpr
+  tableAlias tableAlias
+  columnDefs []ColumnDef
+  columnDef ColumnDef
+  rowsFromItems []RowsFromItem
+  rowsFromItem RowsFromItem
+  tableSample TableSample
+  withClause *WithClause
+  ctes []CommonTableExpr
+  cte CommonTableExpr
+  searchClause *SearchClause
+  cycleClause *CycleClause
   simpleSelect *SimpleSelect
   fields []Expr
   expr Expr
+  stmts []Stmt
+  stmt Stmt
   str string
   identifiers []string
   anyNames []AnyName
@@ -30,7 +54,7 @@ package sqlfmt
   pgTypes []PgType
   row Row
   valuesRow ValuesRow
-  valuesClause ValuesClause
+  valuesClause *ValuesClause
   funcApplication FuncApplication
   funcArgs []FuncArg
   funcArg FuncArg
@@ -65,8 +89,21 @@ package sqlfmt
   xmlRootVersion XmlRootVersion
 }
 
-%type <sqlSelect> top
+%type <stmts> stmtmulti
+%type <stmt> toplevel_stmt
 %type <sqlSelect> SelectStmt
+%type <insertStmt> InsertStmt insert_target insert_rest
+%type <columnRefs> insert_column_list
+%type <columnRef> insert_column_item set_target
+%type <setClauses> set_clause_list opt_on_duplicate_key_update
+%type <setClause> set_clause
+%type <withClause> with_clause
+%type <ctes> cte_list
+%type <cte> common_table_expr
+%type <str> opt_materialized
+%type <searchClause> opt_search_clause
+%type <cycleClause> opt_cycle_clause
+%type <identifiers> columnList opt_name_list
 %type <sqlSelect> select_no_parens
 %type <sqlSelect> select_with_parens select_clause
 %type <simpleSelect> simple_select
@@ -74,11 +111,20 @@ package sqlfmt
 %type <fields> opt_target_list target_list distinct_clause expr_list
 %type <placeholder> opt_all_clause
 
-%type <expr> aliasableExpr
 %type <expr> target_el a_expr b_expr c_expr
 %type <fromClause> from_clause
 %type <identifiers> identifierSeq
-%type <expr> joinExpr
+%type <expr> from_list table_ref joined_table func_table
+%type <tableAlias> alias_clause opt_alias_clause func_alias_clause
+%type <columnDefs> TableFuncElementList opt_col_def_list
+%type <columnDef> TableFuncElement
+%type <rowsFromItems> rowsfrom_list
+%type <rowsFromItem> rowsfrom_item
+%type <boolean> opt_ordinality
+%type <tableSample> tablesample_clause
+%type <expr> opt_repeatable_clause
+%type <str> join_type opt_outer
+%type <joinQual> join_qual
 %type <intoClause> OptTempTableName into_clause
 %type <boolean> opt_table
 %type <whereClause> where_clause
@@ -144,12 +190,13 @@ package sqlfmt
 %type <expr>  SignedIconst Sconst AexprConst
 %type <iconst> Iconst opt_float
 
-%type <expr> case_expr case_arg case_default
+%type <expr> case_expr case_arg
 %type <whenClauses> when_clause_list
 %type <whenClause> when_clause
 
 %type <expr> ctext_expr
-%type <valuesRow> ctext_expr_list ctext_row
+%type <fields> ctext_expr_list
+%type <valuesRow> ctext_row
 %type <row> row explicit_row implicit_row
 
 %type <funcApplication> func_application
@@ -226,7 +273,7 @@ package sqlfmt
  */
 %token <str>  IDENT FCONST SCONST BCONST XCONST Op
 /* ival in PostgreSQL */
-%token <str> ICONST PARAM
+%token <str> ICONST PARAM PLACEHOLDER
 %token      TYPECAST DOT_DOT COLON_EQUALS EQUALS_GREATER
 %token      LESS_EQUALS GREATER_EQUALS NOT_EQUALS
 
@@ -237,7 +284,7 @@ package sqlfmt
   ASSERTION ASSIGNMENT ASYMMETRIC AT ATTRIBUTE AUTHORIZATION
 
   BACKWARD BEFORE BEGIN_P BETWEEN BIGINT BINARY BIT
-  BOOLEAN_P BOTH BY
+  BOOLEAN_P BOTH BREADTH BY
 
   CACHE CALLED CASCADE CASCADED CASE CAST CATALOG_P CHAIN CHAR_P
   CHARACTER CHARACTERISTICS CHECK CHECKPOINT CLASS CLOSE
@@ -249,8 +296,9 @@ package sqlfmt
   CURRENT_TIME CURRENT_TIMESTAMP CURRENT_USER CURSOR CYCLE
 
   DATA_P DATABASE DAY_P DEALLOCATE DEC DECIMAL_P DECLARE DEFAULT DEFAULTS
-  DEFERRABLE DEFERRED DEFINER DELETE_P DELIMITER DELIMITERS DESC
+  DEFERRABLE DEFERRED DEFINER DELETE_P DELIMITER DELIMITERS DEPTH DESC
   DICTIONARY DISABLE_P DISCARD DISTINCT DO DOCUMENT_P DOMAIN_P DOUBLE_P DROP
+  DUPLICATE
 
   EACH ELSE ENABLE_P ENCODING ENCRYPTED END_P ENUM_P ESCAPE EVENT EXCEPT
   EXCLUDE EXCLUDING EXCLUSIVE EXECUTE EXISTS EXPLAIN
@@ -337,6 +385,8 @@ package sqlfmt
 
 
 /* Precedence: lowest to highest */
+%nonassoc EMPTY_STMT  /* see toplevel_stmt */
+%nonassoc error
 %nonassoc SET       /* see relation_expr_opt_alias */
 %left   UNION EXCEPT
 %left   INTERSECT
@@ -403,16 +453,66 @@ package sqlfmt
 %%
 
 top:
+  stmtmulti
+  {
+    yylex.(*sqlLex).stmts = $1
+  }
+
+/*
+ * As in PostgreSQL, statements are separated by semicolons and may be empty.
+ * The semicolon and the comments on it belong to the statement before it.
+ */
+stmtmulti:
+  stmtmulti ';' toplevel_stmt
+  {
+    x := yylex.(*sqlLex)
+    endStmt($1[len($1)-1], x.claimCommentsRange($<pos>2, $<pos>2), x.token($<pos>2).blankLineAfter)
+    x.dropTokens($<pos>2)
+    $$ = x.flushStmts(append($1, $3))
+  }
+| toplevel_stmt
+  {
+    $$ = []Stmt{$1}
+  }
+/*
+ * A syntax error found only once the statement before it has been reduced,
+ * as in "select a )". The statement is replaced with the recovered one.
+ */
+| stmtmulti error
+  {
+    x := yylex.(*sqlLex)
+    $$ = append($1[:len($1)-1], x.recoverStmt())
+  }
+
+toplevel_stmt:
   SelectStmt
   {
-    $$ = $1
-    yylex.(*sqlLex).stmt = $1
+    x := yylex.(*sqlLex)
+    $1.Comments = x.claimComments($<pos>1)
+    $$ = x.dialectChecked($1)
   }
-| SelectStmt ';'
+| InsertStmt
   {
-    $$ = $1
-    $$.Semicolon = true
-    yylex.(*sqlLex).stmt = $1
+    x := yylex.(*sqlLex)
+    $1.Comments = x.claimComments($<pos>1)
+    $$ = x.dialectChecked($1)
+  }
+/*
+ * An error at the start of a statement is recovered by toplevel_stmt: error,
+ * not by an empty statement followed by stmtmulti: stmtmulti error.
+ *
+ * Both error rules leave the rest of the statement to the lexer, which skips
+ * it (see recoverStmt). goyacc discards the lookahead token itself, as it
+ * cannot follow the error, without reporting another error.
+ */
+| /*EMPTY*/ %prec EMPTY_STMT
+  {
+    $$ = &EmptyStmt{}
+  }
+| error
+  {
+    x := yylex.(*sqlLex)
+    $$ = x.recoverStmt()
   }
 
 opt_asc_desc:
@@ -430,18 +530,121 @@ opt_nulls_order:
  */
 param_name: type_function_name
 
-aliasableExpr:
-  a_expr
+alias_clause:
+  AS IDENT
   {
-    $$ = $1
+    $$ = tableAlias{name: $2}
   }
-| a_expr AS IDENT
+| IDENT
   {
-    $$ = AliasedExpr{Expr: $1, Alias: $3}
+    $$ = tableAlias{name: $1}
   }
-| a_expr IDENT
+| AS IDENT '(' identifierSeq ')'
+  {
+    $$ = tableAlias{name: $2, columns: $4}
+  }
+| IDENT '(' identifierSeq ')'
+  {
+    $$ = tableAlias{name: $1, columns: $3}
+  }
+
+opt_alias_clause:
+  alias_clause
+| /*EMPTY*/
+  {
+    $$ = tableAlias{}
+  }
+
+/*
+ * A function call with its alias can have a column definition list, which
+ * gives the types of the columns of a function returning record.
+ */
+func_alias_clause:
+  alias_clause
+| AS IDENT '(' TableFuncElementList ')'
+  {
+    $$ = tableAlias{name: $2, columnDefs: $4}
+  }
+| IDENT '(' TableFuncElementList ')'
+  {
+    $$ = tableAlias{name: $1, columnDefs: $3}
+  }
+| AS '(' TableFuncElementList ')'
+  {
+    $$ = tableAlias{columnDefs: $3}
+  }
+| /*EMPTY*/
+  {
+    $$ = tableAlias{}
+  }
+
+tablesample_clause:
+  TABLESAMPLE func_name '(' expr_list ')' opt_repeatable_clause
+  {
+    $$ = TableSample{Method: $2, Args: $4, Repeatable: $6}
+  }
+
+opt_repeatable_clause:
+  REPEATABLE '(' a_expr ')'  { $$ = $3 }
+| /*EMPTY*/                  { $$ = nil }
+
+/*
+ * A function call in a FROM clause. Unlike a table or a subquery, its alias
+ * can have a column definition list; see func_alias_clause.
+ */
+func_table:
+  func_expr
+| func_expr WITH_LA ORDINALITY
+  {
+    $$ = FuncTable{Func: $1, Ordinality: true}
+  }
+| ROWS FROM '(' rowsfrom_list ')' opt_ordinality
+  {
+    $$ = FuncTable{RowsFrom: $4, Ordinality: $6}
+  }
+
+rowsfrom_list:
+  rowsfrom_item
+  {
+    $$ = []RowsFromItem{$1}
+  }
+| rowsfrom_list ',' rowsfrom_item
+  {
+    $$ = append($1, $3)
+  }
+
+rowsfrom_item:
+  func_expr opt_col_def_list
   {
-    $$ = AliasedExpr{Expr: $1, Alias: $2}
+    $$ = RowsFromItem{Func: $1, ColumnDefs: $2}
+  }
+
+opt_col_def_list:
+  AS '(' TableFuncElementList ')'  { $$ = $3 }
+| /*EMPTY*/                        { $$ = nil }
+
+opt_ordinality:
+  WITH_LA ORDINALITY  { $$ = true }
+| /*EMPTY*/           { $$ = false }
+
+TableFuncElementList:
+  TableFuncElement
+  {
+    $$ = []ColumnDef{$1}
+  }
+| TableFuncElementList ',' TableFuncElement
+  {
+    $$ = append($1, $3)
+  }
+
+TableFuncElement:
+  ColId Typename
+  {
+    $$ = ColumnDef{Name: $1, Type: $2}
+  }
+| ColId Typename COLLATE any_name
+  {
+    $$ = ColumnDef{Name: $1, Type: $2, Collation: $4}
   }
 
 
@@ -915,14 +1118,17 @@ a_expr:
   c_expr
 | a_expr TYPECAST Typename
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TypecastExpr{Expr: $1, Typename: $3}
   }
 | a_expr COLLATE any_name
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = CollateExpr{Expr: $1, Collation: $3}
   }
 | a_expr AT TIME ZONE a_expr      %prec AT
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = AtTimeZoneExpr{Expr: $1, TimeZone: $5}
   }
 /*
@@ -936,135 +1142,172 @@ a_expr:
 */
 | '+' a_expr          %prec UMINUS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: AnyName{"+"}, Expr: $2}
   }
 | '-' a_expr          %prec UMINUS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: AnyName{"-"}, Expr: $2}
   }
 | a_expr '+' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"+"}, Right: $3}
   }
 | a_expr '-' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"-"}, Right: $3}
   }
 | a_expr '*' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"*"}, Right: $3}
   }
 | a_expr '/' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"/"}, Right: $3}
   }
 | a_expr '%' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"%"}, Right: $3}
   }
 | a_expr '^' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"^"}, Right: $3}
   }
 | a_expr '<' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"<"}, Right: $3}
   }
 | a_expr '>' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{">"}, Right: $3}
   }
 | a_expr '=' a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"="}, Right: $3}
   }
 | a_expr LESS_EQUALS a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"<="}, Right: $3}
   }
 | a_expr GREATER_EQUALS a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{">="}, Right: $3}
   }
 | a_expr NOT_EQUALS a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"!="}, Right: $3}
   }
 | a_expr qual_Op a_expr       %prec Op
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: $2, Right: $3}
   }
 | qual_Op a_expr          %prec Op
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: $1, Expr: $2}
   }
 | a_expr qual_Op          %prec POSTFIXOP
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = PostfixExpr{Expr: $1, Operator: $2}
   }
 | a_expr AND a_expr
   {
-    $$ = BooleanExpr{Left: $1, Operator: "and", Right: $3}
+    yylex.(*sqlLex).nest($<pos>1)
+    left := yylex.(*sqlLex).commentedRange($<pos>1, $<pos>2-1, $1)
+    right := yylex.(*sqlLex).commented($<pos>3, $3)
+    $$ = BooleanExpr{Left: left, Operator: "and", Right: right}
   }
 | a_expr OR a_expr
   {
-    $$ = BooleanExpr{Left: $1, Operator: "or", Right: $3}
+    yylex.(*sqlLex).nest($<pos>1)
+    left := yylex.(*sqlLex).commentedRange($<pos>1, $<pos>2-1, $1)
+    right := yylex.(*sqlLex).commented($<pos>3, $3)
+    $$ = BooleanExpr{Left: left, Operator: "or", Right: right}
   }
 | NOT a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = NotExpr{Expr: $2}
   }
 | NOT_LA a_expr           %prec NOT
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = NotExpr{Expr: $2}
   }
 | a_expr LIKE a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "like", Right: $3}
   }
 | a_expr LIKE a_expr ESCAPE a_expr          %prec LIKE
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "like", Right: $3, Escape: $5}
   }
 | a_expr NOT_LA LIKE a_expr             %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not like", Right: $4}
   }
 | a_expr NOT_LA LIKE a_expr ESCAPE a_expr     %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not like", Right: $4, Escape: $6}
   }
 | a_expr ILIKE a_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "ilike", Right: $3}
   }
 | a_expr ILIKE a_expr ESCAPE a_expr         %prec ILIKE
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "ilike", Right: $3, Escape: $5}
   }
 | a_expr NOT_LA ILIKE a_expr            %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not ilike", Right: $4}
   }
 | a_expr NOT_LA ILIKE a_expr ESCAPE a_expr      %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not ilike", Right: $4, Escape: $6}
   }
 
 | a_expr SIMILAR TO a_expr              %prec SIMILAR
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "similar to", Right: $4}
   }
 | a_expr SIMILAR TO a_expr ESCAPE a_expr      %prec SIMILAR
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "similar to", Right: $4, Escape: $6}
   }
 | a_expr NOT_LA SIMILAR TO a_expr         %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not similar to", Right: $5}
   }
 | a_expr NOT_LA SIMILAR TO a_expr ESCAPE a_expr   %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TextOpWithEscapeExpr{Left: $1, Operator: "not similar to", Right: $5, Escape: $7}
   }
 /* NullTest clause
@@ -1078,102 +1321,130 @@ a_expr:
  */
 | a_expr IS NULL_P              %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "null"}
   }
 | a_expr ISNULL
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "null"}
   }
 | a_expr IS NOT NULL_P            %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "null"}
   }
 | a_expr NOTNULL
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "null"}
   }
 | row OVERLAPS row
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"overlaps"}, Right: $3}
   }
 | a_expr IS TRUE_P              %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "true"}
   }
 | a_expr IS NOT TRUE_P            %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "true"}
   }
 | a_expr IS FALSE_P             %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "false"}
   }
 | a_expr IS NOT FALSE_P           %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "false"}
   }
 | a_expr IS UNKNOWN             %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "unknown"}
   }
 | a_expr IS NOT UNKNOWN           %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "unknown"}
   }
 | a_expr IS DISTINCT FROM a_expr      %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"is distinct from"}, Right: $5}
   }
 | a_expr IS NOT DISTINCT FROM a_expr    %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"is not distinct from"}, Right: $6}
   }
 | a_expr IS OF '(' type_list ')'      %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsOfExpr{Expr: $1, Types: $5}
   }
 | a_expr IS NOT OF '(' type_list ')'    %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsOfExpr{Expr: $1, Not: true, Types: $6}
   }
 | a_expr BETWEEN opt_asymmetric b_expr AND a_expr   %prec BETWEEN
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BetweenExpr{Expr: $1, Left: $4, Right: $6}
   }
 | a_expr NOT_LA BETWEEN opt_asymmetric b_expr AND a_expr %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BetweenExpr{Expr: $1, Not: true, Left: $5, Right: $7}
   }
 | a_expr BETWEEN SYMMETRIC b_expr AND a_expr      %prec BETWEEN
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BetweenExpr{Expr: $1, Symmetric: true, Left: $4, Right: $6}
   }
 | a_expr NOT_LA BETWEEN SYMMETRIC b_expr AND a_expr   %prec NOT_LA
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BetweenExpr{Expr: $1, Not: true, Symmetric: true, Left: $5, Right: $7}
   }
 | a_expr IN_P in_expr
   {
-    $$ = InExpr{Value: $1, In: $3}
+    x := yylex.(*sqlLex)
+    x.nest($<pos>1)
+    $$ = InExpr{Value: $1, In: withParenComments($3, x.claimTokenComments($<pos>2))}
   }
 | a_expr NOT_LA IN_P in_expr            %prec NOT_LA
   {
-    $$ = InExpr{Value: $1, Not: true, In: $4}
+    x := yylex.(*sqlLex)
+    x.nest($<pos>1)
+    $$ = InExpr{Value: $1, Not: true, In: withParenComments($4, x.claimTokenComments($<pos>2, $<pos>3))}
   }
 | a_expr subquery_Op sub_type select_with_parens  %prec Op
   {
-    $$ = SubqueryOpExpr{Value: $1, Op: $2, Type: $3, Query: $4}
+    yylex.(*sqlLex).nest($<pos>1)
+    c := yylex.(*sqlLex).claimCommentsRange($<pos>2, $<pos>4-1)
+    $$ = SubqueryOpExpr{Value: $1, Op: $2, Type: $3, Query: withParenComments($4, c)}
   }
 | a_expr subquery_Op sub_type '(' a_expr ')'    %prec Op
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = SubqueryOpExpr{Value: $1, Op: $2, Type: $3, Query: ParenExpr{Expr: $5}}
   }
 | a_expr IS DOCUMENT_P          %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "document"}
   }
 | a_expr IS NOT DOCUMENT_P        %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "document"}
   }
 
@@ -1189,102 +1460,127 @@ a_expr:
 b_expr:
   c_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = $1
   }
 | b_expr TYPECAST Typename
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = TypecastExpr{Expr: $1, Typename: $3}
   }
 | '+' b_expr          %prec UMINUS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: AnyName{"+"}, Expr: $2}
   }
 | '-' b_expr          %prec UMINUS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: AnyName{"-"}, Expr: $2}
   }
 | b_expr '+' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"+"}, Right: $3}
   }
 | b_expr '-' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"-"}, Right: $3}
   }
 | b_expr '*' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"*"}, Right: $3}
   }
 | b_expr '/' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"/"}, Right: $3}
   }
 | b_expr '%' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"%"}, Right: $3}
   }
 | b_expr '^' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"^"}, Right: $3}
   }
 | b_expr '<' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"<"}, Right: $3}
   }
 | b_expr '>' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{">"}, Right: $3}
   }
 | b_expr '=' b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"="}, Right: $3}
   }
 | b_expr LESS_EQUALS b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"<="}, Right: $3}
   }
 | b_expr GREATER_EQUALS b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{">="}, Right: $3}
   }
 | b_expr NOT_EQUALS b_expr
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"!="}, Right: $3}
   }
 | b_expr qual_Op b_expr       %prec Op
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: $2, Right: $3}
   }
 | qual_Op b_expr          %prec Op
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = UnaryExpr{Operator: $1, Expr: $2}
   }
 | b_expr qual_Op          %prec POSTFIXOP
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = PostfixExpr{Expr: $1, Operator: $2}
   }
 | b_expr IS DISTINCT FROM b_expr    %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"is distinct from"}, Right: $5}
   }
 | b_expr IS NOT DISTINCT FROM b_expr  %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = BinaryExpr{Left: $1, Operator: AnyName{"is not distinct from"}, Right: $6}
   }
 | b_expr IS OF '(' type_list ')'    %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsOfExpr{Expr: $1, Types: $5}
   }
 | b_expr IS NOT OF '(' type_list ')'  %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsOfExpr{Expr: $1, Not: true, Types: $6}
   }
 | b_expr IS DOCUMENT_P          %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Op: "document"}
   }
 | b_expr IS NOT DOCUMENT_P        %prec IS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = IsExpr{Expr: $1, Not: true, Op: "document"}
   }
 
@@ -1296,48 +1592,91 @@ b_expr:
  * inside parentheses, such as function arguments; that cannot introduce
  * ambiguity to the b_expr syntax.
  */
-c_expr:
-  columnref   { $$ = $1 }
-| AexprConst  { $$ = $1 }
 /*
-  PARAM does not occur directly is the grammar -- it is used for output
-  params of functions. See outfuncs.c in PostgreSQL source.
+ * A c_expr claims the comments on its tokens that no nested node has, so that
+ * a comment in the middle of an expression stays next to the code it is in.
+ * A parenthesized query is left bare for tableRef; it has its comments as
+ * ParenComments, where the trailing ones of EXISTS and ARRAY go too, so that
+ * they are rendered before the line break after the query.
+ */
+c_expr:
+  columnref
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
+| AexprConst
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
 | PARAM opt_indirection
-*/
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, NewParamRef($1, $2))
+  }
+| PLACEHOLDER
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, Placeholder{Style: yylex.(*sqlLex).placeholders, Text: $1})
+  }
 
 | '(' a_expr ')' opt_indirection
   {
-    $$ = ParenExpr{Expr: $2, Indirection: $4}
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, ParenExpr{Expr: $2, Indirection: $4})
+  }
+| case_expr
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
+| func_expr
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
   }
-| case_expr { $$ = $1 }
-| func_expr { $$ = $1 }
 | select_with_parens      %prec UMINUS
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $$ = $1
   }
 | select_with_parens indirection
   {
+    yylex.(*sqlLex).nest($<pos>1)
     $1.ParenWrapped = false
-    $$ = ParenExpr{Expr: $1, Indirection: $2}
+    e := withComments(ParenExpr{Expr: $1, Indirection: $2}, $1.ParenComments)
+    $$ = yylex.(*sqlLex).commented($<pos>1, e)
   }
 | EXISTS select_with_parens
   {
-    $$ = ExistsExpr(*$2)
+    x := yylex.(*sqlLex)
+    x.nest($<pos>1)
+    c := x.claimTokenComments($<pos>1)
+    $2.ParenComments.Trailing = append(c.Trailing, $2.ParenComments.Trailing...)
+    $$ = withComments(ExistsExpr(*$2), Comments{Leading: c.Leading})
   }
 | ARRAY select_with_parens
   {
-    $$ = ArraySubselect(*$2)
+    x := yylex.(*sqlLex)
+    x.nest($<pos>1)
+    c := x.claimTokenComments($<pos>1)
+    $2.ParenComments.Trailing = append(c.Trailing, $2.ParenComments.Trailing...)
+    $$ = withComments(ArraySubselect(*$2), Comments{Leading: c.Leading})
   }
 | ARRAY array_expr {
-  $$ = ArrayConstructorExpr($2)
+  yylex.(*sqlLex).nest($<pos>1)
+  $$ = yylex.(*sqlLex).commented($<pos>1, ArrayConstructorExpr($2))
 }
 | explicit_row
   {
-    $$ = $1
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
   }
 | implicit_row
   {
-    $$ = $1
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
   }
 /* TODO
 | GROUPING '(' expr_list ')'
@@ -1684,38 +2023,134 @@ identifierSeq:
     $$ = append($1, $3)
   }
 
-joinExpr:
-  aliasableExpr ',' aliasableExpr
+from_clause:
+  FROM from_list
+  {
+    $$ = &FromClause{Expr: $2, Comments: yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>1)}
+  }
+| /*EMPTY*/  { $$ = nil }
+
+from_list:
+  table_ref
+| from_list ',' table_ref
   {
-    $$ = JoinExpr{Left: $1, Join: ",", Right: $3}
+    $$ = yylex.(*sqlLex).commented($<pos>1, JoinExpr{Left: $1, Join: ",", Right: $3})
   }
-| aliasableExpr CROSS JOIN aliasableExpr
+
+/*
+ * LATERAL applies only to subqueries and function calls; a table cannot refer
+ * to the FROM items before it.
+ */
+table_ref:
+  relation_expr opt_alias_clause
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>1, tableRef($1, $2))
+  }
+| relation_expr opt_alias_clause tablesample_clause
   {
-    $$ = JoinExpr{Left: $1, Join: "cross join", Right: $4}
+    $3.Relation = tableRef($1, $2)
+    $$ = yylex.(*sqlLex).commented($<pos>1, $3)
   }
-| aliasableExpr NATURAL JOIN aliasableExpr
+| func_table func_alias_clause
   {
-    $$ = JoinExpr{Left: $1, Join: "natural join", Right: $4}
+    $$ = yylex.(*sqlLex).commented($<pos>1, tableRef($1, $2))
   }
-| aliasableExpr JOIN aliasableExpr USING '(' identifierSeq ')'
+| LATERAL_P func_table func_alias_clause
   {
-    $$ = JoinExpr{Left: $1, Join: "join", Right: $3, Using: $6}
+    $$ = yylex.(*sqlLex).commented($<pos>1, LateralExpr{Expr: tableRef($2, $3)})
   }
-| aliasableExpr JOIN aliasableExpr ON a_expr
+| select_with_parens opt_alias_clause
   {
-    $$ = JoinExpr{Left: $1, Join: "join", Right: $3, On: $5}
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, tableRef($1, $2))
+  }
+| LATERAL_P select_with_parens opt_alias_clause
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, LateralExpr{Expr: tableRef($2, $3)})
+  }
+| joined_table
+| '(' joined_table ')' AS IDENT
+  {
+    $$ = ParenJoinExpr{Join: $2, Alias: $5}
+  }
+| '(' joined_table ')' IDENT
+  {
+    $$ = ParenJoinExpr{Join: $2, Alias: $4}
   }
 
-from_clause:
-  FROM aliasableExpr
+/*
+ * It may seem silly to separate joined_table from table_ref, but there is
+ * method in SQL's madness: if you don't do it this way you get reduce-
+ * reduce conflicts, because it's not clear to the parser generator whether
+ * to expect alias_clause after ')' or not.  For the same reason we must
+ * treat 'JOIN' and 'join_type JOIN' separately, rather than allowing
+ * join_type to expand to empty; if we try it, the parser generator can't
+ * figure out when to reduce an empty join_type right after table_ref.
+ *
+ * Joins are left-associative, so a chain of joins is a JoinExpr whose Left is
+ * the join before it.
+ */
+joined_table:
+  '(' joined_table ')'
   {
-    $$ = &FromClause{Expr: $2}
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = ParenJoinExpr{Join: $2}
   }
-| FROM joinExpr
+| table_ref CROSS JOIN table_ref
   {
-    $$ = &FromClause{Expr: $2}
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, JoinExpr{Left: $1, Join: "cross join", Right: $4})
+  }
+| table_ref join_type JOIN table_ref join_qual
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $5.Left, $5.Join, $5.Right = $1, $2+" join", $4
+    $$ = yylex.(*sqlLex).commented($<pos>1, $5)
+  }
+| table_ref JOIN table_ref join_qual
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $4.Left, $4.Join, $4.Right = $1, "join", $3
+    $$ = yylex.(*sqlLex).commented($<pos>1, $4)
+  }
+| table_ref NATURAL join_type JOIN table_ref
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, JoinExpr{Left: $1, Join: "natural " + $3 + " join", Right: $5})
+  }
+| table_ref NATURAL JOIN table_ref
+  {
+    yylex.(*sqlLex).nest($<pos>1)
+    $$ = yylex.(*sqlLex).commented($<pos>1, JoinExpr{Left: $1, Join: "natural join", Right: $4})
+  }
+
+join_type:
+  FULL opt_outer    { $$ = "full" + $2 }
+| LEFT opt_outer    { $$ = "left" + $2 }
+| RIGHT opt_outer   { $$ = "right" + $2 }
+| INNER_P           { $$ = "inner" }
+
+/* OUTER is just noise... */
+opt_outer:
+  OUTER_P     { $$ = " outer" }
+| /*EMPTY*/   { $$ = "" }
+
+/* JOIN qualification clauses
+ * Possibilities are:
+ *  USING ( column list ) allows only unqualified column names,
+ *                        which must match between tables.
+ *  ON expr allows more general qualifications.
+ */
+join_qual:
+  USING '(' identifierSeq ')'
+  {
+    $$ = JoinExpr{Using: $3}
+  }
+| ON a_expr
+  {
+    $$ = JoinExpr{On: $2}
   }
-| /*EMPTY*/  { $$ = nil }
 
 
 opt_nowait_or_skip:
@@ -1760,11 +2195,11 @@ subquery_Op:
 expr_list:
   a_expr
   {
-    $$ = []Expr{$1}
+    $$ = []Expr{yylex.(*sqlLex).commented($<pos>1, $1)}
   }
 | expr_list ',' a_expr
   {
-    $$ = append($1, $3)
+    $$ = append($1, yylex.(*sqlLex).commented($<pos>3, $3))
   }
 
 /* function arguments can have names */
@@ -1781,15 +2216,15 @@ func_arg_list:
 func_arg_expr:
   a_expr
   {
-    $$ = FuncArg{Expr: $1}
+    $$ = FuncArg{Expr: $1, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 | param_name COLON_EQUALS a_expr
   {
-    $$ = FuncArg{Name: $1, NameOp: ":=", Expr: $3}
+    $$ = FuncArg{Name: $1, NameOp: ":=", Expr: $3, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 | param_name EQUALS_GREATER a_expr
   {
-    $$ = FuncArg{Name: $1, NameOp: "=>", Expr: $3}
+    $$ = FuncArg{Name: $1, NameOp: "=>", Expr: $3, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 
 type_list:
@@ -1945,6 +2380,96 @@ trim_list:
     $$ = TrimList{Right: $1}
   }
 
+/*****************************************************************************
+ *
+ *    QUERY:
+ *        INSERT STATEMENTS
+ *
+ *****************************************************************************/
+
+InsertStmt:
+  INSERT INTO insert_target insert_rest opt_on_duplicate_key_update
+  {
+    x := yylex.(*sqlLex)
+    $4.InsertComments = x.claimTokenComments($<pos>1)
+    $4.IntoComments = x.claimTokenComments($<pos>2)
+    $4.TableComments = x.claimCommentsRange($<pos>3, $<pos>4-1)
+    $4.Table = $3.Table
+    $4.Alias = $3.Alias
+    $4.OnDuplicateKeyUpdate = $5
+    $$ = $4
+  }
+
+insert_target:
+  qualified_name
+  {
+    $$ = &InsertStmt{Table: $1}
+  }
+| qualified_name AS ColId
+  {
+    $$ = &InsertStmt{Table: $1, Alias: $3}
+  }
+
+insert_rest:
+  SelectStmt
+  {
+    $$ = &InsertStmt{Select: $1}
+  }
+| '(' insert_column_list ')' SelectStmt
+  {
+    $$ = &InsertStmt{Columns: $2, Select: $4, ColumnComments: yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>4-1)}
+  }
+
+insert_column_list:
+  insert_column_item
+  {
+    $$ = []ColumnRef{$1}
+  }
+| insert_column_list ',' insert_column_item
+  {
+    $$ = append($1, $3)
+  }
+
+insert_column_item:
+  ColId opt_indirection
+  {
+    $$ = ColumnRef{Name: $1, Indirection: $2}
+  }
+
+/* MySQL syntax; the lexer makes DUPLICATE a keyword only here */
+opt_on_duplicate_key_update:
+  ON DUPLICATE KEY UPDATE set_clause_list
+  {
+    yylex.(*sqlLex).requireFeature(DuplicateKeyUpdateFeature, "ON DUPLICATE KEY UPDATE", $<pos>1)
+    $$ = $5
+  }
+| /*EMPTY*/
+  {
+    $$ = nil
+  }
+
+set_clause_list:
+  set_clause
+  {
+    $$ = []SetClause{$1}
+  }
+| set_clause_list ',' set_clause
+  {
+    $$ = append($1, $3)
+  }
+
+set_clause:
+  set_target '=' a_expr
+  {
+    $$ = SetClause{Target: $1, Value: $3}
+  }
+
+set_target:
+  ColId opt_indirection
+  {
+    $$ = ColumnRef{Name: $1, Indirection: $2}
+  }
+
 /*****************************************************************************
  *
  *    QUERY:
@@ -1999,9 +2524,16 @@ select_with_parens:
   '(' select_no_parens ')'
   {
     $2.ParenWrapped = true
+    $2.ParenComments = yylex.(*sqlLex).claimComments($<pos>1)
+    $$ = $2
+  }
+| '(' select_with_parens ')'
+  {
+    c := yylex.(*sqlLex).claimComments($<pos>1)
+    $2.ParenComments.Leading = append(c.Leading, $2.ParenComments.Leading...)
+    $2.ParenComments.Trailing = append($2.ParenComments.Trailing, c.Trailing...)
     $$ = $2
   }
-| '(' select_with_parens ')'      { $$ = $2 }
 
 select_no_parens:
   simple_select
@@ -2029,6 +2561,33 @@ select_no_parens:
     $1.LockingClause = $4
     $$ = $1;
   }
+| with_clause select_clause
+  {
+    $2.WithClause = $1
+    $$ = $2
+  }
+| with_clause select_clause sort_clause
+  {
+    $2.WithClause = $1
+    $2.OrderClause = $3
+    $$ = $2
+  }
+| with_clause select_clause opt_sort_clause for_locking_clause opt_select_limit
+  {
+    $2.WithClause = $1
+    $2.OrderClause = $3
+    $2.LockingClause = $4
+    $2.LimitClause = $5
+    $$ = $2
+  }
+| with_clause select_clause opt_sort_clause select_limit opt_for_locking_clause
+  {
+    $2.WithClause = $1
+    $2.OrderClause = $3
+    $2.LimitClause = $4
+    $2.LockingClause = $5
+    $$ = $2
+  }
 
 select_clause:
   simple_select
@@ -2039,6 +2598,102 @@ select_clause:
   }
 | select_with_parens
 
+/*
+ * SQL standard WITH clause looks like:
+ *
+ * WITH [ RECURSIVE ] <query name> [ (<column>,...) ]
+ *    AS [ [ NOT ] MATERIALIZED ] (query) [ SEARCH or CYCLE clause ]
+ *
+ * WITH_LA is a WITH followed by TIME or ORDINALITY, which may name a query.
+ */
+with_clause:
+  WITH cte_list
+  {
+    $$ = &WithClause{CTEs: $2}
+  }
+| WITH_LA cte_list
+  {
+    $$ = &WithClause{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &WithClause{Recursive: true, CTEs: $3}
+  }
+
+cte_list:
+  common_table_expr
+  {
+    $$ = []CommonTableExpr{$1}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  ColId opt_name_list AS opt_materialized '(' SelectStmt ')' opt_search_clause opt_cycle_clause
+  {
+    x := yylex.(*sqlLex)
+    x.nest($<pos>1)
+    $$ = CommonTableExpr{
+      Name: $1,
+      Columns: $2,
+      Materialized: $4,
+      Query: $6,
+      Search: $8,
+      Cycle: $9,
+      Comments: x.claimCommentsRange($<pos>1, $<pos>6-1),
+      EndComments: x.claimComments($<pos>7),
+    }
+  }
+
+opt_materialized:
+  MATERIALIZED       { $$ = "materialized" }
+| NOT MATERIALIZED   { $$ = "not materialized" }
+| /*EMPTY*/          { $$ = "" }
+
+opt_search_clause:
+  SEARCH DEPTH FIRST_P BY columnList SET ColId
+  {
+    $$ = &SearchClause{Columns: $5, SeqColumn: $7}
+  }
+| SEARCH BREADTH FIRST_P BY columnList SET ColId
+  {
+    $$ = &SearchClause{BreadthFirst: true, Columns: $5, SeqColumn: $7}
+  }
+| /*EMPTY*/
+  {
+    $$ = nil
+  }
+
+opt_cycle_clause:
+  CYCLE columnList SET ColId TO AexprConst DEFAULT AexprConst USING ColId
+  {
+    $$ = &CycleClause{Columns: $2, MarkColumn: $4, MarkValue: $6, MarkDefault: $8, PathColumn: $10}
+  }
+| CYCLE columnList SET ColId USING ColId
+  {
+    $$ = &CycleClause{Columns: $2, MarkColumn: $4, PathColumn: $6}
+  }
+| /*EMPTY*/
+  {
+    $$ = nil
+  }
+
+opt_name_list:
+  '(' columnList ')'   { $$ = $2 }
+| /*EMPTY*/            { $$ = nil }
+
+columnList:
+  ColId
+  {
+    $$ = []string{$1}
+  }
+| columnList ',' ColId
+  {
+    $$ = append($1, $3)
+  }
+
 /*
  * This rule parses SELECT statements that can appear within set operations,
  * including UNION, INTERSECT and EXCEPT.  '(' and ')' can be used to specify
@@ -2068,6 +2723,7 @@ simple_select:
   group_clause having_clause window_clause
   {
     ss := &SimpleSelect{}
+    ss.Comments = yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>1)
     ss.TargetList = $3
     ss.IntoClause = $4
     ss.FromClause = $5
@@ -2082,6 +2738,7 @@ simple_select:
   group_clause having_clause window_clause
   {
     ss := &SimpleSelect{}
+    ss.Comments = yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>3-1)
     ss.DistinctList = $2
     ss.TargetList = $3
     ss.IntoClause = $4
@@ -2106,6 +2763,7 @@ simple_select:
   }
 | select_clause UNION all_or_distinct select_clause
   {
+    yylex.(*sqlLex).nest($<pos>1)
     ss := &SimpleSelect{}
     ss.LeftSelect = $1
     ss.SetOp = "union"
@@ -2115,6 +2773,7 @@ simple_select:
   }
 | select_clause INTERSECT all_or_distinct select_clause
   {
+    yylex.(*sqlLex).nest($<pos>1)
     ss := &SimpleSelect{}
     ss.LeftSelect = $1
     ss.SetOp = "intersect"
@@ -2124,6 +2783,7 @@ simple_select:
   }
 | select_clause EXCEPT all_or_distinct select_clause
   {
+    yylex.(*sqlLex).nest($<pos>1)
     ss := &SimpleSelect{}
     ss.LeftSelect = $1
     ss.SetOp = "except"
@@ -2209,7 +2869,11 @@ opt_sort_clause:
 | /*EMPTY*/    { $$ = nil }
 
 sort_clause:
-  ORDER BY sortby_list  { $$ = $3 }
+  ORDER BY sortby_list
+  {
+    $3.Comments = yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>2)
+    $$ = $3
+  }
 
 sortby_list:
   sortby
@@ -2227,10 +2891,12 @@ sortby:
 a_expr USING qual_all_Op opt_nulls_order
   {
     $$ = OrderExpr{Expr: $1, Using: $3, Nulls: $4}
+    $$.Comments = yylex.(*sqlLex).claimComments($<pos>1)
   }
 | a_expr opt_asc_desc opt_nulls_order
   {
     $$ = OrderExpr{Expr: $1, Order: $2, Nulls: $3}
+    $$.Comments = yylex.(*sqlLex).claimComments($<pos>1)
   }
 
 /*
@@ -2254,7 +2920,10 @@ a_expr USING qual_all_Op opt_nulls_order
  * GroupingSet node of some type.
  */
 group_clause:
-  GROUP_P BY group_by_list  { $$ = &GroupByClause{Exprs: $3} }
+  GROUP_P BY group_by_list
+  {
+    $$ = &GroupByClause{Exprs: $3, Comments: yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>2)}
+  }
 | /*EMPTY*/               { $$ = nil }
 
 group_by_list:
@@ -2269,6 +2938,9 @@ group_by_list:
 
 group_by_item:
   a_expr
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
 /* TODO      | empty_grouping_set          { $$ = $1; }
       | cube_clause             { $$ = $1; }
       | rollup_clause             { $$ = $1; }
@@ -2276,7 +2948,10 @@ group_by_item:
 */
 
 having_clause:
-  HAVING a_expr  { $$ = $2 }
+  HAVING a_expr
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>2, $2)
+  }
 | /*EMPTY*/      { $$ = nil }
 
 for_locking_clause:
@@ -2338,7 +3013,7 @@ window_definition_list:
 window_definition:
   ColId AS window_specification
   {
-    $$ = WindowDefinition{Name: $1, Specification: $3}
+    $$ = WindowDefinition{Name: $1, Specification: $3, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 
 over_clause:
@@ -2357,6 +3032,11 @@ window_specification:
   '(' opt_existing_window_name opt_partition_clause opt_sort_clause opt_frame_clause ')'
   {
     $$ = WindowSpecification{ExistingName: $2, PartitionClause: $3, OrderClause: $4, FrameClause: $5}
+    if $2 != "" {
+      $$.Comments = yylex.(*sqlLex).claimTokenComments($<pos>1, $<pos>2)
+    } else {
+      $$.Comments = yylex.(*sqlLex).claimTokenComments($<pos>1)
+    }
   }
 
 /*
@@ -2476,6 +3156,12 @@ select_limit:
   {
     $$ = &LimitClause{Offset: $1}
   }
+  /* MySQL syntax */
+| LIMIT select_offset_value ',' select_offset_value
+  {
+    yylex.(*sqlLex).requireFeature(LimitCommaFeature, "LIMIT #,# syntax", $<pos>3)
+    $$ = &LimitClause{Limit: $4, Offset: $2, Comma: true}
+  }
 
 
 opt_select_limit:
@@ -2505,14 +3191,20 @@ offset_clause:
   }
 
 select_limit_value:
-  a_expr      { $$ = $1; }
+  a_expr
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
 | ALL
   {
     $$ = nil
   }
 
 select_offset_value:
-  a_expr   { $$ = $1 }
+  a_expr
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
 
 /*
  * Allowing full expressions without parentheses causes various parsing
@@ -2544,11 +3236,12 @@ first_or_next:
 values_clause:
 VALUES ctext_row
   {
-    $$ = ValuesClause{$2}
+    $$ = &ValuesClause{Rows: []ValuesRow{$2}, Comments: yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>1)}
   }
 | values_clause ',' ctext_row
   {
-    $$ = append($1, $3)
+    $1.Rows = append($1.Rows, $3)
+    $$ = $1
   }
 
 
@@ -2564,7 +3257,11 @@ VALUES ctext_row
 
 
 where_clause:
-  WHERE a_expr   { $$ = &WhereClause{Expr: $2} }
+  WHERE a_expr
+  {
+    expr := yylex.(*sqlLex).commented($<pos>2, $2)
+    $$ = &WhereClause{Expr: expr, Comments: yylex.(*sqlLex).claimCommentsRange($<pos>1, $<pos>1)}
+  }
 | /*EMPTY*/      { $$ = nil }
 
 
@@ -2663,7 +3360,7 @@ in_expr:
   }
 | '(' expr_list ')'
   {
-    $$ = ValuesRow($2)
+    $$ = ValuesRow{Exprs: $2, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 
 /*
@@ -2672,11 +3369,29 @@ in_expr:
  *  CASE WHEN a = b THEN c ... ELSE d END
  * - Implicit argument
  *  CASE a WHEN b THEN c ... ELSE d END
+ *
+ * The comments in a CASE are claimed by its parts so they are rendered where
+ * they are rather than around the whole expression.
  */
 case_expr:
-  CASE case_arg when_clause_list case_default END_P
+  CASE case_arg when_clause_list END_P
   {
-    $$ = CaseExpr{CaseArg: $2, WhenClauses: $3, Default: $4}
+    x := yylex.(*sqlLex)
+    $$ = CaseExpr{
+      CaseArg: $2, WhenClauses: $3,
+      Comments: x.claimTokenComments($<pos>1),
+      EndComments: x.claimTokenComments($<pos>4),
+    }
+  }
+| CASE case_arg when_clause_list ELSE a_expr END_P
+  {
+    x := yylex.(*sqlLex)
+    $$ = CaseExpr{
+      CaseArg: $2, WhenClauses: $3, Default: x.commentedRange($<pos>5, $<pos>6-1, $5),
+      Comments: x.claimTokenComments($<pos>1),
+      DefaultComments: x.claimTokenComments($<pos>4),
+      EndComments: x.claimTokenComments($<pos>6),
+    }
   }
 
 when_clause_list:
@@ -2693,15 +3408,16 @@ when_clause_list:
 when_clause:
   WHEN a_expr THEN a_expr
   {
-    $$ = WhenClause{When: $2, Then: $4}
+    x := yylex.(*sqlLex)
+    $$ = WhenClause{
+      When: x.commentedRange($<pos>2, $<pos>3-1, $2),
+      Then: x.commented($<pos>4, $4),
+      Comments: x.claimTokenComments($<pos>1, $<pos>3),
+    }
   }
 
-case_default:
-  ELSE a_expr   { $$ = $2 }
-| /*EMPTY*/     { $$ = nil }
-
 case_arg:
-  a_expr        { $$ = $1 }
+  a_expr        { $$ = yylex.(*sqlLex).commented($<pos>1, $1) }
 | /*EMPTY*/     { $$ = nil }
 
 columnref:
@@ -2773,11 +3489,11 @@ ctext_expr:
 ctext_expr_list:
   ctext_expr
   {
-    $$ = ValuesRow{$1}
+    $$ = []Expr{yylex.(*sqlLex).commented($<pos>1, $1)}
   }
 | ctext_expr_list ',' ctext_expr
   {
-    $$ = append($1, $3)
+    $$ = append($1, yylex.(*sqlLex).commented($<pos>3, $3))
   }
 
 /*
@@ -2788,7 +3504,7 @@ ctext_expr_list:
 ctext_row:
   '(' ctext_expr_list ')'
   {
-    $$ = $2
+    $$ = ValuesRow{Exprs: $2, Comments: yylex.(*sqlLex).claimComments($<pos>1)}
   }
 
 /*****************************************************************************
@@ -2811,16 +3527,19 @@ target_list:
 target_el:
   a_expr AS ColLabel
   {
-    $$ = AliasedExpr{Expr: $1, Alias: $3}
+    $$ = yylex.(*sqlLex).commented($<pos>1, AliasedExpr{Expr: $1, Alias: $3})
   }
 | a_expr IDENT
   {
-    $$ = AliasedExpr{Expr: $1, Alias: $2}
+    $$ = yylex.(*sqlLex).commented($<pos>1, AliasedExpr{Expr: $1, Alias: $2})
   }
 | a_expr
+  {
+    $$ = yylex.(*sqlLex).commented($<pos>1, $1)
+  }
 | '*'
   {
-    $$ = ColumnRef{Name: "*"}
+    $$ = yylex.(*sqlLex).commented($<pos>1, ColumnRef{Name: "*"})
   }
 
 
@@ -2857,6 +3576,9 @@ qualified_name:
   {
     $$ = AnyName{$1}
     for _, s := range $2 {
+      if s.Name == "" || s.Name == "*" {
+        yylex.Error("improper qualified name")
+      }
       $$ = append($$, s.Name)
     }
   }
@@ -2966,7 +3688,7 @@ Iconst
   }
 
 Iconst:   ICONST { $$ = IntegerConst($1) }
-Sconst:   SCONST { $$ = StringConst($1) }
+Sconst:   SCONST { $$ = NewStringConst($1) }
 
 SignedIconst:
   Iconst      { $$ = $1 }
@@ -3034,6 +3756,7 @@ unreserved_keyword:
 | BACKWARD
 | BEFORE
 | BEGIN_P
+| BREADTH
 | BY
 | CACHE
 | CALLED
@@ -3075,6 +3798,7 @@ unreserved_keyword:
 | DELETE_P
 | DELIMITER
 | DELIMITERS
+| DEPTH
 | DICTIONARY
 | DISABLE_P
 | DISCARD
@@ -0,0 +1,77 @@
+package sqlfmt
+
+import (
+	"bytes"
+	"context"
+)
+
+// ParseOptions configures ParseString.
+type ParseOptions struct {
+	// Placeholders is the driver-specific placeholder syntax to recognize in
+	// addition to $1 positional parameters.
+	Placeholders PlaceholderStyle
+
+	// Dialect is the SQL dialect of the source. The default is PostgreSQL.
+	Dialect *Dialect
+
+	// Recover keeps statements that cannot be parsed as *VerbatimStmt instead
+	// of failing. See WithErrorRecovery.
+	Recover bool
+
+	// Limits bounds the input for parsing untrusted SQL.
+	Limits
+}
+
+// FormatOptions configures Format.
+type FormatOptions struct {
+	ParseOptions
+
+	// UpperCase renders keywords and symbols in upper case.
+	UpperCase bool
+}
+
+// ParseString parses the statements of the script src in order.
+func ParseString(src string, opts ParseOptions) ([]Stmt, error) {
+	return ParseStringContext(context.Background(), src, opts)
+}
+
+// ParseStringContext is like ParseString but stops with ctx.Err() once ctx is
+// done.
+func ParseStringContext(ctx context.Context, src string, opts ParseOptions) ([]Stmt, error) {
+	lexer := NewSqlLexer(src, append(opts.lexerOptions(), WithContext(ctx))...)
+	return Parse(lexer)
+}
+
+// lexerOptions returns the lexer options opts stands for.
+func (opts ParseOptions) lexerOptions() []LexerOption {
+	options := []LexerOption{WithDialect(opts.Dialect), WithPlaceholderStyle(opts.Placeholders), WithLimits(opts.Limits)}
+	if opts.Recover {
+		options = append(options, WithErrorRecovery())
+	}
+	return options
+}
+
+// Format returns the script src formatted.
+func Format(src string, opts FormatOptions) (string, error) {
+	return FormatContext(context.Background(), src, opts)
+}
+
+// FormatContext is like Format but stops with ctx.Err() once ctx is done.
+func FormatContext(ctx context.Context, src string, opts FormatOptions) (string, error) {
+	stmts, err := ParseStringContext(ctx, src, opts.ParseOptions)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	r := NewTextRenderer(&buf)
+	r.UpperCase = opts.UpperCase
+	for _, stmt := range stmts {
+		stmt.RenderTo(r)
+	}
+	if err := r.Flush(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,60 @@
+package sqlfmt_test
+
+import (
+	"testing"
+
+	"github.com/jackc/sqlfmt"
+)
+
+func TestParseString(t *testing.T) {
+	stmts, err := sqlfmt.ParseString("select 1;; select :a", sqlfmt.ParseOptions{Placeholders: sqlfmt.ColonPlaceholders})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stmts) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(stmts))
+	}
+	if _, ok := stmts[0].(*sqlfmt.SelectStmt); !ok {
+		t.Errorf("expected statement 0 to be *SelectStmt, got %T", stmts[0])
+	}
+	if _, ok := stmts[1].(*sqlfmt.EmptyStmt); !ok {
+		t.Errorf("expected statement 1 to be *EmptyStmt, got %T", stmts[1])
+	}
+	if _, ok := stmts[2].(*sqlfmt.SelectStmt); !ok {
+		t.Errorf("expected statement 2 to be *SelectStmt, got %T", stmts[2])
+	}
+
+	_, err = sqlfmt.ParseString("select 1 +", sqlfmt.ParseOptions{})
+	if _, ok := err.(*sqlfmt.ParseError); !ok {
+		t.Errorf("expected *ParseError, got %#v", err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		src      string
+		opts     sqlfmt.FormatOptions
+		expected string
+	}{
+		{"select a from t", sqlfmt.FormatOptions{}, "select\n  a\nfrom\n  t\n"},
+		{"select a from t where b=?", sqlfmt.FormatOptions{UpperCase: true, ParseOptions: sqlfmt.ParseOptions{Placeholders: sqlfmt.QuestionPlaceholders}}, "SELECT\n  a\nFROM\n  t\nWHERE\n  b = ?\n"},
+		{"select 1; select 2;", sqlfmt.FormatOptions{}, "select\n  1\n;\nselect\n  2\n;\n"},
+		{"select - - 1", sqlfmt.FormatOptions{}, "select\n  - -1\n"}, // not a comment
+	}
+
+	for _, tt := range tests {
+		actual, err := sqlfmt.Format(tt.src, tt.opts)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.src, err)
+			continue
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.src, tt.expected, actual)
+		}
+	}
+
+	if _, err := sqlfmt.Format("select 'a", sqlfmt.FormatOptions{}); err == nil {
+		t.Error("expected an error for an unterminated string")
+	}
+}
@@ -0,0 +1,125 @@
+package sqlfmt
+
+import (
+	"sort"
+	"strings"
+)
+
+// suggestKeyword looks for a misspelled keyword at the syntax error at the
+// last token read by the parser. A typo is often only noticed a token later,
+// as in "select a form t", where form is taken as an alias for a, so the
+// token before the offending one is also considered. It returns the index of
+// the misspelled token and the keyword it most likely was meant to be, or -1.
+func (x *sqlLex) suggestKeyword() (int, string) {
+	last := x.nextToken - 1
+	for i := last - 1; i <= last; i++ {
+		if i < x.stmtStart || !x.isUnquotedIdent(*x.token(i)) {
+			continue
+		}
+
+		for _, k := range keywordCandidates(x.token(i).src, x.dialect.Keywords) {
+			if x.parsesWith(i, x.dialect.Keywords[k], last) {
+				return i, strings.ToUpper(k)
+			}
+		}
+	}
+
+	return -1, ""
+}
+
+func (x *sqlLex) isUnquotedIdent(t token) bool {
+	return t.typ == IDENT && t.src != "" && !strings.ContainsRune(x.dialect.IdentifierQuotes, rune(t.src[0]))
+}
+
+// keywordCandidates returns the keywords close enough to word to be a typo of
+// them, closest first.
+func keywordCandidates(word string, keywords map[string]int) []string {
+	word = strings.ToLower(word)
+	if len(word) < 3 {
+		return nil // too short to tell what it was meant to be
+	}
+
+	limit := 1
+	if len(word) >= 6 {
+		limit = 2
+	}
+
+	var candidates []string
+	distances := make(map[string]int)
+	for k := range keywords {
+		if d := editDistance(word, k); d <= limit {
+			candidates = append(candidates, k)
+			distances[k] = d
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if distances[a] != distances[b] {
+			return distances[a] < distances[b]
+		}
+		return a < b
+	})
+
+	return candidates
+}
+
+// parsesWith reports whether the statement parses up to the token at index
+// last, or through it, when the token at index i has type typ.
+func (x *sqlLex) parsesWith(i, typ, last int) bool {
+	trial := x.newTrial(last)
+	trial.tokens[i-x.stmtStart].typ = typ
+
+	yyNewParser().Parse(trial)
+	return trial.trialErr == -1 || trial.trialErr >= last-x.stmtStart+1
+}
+
+// newTrial returns a lexer for parsing the tokens of the statement through the
+// token at index last again.
+func (x *sqlLex) newTrial(last int) *sqlLex {
+	trial := &sqlLex{tokens: make([]token, 0, last-x.stmtStart+2), trial: true, trialErr: -1}
+	for j := x.stmtStart; j <= last; j++ {
+		t := *x.token(j)
+		t.leading, t.trailing = nil, nil
+		trial.tokens = append(trial.tokens, t)
+	}
+	trial.tokens = append(trial.tokens, token{typ: eof})
+	return trial
+}
+
+// editDistance returns the number of single character insertions, deletions,
+// substitutions and transpositions of adjacent characters that turn a into b.
+func editDistance(a, b string) int {
+	// three rows of the optimal string alignment distance matrix
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d := prev[j-1] + cost
+			if prev[j]+1 < d {
+				d = prev[j] + 1
+			}
+			if cur[j-1]+1 < d {
+				d = cur[j-1] + 1
+			}
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && prev2[j-2]+1 < d {
+				d = prev2[j-2] + 1
+			}
+			cur[j] = d
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[len(b)]
+}
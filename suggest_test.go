@@ -0,0 +1,55 @@
+package sqlfmt
+
+import (
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"from", "from", 0},
+		{"form", "from", 1},
+		{"selct", "select", 1},
+		{"gruop", "group", 1},
+		{"oder", "order", 1},
+		{"limt", "limit", 1},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		if d := editDistance(tt.a, tt.b); d != tt.distance {
+			t.Errorf("editDistance(%q, %q): expected %d, got %d", tt.a, tt.b, tt.distance, d)
+		}
+	}
+}
+
+func TestSuggestKeyword(t *testing.T) {
+	tests := []struct {
+		src     string
+		column  int
+		message string
+	}{
+		{"selct * from t", 1, `unexpected identifier "selct"; did you mean SELECT?`},
+		{"select a form t", 10, `unexpected identifier "form"; did you mean FROM?`},
+		{"select a from t gruop by a", 17, `unexpected identifier "gruop"; did you mean GROUP?`},
+		{"select a from t oder by a", 17, `unexpected identifier "oder"; did you mean ORDER?`},
+		{"select a from t where a = 1 adn b = 2", 29, `unexpected identifier "adn"; did you mean AND?`},
+		{"select a b c", 12, `syntax error at or near "c", expecting FETCH, FOR, LIMIT or OFFSET`},
+		{`select a "form" t`, 17, `syntax error at or near "t", expecting FETCH, FOR, LIMIT or OFFSET`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(NewSqlLexer(tt.src))
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%s: expected *ParseError, got %#v", tt.src, err)
+			continue
+		}
+		if pe.Column != tt.column || pe.Message() != tt.message {
+			t.Errorf("%s: expected 1:%d: %s, got %v", tt.src, tt.column, tt.message, pe)
+		}
+	}
+}
@@ -1,3 +1,4 @@
+-- b_expr is duplicated subset of a_expr -- test its clauses
 select
   foo between bexpr::text and bar,
   foo between -42 and bar,
@@ -1,3 +1,4 @@
+-- comment on the line before the statement
 select
   foo,
   bar
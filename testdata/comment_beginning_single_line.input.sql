@@ -1,2 +1,2 @@
--- TODO - do not strip comments
+-- comment on the line before the statement
 select foo, bar from baz
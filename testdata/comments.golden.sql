@@ -0,0 +1,21 @@
+-- Active adult users
+
+-- columns we care about
+select
+  id, -- primary key
+  /* full name */
+  name,
+  email -- contact
+from
+  users -- the table
+where
+  active -- only active
+  and age > 18 /* adults */
+group by -- grouping
+  id,
+  name,
+  email
+order by
+  id -- sort
+; -- done
+-- end of file
@@ -0,0 +1,7 @@
+with a as ( -- first cte
+  select 1 -- one
+), -- between
+b (x) as materialized ( -- second cte
+  select 2
+)
+select * from a, b where true
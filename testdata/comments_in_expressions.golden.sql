@@ -0,0 +1,14 @@
+select
+  a /* note */,
+  b,
+  coalesce(c, /* fallback */ 0) as c,
+  price * /* tax */ 1.2 /* gross */ as gross
+from
+  t
+where
+  x in (1, /* two */ 2)
+  and y = /* param */ $1
+order by
+  a
+limit /* lim */ 10
+offset /* skip */ 20
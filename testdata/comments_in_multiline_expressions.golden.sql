@@ -0,0 +1,25 @@
+select
+  case -- kind
+  when a = 1 then -- compared
+    'one' -- first
+  else -- fallback
+    'other'
+  end
+  as kind,
+  b in (1, 2), -- listed
+  c not in (select
+    c
+  from
+    u
+  ) -- excluded
+from
+  t
+where
+  exists(select
+    1
+  from
+    v
+  ) -- any
+order by
+  a, -- first key
+  b
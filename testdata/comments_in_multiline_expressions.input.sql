@@ -0,0 +1,12 @@
+select case -- kind
+when a = -- compared
+1 then 'one' -- first
+else -- fallback
+'other' end as kind,
+  b in -- listed
+(1, 2), c not in (select c from u) -- excluded
+from t
+where exists -- any
+(select 1 from v)
+order by a, -- first key
+b
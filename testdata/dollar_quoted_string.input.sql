@@ -0,0 +1,3 @@
+select $$it's$$, $fn$ outer $$ inner $$ still outer $fn$,
+  $a$multi
+line$a$, $_1$$_1$, foo$bar, $$$$ || 'x'
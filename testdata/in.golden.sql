@@ -1,3 +1,4 @@
+-- TODO - fix formatting when spacing / new line is improved
 select
   2 in (1, 2, 3),
   2 not in (1, 2, 3),
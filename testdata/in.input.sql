@@ -1,2 +1,3 @@
+-- TODO - fix formatting when spacing / new line is improved
 select 2 in (1,2,3), 2 not in (1,2,3),
   2 in (select generate_series(1,10)), 2 not in (select generate_series(1,10))
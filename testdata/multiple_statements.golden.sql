@@ -0,0 +1,29 @@
+-- first
+select
+  1
+;
+select
+  a
+from
+  t
+where
+  b = 2
+; -- second
+
+-- third, after blank lines
+select
+  c
+from
+  u
+;
+;
+-- the empty statement above is kept
+
+select
+  4
+;
+select
+  5
+;
+
+-- end of script
@@ -0,0 +1,7 @@
+select
+  `id`,
+  `user name`
+from
+  `app`.`users`
+where
+  `id` = 1
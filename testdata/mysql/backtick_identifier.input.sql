@@ -0,0 +1 @@
+select `id`, `user name` from `app`.`users` where `id` = 1
@@ -0,0 +1,5 @@
+select
+  "double",
+  'single',
+  'it\'s',
+  "say \"hi\""
@@ -0,0 +1 @@
+select "double", 'single', 'it\'s', "say \"hi\""
@@ -0,0 +1,6 @@
+# leading comment
+select
+  a, # trailing
+  b -- dash comment
+from
+  t
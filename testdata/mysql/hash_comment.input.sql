@@ -0,0 +1,4 @@
+# leading comment
+select a, # trailing
+  b -- dash comment
+from t
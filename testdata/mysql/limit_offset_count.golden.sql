@@ -0,0 +1,7 @@
+select
+  *
+from
+  t
+order by
+  id
+limit 20, 10
@@ -0,0 +1 @@
+select * from t order by id limit 20, 10
@@ -0,0 +1,7 @@
+insert into t (id, name, visits)
+values
+  (1, 'a', 1),
+  (2, 'b', 1)
+on duplicate key update
+  name = values(name),
+  visits = visits + 1
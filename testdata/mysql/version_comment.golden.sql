@@ -0,0 +1,24 @@
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */
+;
+select
+  /*!40001 SQL_NO_CACHE */
+  a
+from
+  t
+;
+select
+  a /*!50000 + 1 */,
+  b
+from
+  t
+;
+select
+  a,
+  b
+from
+  t /* a plain comment */
+;
+insert /*!IGNORE*/ into t (a)
+values
+  (1)
+;
@@ -0,0 +1,14 @@
+select
+  42,
+  1_000_000,
+  0x1F,
+  0o17,
+  0b101,
+  3000000000,
+  3.14,
+  .5,
+  5.,
+  1e10,
+  1.5E-3,
+  arr[1:2],
+  1 + 2.5 * 3
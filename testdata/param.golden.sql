@@ -0,0 +1,13 @@
+select
+  $1,
+  $2::int[],
+  $3::text,
+  ($4::int[])[1],
+  $5[2:3],
+  $6.foo,
+  foo($7, $8)
+from
+  bar
+where
+  id = $9
+  and name = $10
@@ -0,0 +1,4 @@
+select E'it\'s', e'tab\tnew\\line\n', U&'d\0061t\+000061',
+  u&'d!0061t!+000061' UESCAPE '!', U&"d\0061t" as data, U&"d*0061t" uescape '*',
+  N'national', n'it''s', ename, nobody, usage
+from U&"t\0061ble"
@@ -0,0 +1,5 @@
+select
+  f.x
+from
+  foo as f(x, y)
+  join bar as b(x) using(x)
@@ -0,0 +1 @@
+select f.x from foo as f(x, y) join bar b(x) using (x)
@@ -0,0 +1,15 @@
+select
+  t.x,
+  t.y
+from
+  (
+    select
+      a,
+      b
+    from
+      foo
+    where
+      a > 1
+    order by
+      a
+  ) as t(x, y)
@@ -0,0 +1 @@
+select * from a join b on a.id = b.a_id join c on c.b_id = b.id, d cross join e
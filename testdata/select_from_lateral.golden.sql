@@ -0,0 +1,18 @@
+select
+  u.id,
+  o.total,
+  tag
+from
+  users as u
+  left join lateral (
+    select
+      total
+    from
+      orders
+    where
+      orders.user_id = u.id
+    order by
+      created_at desc
+    limit 1
+  ) as o on true
+  cross join lateral unnest(u.tags) as tag
@@ -0,0 +1,4 @@
+select u.id, o.total, tag
+from users u
+left join lateral (select total from orders where orders.user_id = u.id order by created_at desc limit 1) o on true
+cross join lateral unnest(u.tags) as tag
@@ -0,0 +1,6 @@
+select
+  foo,
+  bar
+from
+  baz
+  left join quz on baz.a = quz.b
@@ -0,0 +1 @@
+select * from a natural left outer join b natural join c
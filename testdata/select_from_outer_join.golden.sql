@@ -0,0 +1,7 @@
+select
+  *
+from
+  a
+  right outer join b using(id)
+  full join c on c.id = b.id
+  inner join d on d.id = c.id
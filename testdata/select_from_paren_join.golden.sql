@@ -0,0 +1,12 @@
+select
+  *
+from
+  a
+  left join (
+    b
+    join c on b.id = c.b_id
+  ) on a.id = b.a_id,
+  (
+    d
+    natural join e
+  ) as de
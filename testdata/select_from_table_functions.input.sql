@@ -0,0 +1 @@
+select * from generate_series(1, 10) as g(n), unnest(array['a', 'b']) with ordinality as u(letter, i), rows from (f(1), g() as (a int, b text)) with ordinality as r, jsonb_to_recordset(x) as r(a int, b text)
@@ -0,0 +1,4 @@
+select
+  count(*)
+from
+  events tablesample system (1) repeatable (42)
@@ -0,0 +1 @@
+select count(*) from events tablesample system (1) repeatable (42)
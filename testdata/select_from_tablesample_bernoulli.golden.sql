@@ -0,0 +1,6 @@
+select
+  e.id,
+  u.name
+from
+  public.events as e tablesample bernoulli (0.5 * 2)
+  join users as u tablesample system (10) on u.id = e.user_id
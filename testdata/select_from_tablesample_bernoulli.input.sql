@@ -0,0 +1 @@
+select e.id, u.name from public.events as e tablesample bernoulli (0.5 * 2) join users u tablesample system (10) on u.id = e.user_id
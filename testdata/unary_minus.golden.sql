@@ -0,0 +1,11 @@
+select
+  a * -1,
+  x < -1,
+  a + -b,
+  a <= -1,
+  -a * -b,
+  a @- b,
+  a + /* plus */ 1,
+  - -1,
+  - +1,
+  @ -1
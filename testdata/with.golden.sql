@@ -0,0 +1,14 @@
+with a as (
+  select
+    1 as x
+),
+b(y) as materialized (
+  select
+    x
+  from
+    a
+)
+select
+  *
+from
+  b
@@ -0,0 +1 @@
+with a as (select 1 as x), b(y) as materialized (select x from a) select * from b
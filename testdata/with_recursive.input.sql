@@ -0,0 +1 @@
+with recursive t(n) as not materialized (select 1 union all select n+1 from t where n < 10) select sum(n) from t order by 1 limit 1
@@ -0,0 +1 @@
+with recursive g(a, b) as (select 1, 2) search breadth first by a, b set seq cycle a set is_cycle using path select * from g
@@ -0,0 +1,24 @@
+with recursive tree(id, parent) as (
+  select
+    id,
+    parent
+  from
+    nodes
+  where
+    parent is null
+  union all
+  select
+    n.id,
+    n.parent
+  from
+    nodes as n,
+    tree
+  where
+    n.parent = tree.id
+) search depth first by id set ord cycle id set is_cycle to true default false using path
+select
+  *
+from
+  tree
+order by
+  ord
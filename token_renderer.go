@@ -25,6 +25,12 @@ func RenderTokens(r Renderer, tokens []RenderToken) {
 }
 
 func TryOneLine(tokens []RenderToken, maxLineLength int) []RenderToken {
+	for _, t := range tokens {
+		if t.Type == CommentToken || t.Type == TrailingCommentToken {
+			return tokens
+		}
+	}
+
 	buf := &bytes.Buffer{}
 	r := NewTextRenderer(buf)
 	RenderTokens(r, tokens)
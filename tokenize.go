@@ -0,0 +1,130 @@
+package sqlfmt
+
+import (
+	"fmt"
+)
+
+// TokenKind is the lexical category of a Token.
+type TokenKind int
+
+const (
+	KeywordKind TokenKind = iota
+	IdentifierKind
+	StringKind
+	BitStringKind
+	IntegerKind
+	NumericKind
+	ParamKind
+	PlaceholderKind
+	OperatorKind
+	PunctuationKind
+	CommentKind
+)
+
+var tokenKindNames = []string{
+	KeywordKind:     "keyword",
+	IdentifierKind:  "identifier",
+	StringKind:      "string",
+	BitStringKind:   "bitstring",
+	IntegerKind:     "integer",
+	NumericKind:     "numeric",
+	ParamKind:       "param",
+	PlaceholderKind: "placeholder",
+	OperatorKind:    "operator",
+	PunctuationKind: "punctuation",
+	CommentKind:     "comment",
+}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+	return tokenKindNames[k]
+}
+
+// MarshalText encodes the kind as its name, so it appears as a string in JSON.
+func (k TokenKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Token is a lexical token of SQL source text.
+type Token struct {
+	Kind   TokenKind `json:"kind"`
+	Text   string    `json:"text"`   // raw text of the token as it appears in the source
+	Offset int       `json:"offset"` // byte offset of the token in the source
+	Line   int       `json:"line"`   // starting at 1
+	Column int       `json:"column"` // in characters, starting at 1
+}
+
+// Tokenize splits src into tokens, including comments. On a lexical error it
+// returns the tokens before the error and a *LexError.
+func Tokenize(src string, options ...LexerOption) ([]Token, error) {
+	l := NewSqlLexer(src, options...)
+	l.lexAll()
+
+	tokens := l.tokens[:len(l.tokens)-1] // without eof
+	comments := l.comments
+	result := make([]Token, 0, len(tokens)+len(comments))
+
+	line, column, last := 1, 1, 0
+	add := func(kind TokenKind, t token) {
+		for _, r := range src[last:t.offset] {
+			if r == '\n' {
+				line++
+				column = 1
+			} else {
+				column++
+			}
+		}
+		last = t.offset
+
+		result = append(result, Token{
+			Kind:   kind,
+			Text:   src[t.offset:t.end],
+			Offset: t.offset,
+			Line:   line,
+			Column: column,
+		})
+	}
+
+	for len(tokens) > 0 || len(comments) > 0 {
+		if len(comments) > 0 && (len(tokens) == 0 || comments[0].offset < tokens[0].offset) {
+			add(CommentKind, comments[0])
+			comments = comments[1:]
+		} else {
+			add(tokenKind(tokens[0].typ), tokens[0])
+			tokens = tokens[1:]
+		}
+	}
+
+	if l.err != nil {
+		return result, l.err
+	}
+
+	return result, nil
+}
+
+func tokenKind(typ int) TokenKind {
+	switch typ {
+	case IDENT:
+		return IdentifierKind
+	case SCONST:
+		return StringKind
+	case BCONST, XCONST:
+		return BitStringKind
+	case ICONST:
+		return IntegerKind
+	case FCONST:
+		return NumericKind
+	case PARAM:
+		return ParamKind
+	case PLACEHOLDER:
+		return PlaceholderKind
+	case Op, TYPECAST, DOT_DOT, COLON_EQUALS, EQUALS_GREATER, LESS_EQUALS, GREATER_EQUALS, NOT_EQUALS,
+		'+', '-', '*', '/', '%', '^', '<', '>', '=':
+		return OperatorKind
+	case ',', '(', ')', '[', ']', ';', '.', ':':
+		return PunctuationKind
+	}
+	return KeywordKind
+}
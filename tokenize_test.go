@@ -0,0 +1,56 @@
+package sqlfmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	src := "select a, -- the a\n  \"B\"::int\nfrom t where x >= $1 /* done */"
+	expected := []Token{
+		{Kind: KeywordKind, Text: "select", Offset: 0, Line: 1, Column: 1},
+		{Kind: IdentifierKind, Text: "a", Offset: 7, Line: 1, Column: 8},
+		{Kind: PunctuationKind, Text: ",", Offset: 8, Line: 1, Column: 9},
+		{Kind: CommentKind, Text: "-- the a", Offset: 10, Line: 1, Column: 11},
+		{Kind: IdentifierKind, Text: `"B"`, Offset: 21, Line: 2, Column: 3},
+		{Kind: OperatorKind, Text: "::", Offset: 24, Line: 2, Column: 6},
+		{Kind: KeywordKind, Text: "int", Offset: 26, Line: 2, Column: 8},
+		{Kind: KeywordKind, Text: "from", Offset: 30, Line: 3, Column: 1},
+		{Kind: IdentifierKind, Text: "t", Offset: 35, Line: 3, Column: 6},
+		{Kind: KeywordKind, Text: "where", Offset: 37, Line: 3, Column: 8},
+		{Kind: IdentifierKind, Text: "x", Offset: 43, Line: 3, Column: 14},
+		{Kind: OperatorKind, Text: ">=", Offset: 45, Line: 3, Column: 16},
+		{Kind: ParamKind, Text: "$1", Offset: 48, Line: 3, Column: 19},
+		{Kind: CommentKind, Text: "/* done */", Offset: 51, Line: 3, Column: 22},
+	}
+
+	tokens, err := Tokenize(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected %v, got %v", expected, tokens)
+	}
+}
+
+func TestTokenizeError(t *testing.T) {
+	tokens, err := Tokenize("select 'foo")
+	if _, ok := err.(*LexError); !ok {
+		t.Fatalf("expected *LexError, got %#v", err)
+	}
+
+	expected := []Token{{Kind: KeywordKind, Text: "select", Offset: 0, Line: 1, Column: 1}}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected %v, got %v", expected, tokens)
+	}
+}
+
+func TestTokenKindString(t *testing.T) {
+	if s := OperatorKind.String(); s != "operator" {
+		t.Errorf("expected operator, got %s", s)
+	}
+	if s := TokenKind(100).String(); s != "TokenKind(100)" {
+		t.Errorf("expected TokenKind(100), got %s", s)
+	}
+}
@@ -0,0 +1,181 @@
+package sqlfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedError reports valid SQL that sqlfmt cannot format yet, such as a
+// statement other than a query. It is returned instead of a ParseError when a
+// syntax error is at a statement or clause sqlfmt does not implement.
+type UnsupportedError struct {
+	Offset  int    // byte offset of the offending token
+	Line    int    // line of the offending token, starting at 1
+	Column  int    // column of the offending token in characters, starting at 1
+	Feature string // such as "CREATE TRIGGER statements"
+
+	// Snippet is the source line containing the offending token followed by a
+	// line with a caret under the token.
+	Snippet string
+}
+
+// Error returns the message prefixed with line:column.
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message())
+}
+
+// Message returns the description of the error without its position.
+func (e *UnsupportedError) Message() string {
+	return fmt.Sprintf("unsupported: %s are not handled by sqlfmt", e.Feature)
+}
+
+// statementKeywords are the keywords PostgreSQL statements other than queries
+// begin with.
+var statementKeywords = map[string]bool{
+	"ABORT": true, "ALTER": true, "ANALYSE": true, "ANALYZE": true, "BEGIN": true,
+	"CALL": true, "CHECKPOINT": true, "CLOSE": true, "CLUSTER": true, "COMMENT": true,
+	"COMMIT": true, "COPY": true, "CREATE": true, "DEALLOCATE": true, "DECLARE": true,
+	"DELETE": true, "DISCARD": true, "DO": true, "DROP": true, "END": true,
+	"EXECUTE": true, "EXPLAIN": true, "FETCH": true, "GRANT": true, "IMPORT": true,
+	"LISTEN": true, "LOAD": true, "LOCK": true, "MERGE": true,
+	"MOVE": true, "NOTIFY": true, "PREPARE": true, "REASSIGN": true, "REFRESH": true,
+	"REINDEX": true, "RELEASE": true, "RESET": true, "REVOKE": true, "ROLLBACK": true,
+	"SAVEPOINT": true, "SECURITY": true, "SET": true, "SHOW": true, "START": true,
+	"TRUNCATE": true, "UNLISTEN": true, "UPDATE": true, "VACUUM": true,
+}
+
+// objectModifiers may come between CREATE, ALTER or DROP and the kind of
+// object, as in CREATE OR REPLACE TEMPORARY VIEW.
+var objectModifiers = map[string]bool{
+	"OR": true, "REPLACE": true, "GLOBAL": true, "LOCAL": true, "TEMP": true,
+	"TEMPORARY": true, "UNLOGGED": true, "UNIQUE": true, "CONSTRAINT": true,
+	"TRUSTED": true, "PROCEDURAL": true, "RECURSIVE": true,
+}
+
+// objectKindWords lists the words that continue the name of a kind of object
+// after the given word, as VIEW does in MATERIALIZED VIEW.
+var objectKindWords = map[string][]string{
+	"MATERIALIZED": {"VIEW"},
+	"FOREIGN":      {"TABLE", "DATA"},
+	"DATA":         {"WRAPPER"},
+	"EVENT":        {"TRIGGER"},
+	"ACCESS":       {"METHOD"},
+	"USER":         {"MAPPING"},
+	"TEXT":         {"SEARCH"},
+	"SEARCH":       {"CONFIGURATION", "DICTIONARY", "PARSER", "TEMPLATE"},
+	"OPERATOR":     {"CLASS", "FAMILY"},
+	"DEFAULT":      {"PRIVILEGES"},
+}
+
+// unsupportedClauses maps the token a clause sqlfmt does not implement fails
+// to parse at, and the token before it or 0 for any, to the feature reported
+// for it.
+var unsupportedClauses = map[[2]int]string{
+	{0, CONFLICT}:    "ON CONFLICT clauses",
+	{0, RETURNING}:   "RETURNING clauses",
+	{GROUPING, SETS}: "GROUPING SETS clauses",
+}
+
+// unsupportedFunctions are the functions taking arguments other than a list of
+// expressions that sqlfmt does not implement.
+var unsupportedFunctions = map[string]bool{
+	"XMLTABLE": true, "JSON_TABLE": true, "JSON_OBJECT": true, "JSON_ARRAY": true,
+	"JSON_OBJECTAGG": true, "JSON_ARRAYAGG": true,
+}
+
+// unsupportedFeature returns the feature sqlfmt does not implement that the
+// parse error at the last token read by the parser is due to, or "" if the
+// input is simply invalid.
+func (x *sqlLex) unsupportedFeature() string {
+	if name := x.statementName(x.stmtStart); statementKeywords[name] {
+		return x.objectKind(name) + " statements"
+	}
+
+	if name := x.enclosingFunction(x.nextToken - 1); unsupportedFunctions[name] {
+		return name + " expressions"
+	}
+
+	last := x.nextToken - 1
+	var prev int
+	if last > x.stmtStart {
+		prev = x.token(last - 1).typ
+	}
+	if feature, ok := unsupportedClauses[[2]int{prev, x.token(last).typ}]; ok {
+		return feature
+	}
+	return unsupportedClauses[[2]int{0, x.token(last).typ}]
+}
+
+// enclosingFunction returns the upper-cased name of the function whose
+// parentheses most closely enclose the token at index i, or "" if there is
+// none in the statement.
+func (x *sqlLex) enclosingFunction(i int) string {
+	depth := 0
+	for j := i - 1; j > x.stmtStart; j-- {
+		switch x.token(j).typ {
+		case ')':
+			depth++
+		case '(':
+			if depth == 0 {
+				return x.statementName(j - 1)
+			}
+			depth--
+		}
+	}
+	return ""
+}
+
+// objectKind extends the name of the statement starting at stmtStart with the
+// kind of object it applies to, as in CREATE TRIGGER.
+func (x *sqlLex) objectKind(name string) string {
+	if name != "CREATE" && name != "ALTER" && name != "DROP" {
+		return name
+	}
+
+	i := x.stmtStart + 1
+	for objectModifiers[x.statementName(i)] {
+		i++
+	}
+
+	word := x.statementName(i)
+	if word == "" || !x.isKeyword(i) {
+		return name
+	}
+	name += " " + word
+
+	for {
+		next := x.statementName(i + 1)
+		found := false
+		for _, w := range objectKindWords[word] {
+			found = found || w == next
+		}
+		if !found {
+			return name
+		}
+
+		name += " " + next
+		word = next
+		i++
+	}
+}
+
+// statementName returns the upper-cased text of the token at index i, lexing
+// up to it if necessary, or "" if it is not a word.
+func (x *sqlLex) statementName(i int) string {
+	for i >= x.lexed() && x.advance() {
+	}
+	if i >= x.lexed() {
+		return ""
+	}
+
+	t := x.token(i)
+	if t.typ != IDENT && !x.isKeyword(i) {
+		return ""
+	}
+	return strings.ToUpper(t.src)
+}
+
+func (x *sqlLex) isKeyword(i int) bool {
+	t := x.token(i)
+	return t.typ != eof && tokenKind(t.typ) == KeywordKind
+}
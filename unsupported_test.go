@@ -0,0 +1,64 @@
+package sqlfmt
+
+import (
+	"testing"
+)
+
+func TestUnsupported(t *testing.T) {
+	tests := []struct {
+		src    string
+		column int
+		msg    string
+	}{
+		{"create or replace trigger t after insert on x execute function f()", 1, "unsupported: CREATE TRIGGER statements are not handled by sqlfmt"},
+		{"CREATE UNIQUE INDEX i ON t (a)", 1, "unsupported: CREATE INDEX statements are not handled by sqlfmt"},
+		{"drop materialized view v", 1, "unsupported: DROP MATERIALIZED VIEW statements are not handled by sqlfmt"},
+		{"alter foreign data wrapper w", 1, "unsupported: ALTER FOREIGN DATA WRAPPER statements are not handled by sqlfmt"},
+		{"create", 1, "unsupported: CREATE statements are not handled by sqlfmt"},
+		{"select 1; delete from t where a", 11, "unsupported: DELETE statements are not handled by sqlfmt"},
+		{"select * from t tablesample system (1); update t set a = 1", 41, "unsupported: UPDATE statements are not handled by sqlfmt"},
+		{"insert into t values (1) returning a", 26, "unsupported: RETURNING clauses are not handled by sqlfmt"},
+		{"insert into t values (1) on conflict do nothing", 29, "unsupported: ON CONFLICT clauses are not handled by sqlfmt"},
+		{"select a from t group by grouping sets ((a), ())", 35, "unsupported: GROUPING SETS clauses are not handled by sqlfmt"},
+		{"select * from xmltable('/r' passing x columns a int)", 29, "unsupported: XMLTABLE expressions are not handled by sqlfmt"},
+		{"select json_object('a': 1)", 23, "unsupported: JSON_OBJECT expressions are not handled by sqlfmt"},
+		{"select json_array(1, (2) returning jsonb)", 26, "unsupported: JSON_ARRAY expressions are not handled by sqlfmt"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(NewSqlLexer(tt.src))
+		ue, ok := err.(*UnsupportedError)
+		if !ok {
+			t.Errorf("%s: expected *UnsupportedError, got %#v", tt.src, err)
+			continue
+		}
+		if ue.Line != 1 || ue.Column != tt.column || ue.Message() != tt.msg {
+			t.Errorf("%s: expected 1:%d: %s, got %v", tt.src, tt.column, tt.msg, ue)
+		}
+	}
+
+	for _, src := range []string{"frob the thing", "select a b c", "from t"} {
+		_, err := Parse(NewSqlLexer(src))
+		if _, ok := err.(*ParseError); !ok {
+			t.Errorf("%s: expected *ParseError, got %#v", src, err)
+		}
+	}
+}
+
+func TestUnsupportedRecovery(t *testing.T) {
+	stmts, err := Parse(NewSqlLexer("delete from t where a; select 1", WithErrorRecovery()))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(stmts) != 2 {
+		t.Fatalf("expected 2 statements, got %d", len(stmts))
+	}
+
+	v, ok := stmts[0].(*VerbatimStmt)
+	if !ok {
+		t.Fatalf("expected *VerbatimStmt, got %#v", stmts[0])
+	}
+	if _, ok := v.Err.(*UnsupportedError); !ok || v.Text != "delete from t where a" {
+		t.Errorf("unexpected %#v", *v)
+	}
+}